@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftest
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+)
+
+// TestCheckFunc is a function which validates the Terraform State after a TestStep has been applied
+type TestCheckFunc = resource.TestCheckFunc
+
+// DisappearsStepData configures a TestStep, as returned from `TestData.DisappearsStep`, which deletes the resource
+// from Azure outside of Terraform and then confirms that Terraform plans to recreate it. This is an alias of the
+// provider's internal type.
+type DisappearsStepData = acceptance.DisappearsStepData
+
+// That returns a type which can be used for fluent assertions against the specified resource, for example
+// `tftest.That("azurerm_resource_group.test").Key("location").HasValue("westeurope")`
+var That = check.That
+
+// ComposeTestCheckFunc returns a TestCheckFunc which runs each of the specified checks in order, stopping at the
+// first failure
+func ComposeTestCheckFunc(fs ...TestCheckFunc) TestCheckFunc {
+	return acceptance.ComposeTestCheckFunc(fs...)
+}
+
+// CheckDestroyed returns a TestCheckFunc which validates that the specified resource no longer exists within Azure,
+// for use as the `CheckDestroy` function of a custom `resource.TestCase`
+func CheckDestroyed(testResource TestResource, resourceType string, resourceName string) TestCheckFunc {
+	return func(state *terraform.State) error {
+		client, err := testclient.Build()
+		if err != nil {
+			return fmt.Errorf("building client: %+v", err)
+		}
+		return helpers.CheckDestroyedFunc(client, testResource, resourceType, resourceName)(state)
+	}
+}
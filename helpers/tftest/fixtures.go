@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftest
+
+import (
+	"bytes"
+	"os"
+	"testing"
+	"text/template"
+)
+
+// LoadFixture reads the Terraform configuration at the specified path and renders it as a Go template using the
+// TestData, allowing fixtures to reference values such as `{{ .RandomInteger }}` and `{{ .Locations.Primary }}`.
+func LoadFixture(t *testing.T, path string, data TestData) string {
+	t.Helper()
+
+	contents, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("reading fixture %q: %+v", path, err)
+	}
+
+	tmpl, err := template.New(path).Option("missingkey=error").Parse(string(contents))
+	if err != nil {
+		t.Fatalf("parsing fixture %q: %+v", path, err)
+	}
+
+	var out bytes.Buffer
+	if err := tmpl.Execute(&out, data); err != nil {
+		t.Fatalf("rendering fixture %q: %+v", path, err)
+	}
+
+	return out.String()
+}
@@ -0,0 +1,26 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftest_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tftest"
+)
+
+func TestLoadFixture(t *testing.T) {
+	data := tftest.TestData{
+		RandomInteger: 1234,
+	}
+	data.Locations.Primary = "westeurope"
+
+	expected := `resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-1234"
+  location = "westeurope"
+}
+`
+	if actual := tftest.LoadFixture(t, "testdata/resource_group.tf", data); actual != expected {
+		t.Fatalf("expected %q but got %q", expected, actual)
+	}
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package tftest
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// RetryFunc is a function which is retried until it either succeeds or returns a non-retryable error
+type RetryFunc = pluginsdk.RetryFunc
+
+// RetryError is returned from a RetryFunc to indicate whether the error is retryable
+type RetryError = pluginsdk.RetryError
+
+// Retry calls the specified function until it succeeds, returns a non-retryable error or the timeout is reached.
+func Retry(ctx context.Context, timeout time.Duration, f RetryFunc) error {
+	return retry.RetryContext(ctx, timeout, f)
+}
+
+// RetryableError returns a RetryError which indicates that the function should be retried.
+func RetryableError(err error) *RetryError {
+	return pluginsdk.RetryableError(err)
+}
+
+// NonRetryableError returns a RetryError which indicates that the function should not be retried.
+func NonRetryableError(err error) *RetryError {
+	return pluginsdk.NonRetryableError(err)
+}
+
+// Cleanup registers a function which is called using an authenticated Client once the test (and any Terraform
+// destroy) has completed, for removing any resources created outside of Terraform. Any error returned from the
+// function fails the test.
+func Cleanup(t *testing.T, timeout time.Duration, f func(ctx context.Context, client *Client) error) {
+	t.Helper()
+
+	t.Cleanup(func() {
+		client, err := BuildClient()
+		if err != nil {
+			t.Errorf("building client for cleanup: %+v", err)
+			return
+		}
+
+		ctx, cancel := context.WithTimeout(client.StopContext, timeout)
+		defer cancel()
+
+		if err := f(ctx, client); err != nil {
+			t.Errorf("cleaning up: %+v", err)
+		}
+	})
+}
@@ -0,0 +1,4 @@
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-{{ .RandomInteger }}"
+  location = "{{ .Locations.Primary }}"
+}
@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tftest exposes the acceptance test harness used by the provider, so that it can be used to run smoke tests
+// against custom Terraform configurations from outside of this repository.
+//
+// This package is not covered by any compatibility guarantees: several of its types (such as TestData and Client) are
+// aliases of the provider's internal types, and so change whenever those internal types do. Consumers should pin the
+// version of the provider they depend on.
+package tftest
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/testclient"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/types"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// TestData contains the randomised values and Azure Regions/Subscriptions which should be used for a single test.
+// This is an alias of the provider's internal type.
+type TestData = acceptance.TestData
+
+// TestStep is a single apply/import/plan step within a test
+type TestStep = resource.TestStep
+
+// Client is the set of authenticated Azure SDK clients passed to the `Exists` and `Destroy` functions. This is an
+// alias of the provider's internal type.
+type Client = clients.Client
+
+// InstanceState is the state of a single resource, as passed to the `Exists` and `Destroy` functions
+type InstanceState = pluginsdk.InstanceState
+
+// TestResource is implemented by types which can confirm whether a resource exists within Azure
+type TestResource interface {
+	Exists(ctx context.Context, client *Client, state *InstanceState) (*bool, error)
+}
+
+// TestResourceVerifyingRemoved is implemented by types which can additionally delete a resource from Azure
+type TestResourceVerifyingRemoved interface {
+	TestResource
+	Destroy(ctx context.Context, client *Client, state *InstanceState) (*bool, error)
+}
+
+var (
+	_ types.TestResource                 = TestResource(nil)
+	_ types.TestResourceVerifyingRemoved = TestResourceVerifyingRemoved(nil)
+)
+
+// BuildTestData returns the TestData for the resource of the specified type and label, e.g. `azurerm_resource_group`
+// and `test`. The Azure Regions and Subscriptions are populated from the `ARM_TEST_LOCATION*` and
+// `ARM_SUBSCRIPTION_ID*` environment variables.
+func BuildTestData(t *testing.T, resourceType string, resourceLabel string) TestData {
+	return acceptance.BuildTestData(t, resourceType, resourceLabel)
+}
+
+// BuildClient returns the authenticated Client used by the test harness, which is built once and then shared between
+// tests. Authentication uses the `ARM_CLIENT_ID`, `ARM_CLIENT_SECRET` (or `ARM_CLIENT_CERTIFICATE_PATH`),
+// `ARM_TENANT_ID` and `ARM_SUBSCRIPTION_ID` environment variables.
+func BuildClient() (*Client, error) {
+	return testclient.Build()
+}
+
+// PreCheck fails the test if any of the environment variables required to run acceptance tests are not set.
+func PreCheck(t *testing.T) {
+	acceptance.PreCheck(t)
+}
+
+// SkipUnlessAcceptanceTestsEnabled skips the test unless the `TF_ACC` environment variable is set, matching the gating
+// used by the acceptance tests within the provider.
+func SkipUnlessAcceptanceTestsEnabled(t *testing.T) {
+	t.Helper()
+
+	if os.Getenv(resource.EnvTfAcc) == "" {
+		t.Skipf("Acceptance test skipped unless env %q set", resource.EnvTfAcc)
+	}
+}
+
+// SkipUnlessEnvironmentVariablesSet skips the test unless all of the specified environment variables are set, which
+// is useful for tests which depend on pre-existing infrastructure.
+func SkipUnlessEnvironmentVariablesSet(t *testing.T, names ...string) {
+	t.Helper()
+
+	for _, name := range names {
+		if os.Getenv(name) == "" {
+			t.Skipf("Skipping since %q is not set", name)
+		}
+	}
+}
+
+// RunTestsInSequence runs the specified groups of tests, with the tests in each group running sequentially.
+func RunTestsInSequence(t *testing.T, tests map[string]map[string]func(t *testing.T)) {
+	acceptance.RunTestsInSequence(t, tests)
+}
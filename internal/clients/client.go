@@ -38,6 +38,7 @@ import (
 	automation "github.com/hashicorp/terraform-provider-azurerm/internal/services/automation/client"
 	azureStackHCI "github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/client"
 	batch "github.com/hashicorp/terraform-provider-azurerm/internal/services/batch/client"
+	billing "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/client"
 	blueprints "github.com/hashicorp/terraform-provider-azurerm/internal/services/blueprints/client"
 	bot "github.com/hashicorp/terraform-provider-azurerm/internal/services/bot/client"
 	cdn "github.com/hashicorp/terraform-provider-azurerm/internal/services/cdn/client"
@@ -174,6 +175,7 @@ type Client struct {
 	Automation                        *automation.Client
 	AzureStackHCI                     *azurestackhci_v2024_01_01.Client
 	Batch                             *batch.Client
+	Billing                           *billing.Client
 	Blueprints                        *blueprints.Client
 	Bot                               *bot.Client
 	Cdn                               *cdn.Client
@@ -348,6 +350,9 @@ func (client *Client) Build(ctx context.Context, o *common.ClientOptions) error
 	if client.Batch, err = batch.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for Batch: %+v", err)
 	}
+	if client.Billing, err = billing.NewClient(o); err != nil {
+		return fmt.Errorf("building clients for Billing: %+v", err)
+	}
 	if client.Blueprints, err = blueprints.NewClient(o); err != nil {
 		return fmt.Errorf("building clients for BluePrints: %+v", err)
 	}
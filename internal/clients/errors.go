@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// armErrorCodeRegex matches the error codes returned by Azure Resource Manager as they're surfaced by both the
+// `hashicorp/go-azure-sdk` (`Code: "SkuNotAvailable"`) and `Azure/go-autorest` (`Code="SkuNotAvailable"`) based clients
+var armErrorCodeRegex = regexp.MustCompile(`Code(?:: |=)"([A-Za-z0-9.]+)"`)
+
+type ArmErrorCategory string
+
+const (
+	ArmErrorCategoryLocation     ArmErrorCategory = "Location"
+	ArmErrorCategoryPermissions  ArmErrorCategory = "Permissions"
+	ArmErrorCategoryPolicy       ArmErrorCategory = "Policy"
+	ArmErrorCategoryProvider     ArmErrorCategory = "Provider"
+	ArmErrorCategoryQuota        ArmErrorCategory = "Quota"
+	ArmErrorCategoryRegistration ArmErrorCategory = "Registration"
+	ArmErrorCategorySku          ArmErrorCategory = "Sku"
+)
+
+type armErrorHint struct {
+	category ArmErrorCategory
+	hint     string
+}
+
+var armErrorHints = map[string]armErrorHint{
+	"AuthorizationFailed": {
+		category: ArmErrorCategoryPermissions,
+		hint:     "The credentials used by the Provider don't have permission to perform this action. Check the Role Assignments for the Service Principal, User or Managed Identity being used - noting that new Role Assignments can take several minutes to propagate.",
+	},
+	"LinkedAuthorizationFailed": {
+		category: ArmErrorCategoryPermissions,
+		hint:     "The credentials used by the Provider don't have permission to perform an action on a resource referenced by this resource (for example a Subnet or Key Vault Key). Check the Role Assignments on the referenced resource for the Service Principal, User or Managed Identity being used.",
+	},
+	"RequestDisallowedByPolicy": {
+		category: ArmErrorCategoryPolicy,
+		hint:     "This request was denied by an Azure Policy assignment. Review the Policy Definition referenced in the error above and either update the configuration to comply with it, or request a Policy Exemption from the owner of the Policy Assignment.",
+	},
+	"QuotaExceeded": {
+		category: ArmErrorCategoryQuota,
+		hint:     "The Subscription doesn't have enough quota available in this region to provision this resource. Either request a quota increase for the Subscription, reduce the size or number of resources being requested, or use a different region.",
+	},
+	"OperationNotAllowed": {
+		category: ArmErrorCategoryQuota,
+		hint:     "This operation would exceed one of the quotas (such as the regional or VM-family vCPU quota) listed in the error above. Request an increase for that specific quota via the Usage + quotas blade of the Subscription, or free up capacity by scaling down or removing existing resources counted against it.",
+	},
+	"SkuNotAvailable": {
+		category: ArmErrorCategorySku,
+		hint:     "The requested SKU isn't currently available for this Subscription in this region, which can be because the SKU isn't offered, is restricted for the Subscription or is out of capacity. Either use a different SKU or region, or raise a support request to enable the SKU for the Subscription.",
+	},
+	"InvalidResourceLocation": {
+		category: ArmErrorCategoryLocation,
+		hint:     "A resource with this name already exists in a different region. Since the region of an existing resource can't be changed, either use a different name for this resource or update the `location` to match the existing resource.",
+	},
+	"LocationNotAvailableForResourceType": {
+		category: ArmErrorCategoryLocation,
+		hint:     "This resource type isn't available in the requested region. Use one of the regions listed in the error above.",
+	},
+	"NoRegisteredProviderFound": {
+		category: ArmErrorCategoryProvider,
+		hint:     "The Resource Provider doesn't support this resource type for the requested combination of region and API Version. Check the supported regions and API Versions listed in the error above - if the region is supported, this API Version may not yet be rolled out there, in which case use a different region.",
+	},
+	"MissingSubscriptionRegistration": {
+		category: ArmErrorCategoryRegistration,
+		hint:     "The Resource Provider used by this resource isn't registered on the Subscription. Register the Resource Provider (for example using `az provider register --namespace {namespace}`), or add it to `resource_providers_to_register` in the Provider block.",
+	},
+}
+
+var _ error = ArmError{}
+
+// ArmError is an error returned from Azure Resource Manager which has been enriched with a hint describing how the
+// error can be resolved and the resource which the error occurred for.
+type ArmError struct {
+	Category     ArmErrorCategory
+	Code         string
+	Hint         string
+	ResourceType string
+	ResourceId   string
+
+	err error
+}
+
+func (e ArmError) Error() string {
+	address := e.ResourceType
+	if e.ResourceId != "" {
+		address = fmt.Sprintf("%s (%s)", e.ResourceType, e.ResourceId)
+	}
+
+	return fmt.Sprintf("%s\n\n%s error (%s) for %s: %s", e.err.Error(), e.Category, e.Code, address, e.Hint)
+}
+
+func (e ArmError) Unwrap() error {
+	return e.err
+}
+
+// EnrichArmError appends a hint describing how to resolve commonly encountered Azure Resource Manager errors (such as
+// quota, policy, permission and SKU availability errors) alongside the address of the resource the error occurred for.
+// Errors which aren't recognised are returned as-is.
+func EnrichArmError(err error, resourceType string, resourceId string) error {
+	if err == nil {
+		return nil
+	}
+
+	if _, ok := err.(ArmError); ok {
+		return err
+	}
+
+	message := err.Error()
+	for _, match := range armErrorCodeRegex.FindAllStringSubmatch(message, -1) {
+		code := match[1]
+		hint, ok := armErrorHints[code]
+		if !ok {
+			continue
+		}
+
+		// `OperationNotAllowed` is used for a number of scenarios, but is most commonly returned when quota is exhausted
+		if code == "OperationNotAllowed" && !strings.Contains(strings.ToLower(message), "quota") {
+			continue
+		}
+
+		return ArmError{
+			Category:     hint.category,
+			Code:         code,
+			Hint:         hint.hint,
+			ResourceType: resourceType,
+			ResourceId:   resourceId,
+			err:          err,
+		}
+	}
+
+	return err
+}
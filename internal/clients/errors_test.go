@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package clients
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestEnrichArmError(t *testing.T) {
+	testData := []struct {
+		name             string
+		input            error
+		resourceId       string
+		expectedCategory ArmErrorCategory
+		expectedCode     string
+	}{
+		{
+			name:  "nil",
+			input: nil,
+		},
+		{
+			name:  "unrecognised error",
+			input: errors.New("creating Resource Group: unexpected status 500"),
+		},
+		{
+			name:  "unrecognised error code",
+			input: errors.New("the Azure API returned the following error:\n\nStatus: \"Conflict\"\nCode: \"AnotherOperationInProgress\"\nMessage: \"...\""),
+		},
+		{
+			name:             "go-azure-sdk sku not available",
+			input:            fmt.Errorf("creating Linux Virtual Machine: %+v", errors.New("the Azure API returned the following error:\n\nStatus: \"Conflict\"\nCode: \"SkuNotAvailable\"\nMessage: \"The requested VM size is currently not available\"")),
+			expectedCategory: ArmErrorCategorySku,
+			expectedCode:     "SkuNotAvailable",
+		},
+		{
+			name:             "autorest policy deny",
+			input:            errors.New(`creating Storage Account: storage.AccountsClient#Create: Failure sending request: StatusCode=403 -- Original Error: Code="RequestDisallowedByPolicy" Message="Resource 'example' was disallowed by policy."`),
+			resourceId:       "/subscriptions/00000000-0000-0000-0000-000000000000/resourceGroups/example/providers/Microsoft.Storage/storageAccounts/example",
+			expectedCategory: ArmErrorCategoryPolicy,
+			expectedCode:     "RequestDisallowedByPolicy",
+		},
+		{
+			name:             "authorization failed",
+			input:            errors.New("retrieving Key Vault: Code: \"AuthorizationFailed\"\nMessage: \"The client does not have authorization to perform action\""),
+			expectedCategory: ArmErrorCategoryPermissions,
+			expectedCode:     "AuthorizationFailed",
+		},
+		{
+			name:             "invalid resource location",
+			input:            errors.New(`Code="InvalidResourceLocation" Message="The resource 'example' already exists in location 'westeurope'"`),
+			expectedCategory: ArmErrorCategoryLocation,
+			expectedCode:     "InvalidResourceLocation",
+		},
+		{
+			name:             "operation not allowed due to quota",
+			input:            errors.New(`Code: "OperationNotAllowed"` + "\n" + `Message: "Operation could not be completed as it results in exceeding approved standardDSv3Family Cores quota."`),
+			expectedCategory: ArmErrorCategoryQuota,
+			expectedCode:     "OperationNotAllowed",
+		},
+		{
+			name:             "no registered provider found",
+			input:            errors.New(`Code="NoRegisteredProviderFound" Message="No registered resource provider found for location 'westeurope' and API version '2024-01-01' for type 'workspaces'."`),
+			expectedCategory: ArmErrorCategoryProvider,
+			expectedCode:     "NoRegisteredProviderFound",
+		},
+		{
+			name:  "operation not allowed for another reason",
+			input: errors.New(`Code: "OperationNotAllowed"` + "\n" + `Message: "The operation is not allowed whilst the resource is being updated."`),
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q..", v.name)
+
+		actual := EnrichArmError(v.input, "azurerm_example", v.resourceId)
+		if v.input == nil {
+			if actual != nil {
+				t.Fatalf("expected no error but got %+v", actual)
+			}
+			continue
+		}
+
+		var armError ArmError
+		if !errors.As(actual, &armError) {
+			if v.expectedCode != "" {
+				t.Fatalf("expected an ArmError with the code %q but got %+v", v.expectedCode, actual)
+			}
+			if actual != v.input {
+				t.Fatalf("expected an unrecognised error to be returned as-is but got %+v", actual)
+			}
+			continue
+		}
+
+		if armError.Code != v.expectedCode {
+			t.Fatalf("expected the code %q but got %q", v.expectedCode, armError.Code)
+		}
+		if armError.Category != v.expectedCategory {
+			t.Fatalf("expected the category %q but got %q", v.expectedCategory, armError.Category)
+		}
+		if !errors.Is(actual, v.input) {
+			t.Fatalf("expected the enriched error to wrap the original error")
+		}
+
+		message := actual.Error()
+		if !strings.HasPrefix(message, v.input.Error()) {
+			t.Fatalf("expected the enriched error to start with the original error but got %q", message)
+		}
+		if !strings.Contains(message, "azurerm_example") {
+			t.Fatalf("expected the enriched error to contain the resource type but got %q", message)
+		}
+		if v.resourceId != "" && !strings.Contains(message, v.resourceId) {
+			t.Fatalf("expected the enriched error to contain the resource id but got %q", message)
+		}
+
+		if again := EnrichArmError(actual, "azurerm_example", v.resourceId); again.Error() != message {
+			t.Fatalf("expected an enriched error not to be enriched a second time but got %q", again.Error())
+		}
+	}
+}
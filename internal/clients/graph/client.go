@@ -53,6 +53,15 @@ func graphClient(authorizer auth.Authorizer, environment environments.Environmen
 }
 
 func ServicePrincipalObjectID(ctx context.Context, authorizer auth.Authorizer, environment environments.Environment, clientId string) (*string, error) {
+	return objectIDForAppID(ctx, authorizer, environment, "/servicePrincipals", clientId)
+}
+
+// ApplicationObjectID returns the Object ID of the Application Registration with the specified Application (Client) ID
+func ApplicationObjectID(ctx context.Context, authorizer auth.Authorizer, environment environments.Environment, applicationId string) (*string, error) {
+	return objectIDForAppID(ctx, authorizer, environment, "/applications", applicationId)
+}
+
+func objectIDForAppID(ctx context.Context, authorizer auth.Authorizer, environment environments.Environment, path string, appId string) (*string, error) {
 	if _, ok := ctx.Deadline(); !ok {
 		var cancel context.CancelFunc
 		ctx, cancel = context.WithDeadline(ctx, time.Now().Add(5*time.Minute))
@@ -67,10 +76,10 @@ func ServicePrincipalObjectID(ctx context.Context, authorizer auth.Authorizer, e
 		HttpMethod: http.MethodGet,
 		OptionsObject: options{
 			query: odata.Query{
-				Filter: fmt.Sprintf("appId eq '%s'", clientId),
+				Filter: fmt.Sprintf("appId eq '%s'", appId),
 			},
 		},
-		Path: "/servicePrincipals",
+		Path: path,
 	}
 
 	client, err := graphClient(authorizer, environment)
@@ -89,17 +98,17 @@ func ServicePrincipalObjectID(ctx context.Context, authorizer auth.Authorizer, e
 	}
 
 	model := struct {
-		ServicePrincipals []directoryObjectModel `json:"value"`
+		Objects []directoryObjectModel `json:"value"`
 	}{}
 	if err := resp.Unmarshal(&model); err != nil {
 		return nil, fmt.Errorf("unmarshaling response: %+v", err)
 	}
 
-	if len(model.ServicePrincipals) != 1 {
-		return nil, fmt.Errorf("unexpected number of results, expected 1, received %d", len(model.ServicePrincipals))
+	if len(model.Objects) != 1 {
+		return nil, fmt.Errorf("unexpected number of results, expected 1, received %d", len(model.Objects))
 	}
 
-	id := model.ServicePrincipals[0].ID
+	id := model.Objects[0].ID
 	if id == nil {
 		return nil, errors.New("returned object ID was nil")
 	}
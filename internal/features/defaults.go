@@ -19,6 +19,7 @@ func Default() UserFeatures {
 		},
 		CognitiveAccount: CognitiveAccountFeatures{
 			PurgeSoftDeleteOnDestroy: true,
+			RecoverSoftDeleted:       true,
 		},
 		KeyVault: KeyVaultFeatures{
 			PurgeSoftDeleteOnDestroy:         true,
@@ -83,5 +84,8 @@ func Default() UserFeatures {
 			DeleteBackupsOnBackupVaultDestroy: false,
 			PreventVolumeDestruction:          true,
 		},
+		IoTHub: IoTHubFeatures{
+			UseSeparateRoutingResources: false,
+		},
 	}
 }
@@ -22,10 +22,12 @@ type UserFeatures struct {
 	MachineLearning          MachineLearningFeatures
 	RecoveryService          RecoveryServiceFeatures
 	NetApp                   NetAppFeatures
+	IoTHub                   IoTHubFeatures
 }
 
 type CognitiveAccountFeatures struct {
 	PurgeSoftDeleteOnDestroy bool
+	RecoverSoftDeleted       bool
 }
 
 type VirtualMachineFeatures struct {
@@ -116,3 +118,7 @@ type NetAppFeatures struct {
 	DeleteBackupsOnBackupVaultDestroy bool
 	PreventVolumeDestruction          bool
 }
+
+type IoTHubFeatures struct {
+	UseSeparateRoutingResources bool
+}
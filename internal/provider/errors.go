@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+)
+
+// enrichUntypedResourceErrors wraps the CRUD functions of an Untyped Resource or Data Source so that common Azure
+// Resource Manager errors are returned alongside a hint describing how they can be resolved - Typed Resources
+// handle this within the SDK wrapper.
+func enrichUntypedResourceErrors(resourceType string, resource *schema.Resource) {
+	wrap := func(in func(*schema.ResourceData, interface{}) error) func(*schema.ResourceData, interface{}) error {
+		if in == nil {
+			return nil
+		}
+
+		return func(d *schema.ResourceData, meta interface{}) error {
+			return clients.EnrichArmError(in(d, meta), resourceType, d.Id())
+		}
+	}
+
+	wrapContext := func(in func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics) func(context.Context, *schema.ResourceData, interface{}) diag.Diagnostics {
+		if in == nil {
+			return nil
+		}
+
+		return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+			diags := in(ctx, d, meta)
+			for i, v := range diags {
+				if v.Severity != diag.Error {
+					continue
+				}
+
+				original := errors.New(v.Summary)
+				if enriched := clients.EnrichArmError(original, resourceType, d.Id()); enriched != original {
+					diags[i].Summary = enriched.Error()
+				}
+			}
+			return diags
+		}
+	}
+
+	resource.Create = wrap(resource.Create)
+	resource.Read = wrap(resource.Read)
+	resource.Update = wrap(resource.Update)
+	resource.Delete = wrap(resource.Delete)
+
+	resource.CreateContext = wrapContext(resource.CreateContext)
+	resource.ReadContext = wrapContext(resource.ReadContext)
+	resource.UpdateContext = wrapContext(resource.UpdateContext)
+	resource.DeleteContext = wrapContext(resource.DeleteContext)
+
+	resource.CreateWithoutTimeout = wrapContext(resource.CreateWithoutTimeout)
+	resource.ReadWithoutTimeout = wrapContext(resource.ReadWithoutTimeout)
+	resource.UpdateWithoutTimeout = wrapContext(resource.UpdateWithoutTimeout)
+	resource.DeleteWithoutTimeout = wrapContext(resource.DeleteWithoutTimeout)
+}
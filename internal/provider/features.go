@@ -84,6 +84,11 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 						Optional: true,
 						Default:  true,
 					},
+					"recover_soft_deleted": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  true,
+					},
 				},
 			},
 		},
@@ -416,6 +421,22 @@ func schemaFeatures(supportLegacyTestSuite bool) *pluginsdk.Schema {
 				},
 			},
 		},
+
+		"iothub": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"use_separate_routing_resources": {
+						Description: "When enabled, the `route`, `fallback_route` and `enrichment` blocks of the `azurerm_iothub` resource are ignored, so that message routing can be managed using the separate routing resources",
+						Type:        pluginsdk.TypeBool,
+						Optional:    true,
+						Default:     false,
+					},
+				},
+			},
+		},
 	}
 
 	// this is a temporary hack to enable us to gradually add provider blocks to test configurations
@@ -496,6 +517,9 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 			if v, ok := cognitiveRaw["purge_soft_delete_on_destroy"]; ok {
 				featuresMap.CognitiveAccount.PurgeSoftDeleteOnDestroy = v.(bool)
 			}
+			if v, ok := cognitiveRaw["recover_soft_deleted"]; ok {
+				featuresMap.CognitiveAccount.RecoverSoftDeleted = v.(bool)
+			}
 		}
 	}
 
@@ -695,5 +719,15 @@ func expandFeatures(input []interface{}) features.UserFeatures {
 		}
 	}
 
+	if raw, ok := val["iothub"]; ok {
+		items := raw.([]interface{})
+		if len(items) > 0 {
+			iothubRaw := items[0].(map[string]interface{})
+			if v, ok := iothubRaw["use_separate_routing_resources"]; ok {
+				featuresMap.IoTHub.UseSeparateRoutingResources = v.(bool)
+			}
+		}
+	}
+
 	return featuresMap
 }
@@ -34,6 +34,7 @@ func TestExpandFeatures(t *testing.T) {
 				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
 				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   true,
@@ -96,6 +97,9 @@ func TestExpandFeatures(t *testing.T) {
 					DeleteBackupsOnBackupVaultDestroy: false,
 					PreventVolumeDestruction:          true,
 				},
+				IoTHub: features.IoTHubFeatures{
+					UseSeparateRoutingResources: false,
+				},
 			},
 		},
 		{
@@ -122,6 +126,7 @@ func TestExpandFeatures(t *testing.T) {
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": true,
+							"recover_soft_deleted":         true,
 						},
 					},
 					"key_vault": []interface{}{
@@ -213,6 +218,11 @@ func TestExpandFeatures(t *testing.T) {
 							"prevent_volume_destruction":             true,
 						},
 					},
+					"iothub": []interface{}{
+						map[string]interface{}{
+							"use_separate_routing_resources": true,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -229,6 +239,7 @@ func TestExpandFeatures(t *testing.T) {
 				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
 				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   true,
@@ -291,6 +302,9 @@ func TestExpandFeatures(t *testing.T) {
 					DeleteBackupsOnBackupVaultDestroy: true,
 					PreventVolumeDestruction:          true,
 				},
+				IoTHub: features.IoTHubFeatures{
+					UseSeparateRoutingResources: true,
+				},
 			},
 		},
 		{
@@ -317,6 +331,7 @@ func TestExpandFeatures(t *testing.T) {
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": false,
+							"recover_soft_deleted":         false,
 						},
 					},
 					"key_vault": []interface{}{
@@ -408,6 +423,11 @@ func TestExpandFeatures(t *testing.T) {
 							"prevent_volume_destruction":             false,
 						},
 					},
+					"iothub": []interface{}{
+						map[string]interface{}{
+							"use_separate_routing_resources": false,
+						},
+					},
 				},
 			},
 			Expected: features.UserFeatures{
@@ -424,6 +444,7 @@ func TestExpandFeatures(t *testing.T) {
 				},
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: false,
+					RecoverSoftDeleted:       false,
 				},
 				KeyVault: features.KeyVaultFeatures{
 					PurgeSoftDeletedCertsOnDestroy:   false,
@@ -486,6 +507,9 @@ func TestExpandFeatures(t *testing.T) {
 					DeleteBackupsOnBackupVaultDestroy: false,
 					PreventVolumeDestruction:          false,
 				},
+				IoTHub: features.IoTHubFeatures{
+					UseSeparateRoutingResources: false,
+				},
 			},
 		},
 	}
@@ -721,6 +745,7 @@ func TestExpandFeaturesCognitiveServices(t *testing.T) {
 			Expected: features.UserFeatures{
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
 				},
 			},
 		},
@@ -731,6 +756,7 @@ func TestExpandFeaturesCognitiveServices(t *testing.T) {
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": true,
+							"recover_soft_deleted":         true,
 						},
 					},
 				},
@@ -738,6 +764,7 @@ func TestExpandFeaturesCognitiveServices(t *testing.T) {
 			Expected: features.UserFeatures{
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       true,
 				},
 			},
 		},
@@ -748,6 +775,7 @@ func TestExpandFeaturesCognitiveServices(t *testing.T) {
 					"cognitive_account": []interface{}{
 						map[string]interface{}{
 							"purge_soft_delete_on_destroy": false,
+							"recover_soft_deleted":         true,
 						},
 					},
 				},
@@ -755,6 +783,26 @@ func TestExpandFeaturesCognitiveServices(t *testing.T) {
 			Expected: features.UserFeatures{
 				CognitiveAccount: features.CognitiveAccountFeatures{
 					PurgeSoftDeleteOnDestroy: false,
+					RecoverSoftDeleted:       true,
+				},
+			},
+		},
+		{
+			Name: "Recover Soft Deleted Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"cognitive_account": []interface{}{
+						map[string]interface{}{
+							"purge_soft_delete_on_destroy": true,
+							"recover_soft_deleted":         false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				CognitiveAccount: features.CognitiveAccountFeatures{
+					PurgeSoftDeleteOnDestroy: true,
+					RecoverSoftDeleted:       false,
 				},
 			},
 		},
@@ -1868,3 +1916,68 @@ func TestExpandFeaturesNetApp(t *testing.T) {
 		}
 	}
 }
+
+func TestExpandFeaturesIoTHub(t *testing.T) {
+	testData := []struct {
+		Name     string
+		Input    []interface{}
+		EnvVars  map[string]interface{}
+		Expected features.UserFeatures
+	}{
+		{
+			Name: "Empty Block",
+			Input: []interface{}{
+				map[string]interface{}{
+					"iothub": []interface{}{},
+				},
+			},
+			Expected: features.UserFeatures{
+				IoTHub: features.IoTHubFeatures{
+					UseSeparateRoutingResources: false,
+				},
+			},
+		},
+		{
+			Name: "Use Separate Routing Resources Enabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"iothub": []interface{}{
+						map[string]interface{}{
+							"use_separate_routing_resources": true,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				IoTHub: features.IoTHubFeatures{
+					UseSeparateRoutingResources: true,
+				},
+			},
+		},
+		{
+			Name: "Use Separate Routing Resources Disabled",
+			Input: []interface{}{
+				map[string]interface{}{
+					"iothub": []interface{}{
+						map[string]interface{}{
+							"use_separate_routing_resources": false,
+						},
+					},
+				},
+			},
+			Expected: features.UserFeatures{
+				IoTHub: features.IoTHubFeatures{
+					UseSeparateRoutingResources: false,
+				},
+			},
+		},
+	}
+
+	for _, testCase := range testData {
+		t.Logf("[DEBUG] Test Case: %q", testCase.Name)
+		result := expandFeatures(testCase.Input)
+		if !reflect.DeepEqual(result.IoTHub, testCase.Expected.IoTHub) {
+			t.Fatalf("Expected %+v but got %+v", result.IoTHub, testCase.Expected.IoTHub)
+		}
+	}
+}
@@ -209,8 +209,14 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 			if !feature[0].PurgeSoftDeleteOnDestroy.IsNull() && !feature[0].PurgeSoftDeleteOnDestroy.IsUnknown() {
 				f.CognitiveAccount.PurgeSoftDeleteOnDestroy = feature[0].PurgeSoftDeleteOnDestroy.ValueBool()
 			}
+
+			f.CognitiveAccount.RecoverSoftDeleted = true
+			if !feature[0].RecoverSoftDeleted.IsNull() && !feature[0].RecoverSoftDeleted.IsUnknown() {
+				f.CognitiveAccount.RecoverSoftDeleted = feature[0].RecoverSoftDeleted.ValueBool()
+			}
 		} else {
 			f.CognitiveAccount.PurgeSoftDeleteOnDestroy = true
+			f.CognitiveAccount.RecoverSoftDeleted = true
 		}
 
 		if !features.KeyVault.IsNull() && !features.KeyVault.IsUnknown() {
@@ -508,6 +514,22 @@ func (p *ProviderConfig) Load(ctx context.Context, data *ProviderModel, tfVersio
 			f.NetApp.DeleteBackupsOnBackupVaultDestroy = false
 			f.NetApp.PreventVolumeDestruction = true
 		}
+
+		if !features.IoTHub.IsNull() && !features.IoTHub.IsUnknown() {
+			var feature []IoTHub
+			d := features.IoTHub.ElementsAs(ctx, &feature, true)
+			diags.Append(d...)
+			if diags.HasError() {
+				return
+			}
+
+			f.IoTHub.UseSeparateRoutingResources = false
+			if !feature[0].UseSeparateRoutingResources.IsNull() && !feature[0].UseSeparateRoutingResources.IsUnknown() {
+				f.IoTHub.UseSeparateRoutingResources = feature[0].UseSeparateRoutingResources.ValueBool()
+			}
+		} else {
+			f.IoTHub.UseSeparateRoutingResources = false
+		}
 	}
 
 	p.clientBuilder.Features = f
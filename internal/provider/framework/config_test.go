@@ -91,6 +91,10 @@ func TestProviderConfig_LoadDefault(t *testing.T) {
 		t.Errorf("expected cognitive_account.purge_soft_delete_on_destroy to be true")
 	}
 
+	if !features.CognitiveAccount.RecoverSoftDeleted {
+		t.Errorf("expected cognitive_account.recover_soft_deleted to be true")
+	}
+
 	if !features.KeyVault.PurgeSoftDeleteOnDestroy {
 		t.Errorf("expected key_vault.purge_soft_delete_on_destroy to be true")
 	}
@@ -214,6 +218,10 @@ func TestProviderConfig_LoadDefault(t *testing.T) {
 	if !features.NetApp.PreventVolumeDestruction {
 		t.Errorf("expected netapp.PreventVolumeDestruction to be true")
 	}
+
+	if features.IoTHub.UseSeparateRoutingResources {
+		t.Errorf("expected iothub.UseSeparateRoutingResources to be false")
+	}
 }
 
 // TODO - helper functions to make setting up test date more easily so we can add more configuration coverage
@@ -238,6 +246,7 @@ func defaultFeaturesList() types.List {
 
 	cognitiveAccount, _ := basetypes.NewObjectValueFrom(context.Background(), CognitiveAccountAttributes, map[string]attr.Value{
 		"purge_soft_delete_on_destroy": basetypes.NewBoolNull(),
+		"recover_soft_deleted":         basetypes.NewBoolNull(),
 	})
 	cognitiveAccountList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(CognitiveAccountAttributes), []attr.Value{cognitiveAccount})
 
@@ -329,6 +338,11 @@ func defaultFeaturesList() types.List {
 	})
 	netappList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(NetAppAttributes), []attr.Value{netapp})
 
+	iothub, _ := basetypes.NewObjectValueFrom(context.Background(), IoTHubAttributes, map[string]attr.Value{
+		"use_separate_routing_resources": basetypes.NewBoolNull(),
+	})
+	iothubList, _ := basetypes.NewListValue(types.ObjectType{}.WithAttributeTypes(IoTHubAttributes), []attr.Value{iothub})
+
 	fData, d := basetypes.NewObjectValue(FeaturesAttributes, map[string]attr.Value{
 		"api_management":             apiManagementList,
 		"app_configuration":          appConfigurationList,
@@ -348,6 +362,7 @@ func defaultFeaturesList() types.List {
 		"recovery_service":           recoveryServicesList,
 		"recovery_services_vaults":   recoveryServicesVaultsList,
 		"netapp":                     netappList,
+		"iothub":                     iothubList,
 	})
 
 	fmt.Printf("%+v", d)
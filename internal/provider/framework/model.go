@@ -60,6 +60,7 @@ type Features struct {
 	RecoveryService          types.List `tfsdk:"recovery_service"`
 	RecoveryServicesVaults   types.List `tfsdk:"recovery_services_vaults"`
 	NetApp                   types.List `tfsdk:"netapp"`
+	IoTHub                   types.List `tfsdk:"iothub"`
 }
 
 // FeaturesAttributes and the other block attribute vars are required for unit testing on the Load func
@@ -83,6 +84,7 @@ var FeaturesAttributes = map[string]attr.Type{
 	"recovery_service":           types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceAttributes)),
 	"recovery_services_vaults":   types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(RecoveryServiceVaultsAttributes)),
 	"netapp":                     types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(NetAppAttributes)),
+	"iothub":                     types.ListType{}.WithElementType(types.ObjectType{}.WithAttributeTypes(IoTHubAttributes)),
 }
 
 type APIManagement struct {
@@ -115,10 +117,12 @@ var ApplicationInsightsAttributes = map[string]attr.Type{
 
 type CognitiveAccount struct {
 	PurgeSoftDeleteOnDestroy types.Bool `tfsdk:"purge_soft_delete_on_destroy"`
+	RecoverSoftDeleted       types.Bool `tfsdk:"recover_soft_deleted"`
 }
 
 var CognitiveAccountAttributes = map[string]attr.Type{
 	"purge_soft_delete_on_destroy": types.BoolType,
+	"recover_soft_deleted":         types.BoolType,
 }
 
 type KeyVault struct {
@@ -270,3 +274,11 @@ var NetAppAttributes = map[string]attr.Type{
 	"delete_backups_on_backup_vault_destroy": types.BoolType,
 	"prevent_volume_destruction":             types.BoolType,
 }
+
+type IoTHub struct {
+	UseSeparateRoutingResources types.Bool `tfsdk:"use_separate_routing_resources"`
+}
+
+var IoTHubAttributes = map[string]attr.Type{
+	"use_separate_routing_resources": types.BoolType,
+}
@@ -282,6 +282,9 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 									"purge_soft_delete_on_destroy": schema.BoolAttribute{
 										Optional: true,
 									},
+									"recover_soft_deleted": schema.BoolAttribute{
+										Optional: true,
+									},
 								},
 							},
 						},
@@ -491,6 +494,16 @@ func (p *azureRmFrameworkProvider) Schema(_ context.Context, _ provider.SchemaRe
 								},
 							},
 						},
+						"iothub": schema.ListNestedBlock{
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"use_separate_routing_resources": schema.BoolAttribute{
+										Description: "When enabled, the `route`, `fallback_route` and `enrichment` blocks of the `azurerm_iothub` resource are ignored, so that message routing can be managed using the separate routing resources",
+										Optional:    true,
+									},
+								},
+							},
+						},
 					},
 				},
 			},
@@ -125,6 +125,7 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				panic(fmt.Sprintf("An existing Data Source exists for %q", k))
 			}
 
+			enrichUntypedResourceErrors(k, v)
 			dataSources[k] = v
 		}
 
@@ -134,6 +135,7 @@ func azureProvider(supportLegacyTestSuite bool) *schema.Provider {
 				panic(fmt.Sprintf("An existing Resource exists for %q", k))
 			}
 
+			enrichUntypedResourceErrors(k, v)
 			resources[k] = v
 		}
 	}
@@ -72,5 +72,5 @@ and we recommend using the %[2]q datasource instead.
 }
 
 func (dw *DataSourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) schema.ReadContextFunc {
-	return diagnosticsWrapper(in, dw.logger)
+	return diagnosticsWrapper(in, dw.dataSource.ResourceType(), dw.logger)
 }
@@ -184,13 +184,14 @@ and we recommend using the %[2]q resource instead.
 }
 
 func (rw *ResourceWrapper) diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
-	return diagnosticsWrapper(in, rw.logger)
+	return diagnosticsWrapper(in, rw.resource.ResourceType(), rw.logger)
 }
 
-func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error, logger Logger) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+func diagnosticsWrapper(in func(ctx context.Context, d *schema.ResourceData, meta interface{}) error, resourceType string, logger Logger) func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	return func(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 		out := make([]diag.Diagnostic, 0)
 		if err := in(ctx, d, meta); err != nil {
+			err = clients.EnrichArmError(err, resourceType, d.Id())
 			out = append(out, diag.Diagnostic{
 				Severity:      diag.Error,
 				Summary:       err.Error(),
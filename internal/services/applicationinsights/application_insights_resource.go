@@ -4,7 +4,9 @@
 package applicationinsights
 
 import (
+	"context"
 	"fmt"
+	"log"
 	"strings"
 	"time"
 
@@ -215,8 +217,10 @@ func resourceApplicationInsightsCreateUpdate(d *pluginsdk.ResourceData, meta int
 		ForceCustomerStorageForProfiler: pointer.To(d.Get("force_customer_storage_for_profiler").(bool)),
 	}
 
+	migratingToWorkspace := false
 	if !d.IsNewResource() {
 		oldWorkspaceId, newWorkspaceId := d.GetChange("workspace_id")
+		migratingToWorkspace = oldWorkspaceId.(string) == "" && newWorkspaceId.(string) != ""
 		if oldWorkspaceId.(string) != "" && newWorkspaceId.(string) == "" {
 			return fmt.Errorf("`workspace_id` cannot be removed after set. If `workspace_id` is not specified but you encounter a diff, this might indicate a Microsoft initiated automatic migration from classic resources to workspace-based resources. If this is the case, please update `workspace_id` in your config file to the new value.")
 		}
@@ -228,6 +232,7 @@ func resourceApplicationInsightsCreateUpdate(d *pluginsdk.ResourceData, meta int
 			return err
 		}
 		applicationInsightsComponentProperties.WorkspaceResourceId = pointer.To(workspaceID.ID())
+		applicationInsightsComponentProperties.IngestionMode = pointer.To(components.IngestionModeLogAnalytics)
 	}
 
 	if v, ok := d.GetOk("retention_in_days"); ok {
@@ -247,6 +252,22 @@ func resourceApplicationInsightsCreateUpdate(d *pluginsdk.ResourceData, meta int
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
+	// migrating a classic Application Insights to a workspace-based one happens in-place, however the ingestion mode
+	// is switched over asynchronously - so we need to wait for this to complete before continuing
+	if migratingToWorkspace {
+		log.Printf("[DEBUG] Waiting for %s to be migrated to a workspace-based Application Insights..", id)
+		stateConf := &pluginsdk.StateChangeConf{
+			Pending:    []string{string(components.IngestionModeApplicationInsights), string(components.IngestionModeApplicationInsightsWithDiagnosticSettings)},
+			Target:     []string{string(components.IngestionModeLogAnalytics)},
+			Refresh:    applicationInsightsIngestionModeRefreshFunc(ctx, client, id),
+			MinTimeout: 15 * time.Second,
+			Timeout:    d.Timeout(pluginsdk.TimeoutUpdate),
+		}
+		if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+			return fmt.Errorf("waiting for %s to be migrated to a workspace-based Application Insights: %+v", id, err)
+		}
+	}
+
 	read, err := client.ComponentsGet(ctx, id)
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", id, err)
@@ -443,3 +464,19 @@ func resourceApplicationInsightsDelete(d *pluginsdk.ResourceData, meta interface
 
 	return err
 }
+
+func applicationInsightsIngestionModeRefreshFunc(ctx context.Context, client *components.ComponentsAPIsClient, id components.ComponentId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.ComponentsGet(ctx, id)
+		if err != nil {
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		ingestionMode := components.IngestionModeApplicationInsights
+		if model := resp.Model; model != nil && model.Properties != nil && model.Properties.IngestionMode != nil {
+			ingestionMode = *model.Properties.IngestionMode
+		}
+
+		return resp, string(ingestionMode), nil
+	}
+}
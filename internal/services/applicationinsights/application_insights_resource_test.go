@@ -171,6 +171,29 @@ func TestAccApplicationInsights_basicWorkspaceMode(t *testing.T) {
 	})
 }
 
+func TestAccApplicationInsights_migrateToWorkspaceMode(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights", "test")
+	r := AppInsightsResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "web"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basicWorkspaceMode(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("workspace_id").IsSet(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t AppInsightsResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := components.ParseComponentID(state.ID)
 	if err != nil {
@@ -4,8 +4,11 @@
 package applicationinsights
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"os"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -28,6 +31,7 @@ type ApplicationInsightsWorkbookModel struct {
 	DisplayName        string            `tfschema:"display_name"`
 	Location           string            `tfschema:"location"`
 	DataJson           string            `tfschema:"data_json"`
+	DataJsonFile       string            `tfschema:"data_json_file"`
 	SourceId           string            `tfschema:"source_id"`
 	StorageContainerId string            `tfschema:"storage_container_id"`
 	Tags               map[string]string `tfschema:"tags"`
@@ -35,7 +39,10 @@ type ApplicationInsightsWorkbookModel struct {
 
 type ApplicationInsightsWorkbookResource struct{}
 
-var _ sdk.ResourceWithUpdate = ApplicationInsightsWorkbookResource{}
+var (
+	_ sdk.ResourceWithUpdate        = ApplicationInsightsWorkbookResource{}
+	_ sdk.ResourceWithCustomizeDiff = ApplicationInsightsWorkbookResource{}
+)
 
 func (r ApplicationInsightsWorkbookResource) ResourceType() string {
 	return "azurerm_application_insights_workbook"
@@ -73,9 +80,18 @@ func (r ApplicationInsightsWorkbookResource) Arguments() map[string]*pluginsdk.S
 
 		"data_json": {
 			Type:             pluginsdk.TypeString,
-			Required:         true,
+			Optional:         true,
+			Computed:         true,
 			ValidateFunc:     validation.StringIsJSON,
 			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			ExactlyOneOf:     []string{"data_json", "data_json_file"},
+		},
+
+		"data_json_file": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"data_json", "data_json_file"},
 		},
 
 		"source_id": {
@@ -292,6 +308,7 @@ func (r ApplicationInsightsWorkbookResource) Read() sdk.ResourceFunc {
 				state.DisplayName = properties.DisplayName
 
 				state.DataJson = properties.SerializedData
+				state.DataJsonFile = metadata.ResourceData.Get("data_json_file").(string)
 
 				if properties.SourceId != nil {
 					state.SourceId = *properties.SourceId
@@ -313,6 +330,38 @@ func (r ApplicationInsightsWorkbookResource) Read() sdk.ResourceFunc {
 	}
 }
 
+func (r ApplicationInsightsWorkbookResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			path := metadata.ResourceDiff.Get("data_json_file").(string)
+			if path == "" {
+				return nil
+			}
+
+			contents, err := os.ReadFile(path)
+			if err != nil {
+				return fmt.Errorf("reading `data_json_file` %q: %+v", path, err)
+			}
+
+			dataJson, err := normalizeWorkbookDataJson(contents)
+			if err != nil {
+				return fmt.Errorf("parsing `data_json_file` %q: %+v", path, err)
+			}
+
+			// the content of the file is compared semantically with the existing `data_json`, such that differences in
+			// encoding, whitespace or line endings don't result in a perpetual diff
+			if existing := metadata.ResourceDiff.Get("data_json").(string); existing != "" {
+				if normalized, err := normalizeWorkbookDataJson([]byte(existing)); err == nil && normalized == dataJson {
+					return nil
+				}
+			}
+
+			return metadata.ResourceDiff.SetNew("data_json", dataJson)
+		},
+	}
+}
+
 func (r ApplicationInsightsWorkbookResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -332,3 +381,23 @@ func (r ApplicationInsightsWorkbookResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+// normalizeWorkbookDataJson removes any UTF-8 Byte Order Mark (as added by some editors) from the input and returns
+// the JSON in a compact form, without escaping HTML characters which are common in Workbook content.
+func normalizeWorkbookDataJson(input []byte) (string, error) {
+	input = bytes.TrimPrefix(input, []byte("\xef\xbb\xbf"))
+
+	var v interface{}
+	if err := json.Unmarshal(input, &v); err != nil {
+		return "", err
+	}
+
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(v); err != nil {
+		return "", err
+	}
+
+	return string(bytes.TrimSuffix(buf.Bytes(), []byte("\n"))), nil
+}
@@ -115,6 +115,27 @@ func TestAccApplicationInsightsWorkbook_hiddenTitleInTags(t *testing.T) {
 	})
 }
 
+func TestAccApplicationInsightsWorkbook_dataJsonFile(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_application_insights_workbook", "test")
+	r := ApplicationInsightsWorkbookResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.dataJsonFile(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("data_json_file"),
+		{
+			Config: r.basic(data, data.RandomInteger),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r ApplicationInsightsWorkbookResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := workbooks.ParseWorkbookID(state.ID)
 	if err != nil {
@@ -208,6 +229,21 @@ resource "azurerm_application_insights_workbook" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r ApplicationInsightsWorkbookResource) dataJsonFile(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_application_insights_workbook" "test" {
+  name                = "be1ad266-d329-4454-b693-8287e4d3b35d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  display_name        = "acctest-amw-%d"
+  data_json_file      = "testdata/workbook.json"
+}
+`, template, data.RandomInteger)
+}
+
 func (r ApplicationInsightsWorkbookResource) requiresImport(data acceptance.TestData) string {
 	config := r.basic(data, data.RandomInteger)
 	return fmt.Sprintf(`
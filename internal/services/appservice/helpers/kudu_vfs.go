@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-12-01/webapps"
+)
+
+// KuduVFSClient is a minimal client for the Kudu (SCM site) Virtual File System API, used to manage files in a
+// Site which are not exposed via Resource Manager, such as the workflow definitions of a Logic App Standard.
+type KuduVFSClient struct {
+	host      string
+	user      string
+	password  string
+	userAgent string
+}
+
+// NewKuduVFSClientForSite looks up the SCM Host Name and Publishing Credentials for the specified Site and returns
+// a KuduVFSClient configured to talk to it.
+func NewKuduVFSClientForSite(ctx context.Context, client *webapps.WebAppsClient, id commonids.AppServiceId) (*KuduVFSClient, error) {
+	site, err := client.Get(ctx, id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+	if site.Model == nil || site.Model.Properties == nil {
+		return nil, fmt.Errorf("retrieving %s: `model` or `properties` was nil", id)
+	}
+
+	scmHost := ""
+	if sslStates := site.Model.Properties.HostNameSslStates; sslStates != nil {
+		for _, v := range *sslStates {
+			if pointer.From(v.Name) != "" && pointer.From(v.HostType) == webapps.HostTypeRepository {
+				scmHost = *v.Name
+				break
+			}
+		}
+	}
+	if scmHost == "" {
+		return nil, fmt.Errorf("could not determine SCM Site name for %s", id)
+	}
+
+	user, password, err := GetSitePublishingCredentials(ctx, client, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &KuduVFSClient{
+		host:      fmt.Sprintf("https://%s", scmHost),
+		user:      pointer.From(user),
+		password:  pointer.From(password),
+		userAgent: client.Client.UserAgent,
+	}, nil
+}
+
+// GetFile returns the contents of the file at the specified path (relative to the Site root), or `nil` if the file
+// does not exist.
+func (c KuduVFSClient) GetFile(ctx context.Context, path string) (*[]byte, error) {
+	resp, err := c.do(ctx, http.MethodGet, c.fileEndpoint(path), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("retrieving file %q: unexpected status %s", path, resp.Status)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("reading response body for file %q: %+v", path, err)
+	}
+
+	return &body, nil
+}
+
+// PutFile creates or overwrites the file at the specified path (relative to the Site root), creating any missing
+// parent directories.
+func (c KuduVFSClient) PutFile(ctx context.Context, path string, content []byte) error {
+	resp, err := c.do(ctx, http.MethodPut, c.fileEndpoint(path), content)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated && resp.StatusCode != http.StatusNoContent {
+		return fmt.Errorf("uploading file %q: unexpected status %s", path, resp.Status)
+	}
+
+	return nil
+}
+
+// DeleteDirectory recursively deletes the directory at the specified path (relative to the Site root).
+func (c KuduVFSClient) DeleteDirectory(ctx context.Context, path string) error {
+	endpoint := fmt.Sprintf("%s/?recursive=true", c.fileEndpoint(strings.TrimSuffix(path, "/")))
+	resp, err := c.do(ctx, http.MethodDelete, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("deleting directory %q: unexpected status %s", path, resp.Status)
+	}
+
+	return nil
+}
+
+func (c KuduVFSClient) fileEndpoint(path string) string {
+	return fmt.Sprintf("%s/api/vfs/site/wwwroot/%s", c.host, strings.TrimPrefix(path, "/"))
+}
+
+func (c KuduVFSClient) do(ctx context.Context, method string, endpoint string, body []byte) (*http.Response, error) {
+	var reader io.Reader = http.NoBody
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, reader)
+	if err != nil {
+		return nil, fmt.Errorf("preparing %s request for %q: %+v", method, endpoint, err)
+	}
+
+	req.SetBasicAuth(c.user, c.password)
+	req.Header["Cache-Control"] = []string{"no-cache"}
+	req.Header["User-Agent"] = []string{c.userAgent}
+	// the VFS API requires an `If-Match` header to overwrite or delete existing files
+	if method == http.MethodPut || method == http.MethodDelete {
+		req.Header["If-Match"] = []string{"*"}
+	}
+	if method == http.MethodPut {
+		req.Header["Content-Type"] = []string{"application/octet-stream"}
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("sending %s request for %q: %+v", method, endpoint, err)
+	}
+
+	return resp, nil
+}
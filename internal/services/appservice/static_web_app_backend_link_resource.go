@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/staticsites"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StaticWebAppBackendLinkResource struct{}
+
+var _ sdk.Resource = StaticWebAppBackendLinkResource{}
+
+type StaticWebAppBackendLinkModel struct {
+	Name              string `tfschema:"name"`
+	StaticWebAppID    string `tfschema:"static_web_app_id"`
+	BackendResourceID string `tfschema:"backend_resource_id"`
+	Location          string `tfschema:"location"`
+}
+
+func (r StaticWebAppBackendLinkResource) ResourceType() string {
+	return "azurerm_static_web_app_backend_link"
+}
+
+func (r StaticWebAppBackendLinkResource) ModelObject() interface{} {
+	return &StaticWebAppBackendLinkModel{}
+}
+
+func (r StaticWebAppBackendLinkResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return staticsites.ValidateLinkedBackendID
+}
+
+func (r StaticWebAppBackendLinkResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"static_web_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: staticsites.ValidateStaticSiteID,
+		},
+
+		"backend_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: azure.ValidateResourceID,
+		},
+
+		"location": commonschema.Location(),
+	}
+}
+
+func (r StaticWebAppBackendLinkResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StaticWebAppBackendLinkResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.StaticSitesClient
+
+			model := StaticWebAppBackendLinkModel{}
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			staticAppId, err := staticsites.ParseStaticSiteID(model.StaticWebAppID)
+			if err != nil {
+				return err
+			}
+
+			id := staticsites.NewLinkedBackendID(staticAppId.SubscriptionId, staticAppId.ResourceGroupName, staticAppId.StaticSiteName, model.Name)
+
+			existing, err := client.GetLinkedBackend(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := staticsites.StaticSiteLinkedBackendARMResource{
+				Properties: &staticsites.StaticSiteLinkedBackendARMResourceProperties{
+					BackendResourceId: pointer.To(model.BackendResourceID),
+					Region:            pointer.To(location.Normalize(model.Location)),
+				},
+			}
+
+			if err = client.LinkBackendThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r StaticWebAppBackendLinkResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.StaticSitesClient
+
+			id, err := staticsites.ParseLinkedBackendID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			result, err := client.GetLinkedBackend(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(result.HttpResponse) {
+					return metadata.MarkAsGone(*id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := StaticWebAppBackendLinkModel{
+				Name:           id.LinkedBackendName,
+				StaticWebAppID: staticsites.NewStaticSiteID(id.SubscriptionId, id.ResourceGroupName, id.StaticSiteName).ID(),
+			}
+
+			if model := result.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.BackendResourceID = pointer.From(props.BackendResourceId)
+					state.Location = location.Normalize(pointer.From(props.Region))
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StaticWebAppBackendLinkResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.StaticSitesClient
+
+			id, err := staticsites.ParseLinkedBackendID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.UnlinkBackend(ctx, *id, staticsites.DefaultUnlinkBackendOperationOptions()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
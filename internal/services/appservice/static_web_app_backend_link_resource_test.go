@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package appservice_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/web/2023-01-01/staticsites"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StaticWebAppBackendLinkResource struct{}
+
+func TestStaticWebAppBackendLinkResource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_web_app_backend_link", "test")
+	r := StaticWebAppBackendLinkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestStaticWebAppBackendLinkResource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_static_web_app_backend_link", "test")
+	r := StaticWebAppBackendLinkResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r StaticWebAppBackendLinkResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := staticsites.ParseLinkedBackendID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.AppService.StaticSitesClient.GetLinkedBackend(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %q: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r StaticWebAppBackendLinkResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_static_web_app_backend_link" "test" {
+  name                = "backend1"
+  static_web_app_id   = azurerm_static_web_app.test.id
+  backend_resource_id = azurerm_linux_function_app.test.id
+  location            = azurerm_linux_function_app.test.location
+}
+`, StaticWebAppFunctionAppRegistrationResource{}.template(data))
+}
+
+func (r StaticWebAppBackendLinkResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_static_web_app_backend_link" "import" {
+  name                = azurerm_static_web_app_backend_link.test.name
+  static_web_app_id   = azurerm_static_web_app_backend_link.test.static_web_app_id
+  backend_resource_id = azurerm_static_web_app_backend_link.test.backend_resource_id
+  location            = azurerm_static_web_app_backend_link.test.location
+}
+`, r.basic(data))
+}
@@ -38,6 +38,7 @@ type StaticWebAppResourceModel struct {
 	AppSettings         map[string]string                          `tfschema:"app_settings"`
 	BasicAuth           []helpers.BasicAuth                        `tfschema:"basic_auth"`
 	ConfigFileChanges   bool                                       `tfschema:"configuration_file_changes_enabled"`
+	EnterpriseGradeCdn  bool                                       `tfschema:"enterprise_grade_cdn_enabled"`
 	Identity            []identity.ModelSystemAssignedUserAssigned `tfschema:"identity"`
 	PreviewEnvironments bool                                       `tfschema:"preview_environments_enabled"`
 	PublicNetworkAccess bool                                       `tfschema:"public_network_access_enabled"`
@@ -72,6 +73,12 @@ func (r StaticWebAppResource) Arguments() map[string]*pluginsdk.Schema {
 			Default:  true,
 		},
 
+		"enterprise_grade_cdn_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
 		"preview_environments_enabled": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
@@ -233,6 +240,10 @@ func (r StaticWebAppResource) Create() sdk.ResourceFunc {
 				props.PublicNetworkAccess = pointer.To(helpers.PublicNetworkAccessDisabled)
 			}
 
+			if model.EnterpriseGradeCdn {
+				props.EnterpriseGradeCdnStatus = pointer.To(staticsites.EnterpriseGradeCdnStatusEnabled)
+			}
+
 			envelope.Properties = props
 
 			if err := client.CreateOrUpdateStaticSiteThenPoll(ctx, id, envelope); err != nil {
@@ -311,6 +322,9 @@ func (r StaticWebAppResource) Read() sdk.ResourceFunc {
 				if props := model.Properties; props != nil {
 					state.ConfigFileChanges = pointer.From(props.AllowConfigFileUpdates)
 					state.DefaultHostName = pointer.From(props.DefaultHostname)
+					// the status may be transitioning (`Enabling`/`Disabling`) immediately after the change is made
+					cdnStatus := pointer.From(props.EnterpriseGradeCdnStatus)
+					state.EnterpriseGradeCdn = cdnStatus == staticsites.EnterpriseGradeCdnStatusEnabled || cdnStatus == staticsites.EnterpriseGradeCdnStatusEnabling
 					state.PreviewEnvironments = pointer.From(props.StagingEnvironmentPolicy) == staticsites.StagingEnvironmentPolicyEnabled
 
 					state.RepositoryUrl = pointer.From(props.RepositoryURL)
@@ -439,6 +453,14 @@ func (r StaticWebAppResource) Update() sdk.ResourceFunc {
 				model.Properties.AllowConfigFileUpdates = pointer.To(config.ConfigFileChanges)
 			}
 
+			if metadata.ResourceData.HasChange("enterprise_grade_cdn_enabled") {
+				if config.EnterpriseGradeCdn {
+					model.Properties.EnterpriseGradeCdnStatus = pointer.To(staticsites.EnterpriseGradeCdnStatusEnabled)
+				} else {
+					model.Properties.EnterpriseGradeCdnStatus = pointer.To(staticsites.EnterpriseGradeCdnStatusDisabled)
+				}
+			}
+
 			if metadata.ResourceData.HasChange("preview_environments_enabled") {
 				if !config.PreviewEnvironments {
 					model.Properties.StagingEnvironmentPolicy = pointer.To(staticsites.StagingEnvironmentPolicyDisabled)
@@ -525,6 +547,9 @@ func (r StaticWebAppResource) CustomizeDiff() sdk.ResourceFunc {
 				if identOk && len(ident.([]interface{})) > 0 {
 					return fmt.Errorf("identities cannot be used with the Free tier of Static Web Apps")
 				}
+				if rd.Get("enterprise_grade_cdn_enabled").(bool) {
+					return fmt.Errorf("enterprise_grade_cdn_enabled cannot be used with the Free tier of Static Web Apps")
+				}
 			}
 
 			return nil
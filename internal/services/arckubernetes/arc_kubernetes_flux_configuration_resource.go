@@ -46,12 +46,17 @@ type AzureBlobDefinitionModel struct {
 	AccountKey            string                            `tfschema:"account_key"`
 	ContainerID           string                            `tfschema:"container_id"`
 	LocalAuthRef          string                            `tfschema:"local_auth_reference"`
+	ManagedIdentity       []ManagedIdentityDefinitionModel  `tfschema:"managed_identity"`
 	SasToken              string                            `tfschema:"sas_token"`
 	ServicePrincipal      []ServicePrincipalDefinitionModel `tfschema:"service_principal"`
 	SyncIntervalInSeconds int64                             `tfschema:"sync_interval_in_seconds"`
 	TimeoutInSeconds      int64                             `tfschema:"timeout_in_seconds"`
 }
 
+type ManagedIdentityDefinitionModel struct {
+	ClientId string `tfschema:"client_id"`
+}
+
 type ServicePrincipalDefinitionModel struct {
 	ClientCertificate          string `tfschema:"client_certificate_base64"`
 	ClientCertificatePassword  string `tfschema:"client_certificate_password"`
@@ -101,6 +106,8 @@ type ArcKubernetesFluxConfigurationResource struct{}
 
 var _ sdk.ResourceWithUpdate = ArcKubernetesFluxConfigurationResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = ArcKubernetesFluxConfigurationResource{}
+
 func (r ArcKubernetesFluxConfigurationResource) ResourceType() string {
 	return "azurerm_arc_kubernetes_flux_configuration"
 }
@@ -245,14 +252,30 @@ func (r ArcKubernetesFluxConfigurationResource) Arguments() map[string]*pluginsd
 						Optional:     true,
 						Sensitive:    true,
 						ValidateFunc: validation.StringIsNotEmpty,
-						ExactlyOneOf: []string{"blob_storage.0.account_key", "blob_storage.0.local_auth_reference", "blob_storage.0.sas_token", "blob_storage.0.service_principal"},
+						ExactlyOneOf: []string{"blob_storage.0.account_key", "blob_storage.0.local_auth_reference", "blob_storage.0.managed_identity", "blob_storage.0.sas_token", "blob_storage.0.service_principal"},
 					},
 
 					"local_auth_reference": {
 						Type:         pluginsdk.TypeString,
 						Optional:     true,
 						ValidateFunc: validate.LocalAuthReference,
-						ExactlyOneOf: []string{"blob_storage.0.account_key", "blob_storage.0.local_auth_reference", "blob_storage.0.sas_token", "blob_storage.0.service_principal"},
+						ExactlyOneOf: []string{"blob_storage.0.account_key", "blob_storage.0.local_auth_reference", "blob_storage.0.managed_identity", "blob_storage.0.sas_token", "blob_storage.0.service_principal"},
+					},
+
+					"managed_identity": {
+						Type:         pluginsdk.TypeList,
+						Optional:     true,
+						MaxItems:     1,
+						ExactlyOneOf: []string{"blob_storage.0.account_key", "blob_storage.0.local_auth_reference", "blob_storage.0.managed_identity", "blob_storage.0.sas_token", "blob_storage.0.service_principal"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"client_id": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
 					},
 
 					"sas_token": {
@@ -260,14 +283,14 @@ func (r ArcKubernetesFluxConfigurationResource) Arguments() map[string]*pluginsd
 						Optional:     true,
 						Sensitive:    true,
 						ValidateFunc: validation.StringIsNotEmpty,
-						ExactlyOneOf: []string{"blob_storage.0.account_key", "blob_storage.0.local_auth_reference", "blob_storage.0.sas_token", "blob_storage.0.service_principal"},
+						ExactlyOneOf: []string{"blob_storage.0.account_key", "blob_storage.0.local_auth_reference", "blob_storage.0.managed_identity", "blob_storage.0.sas_token", "blob_storage.0.service_principal"},
 					},
 
 					"service_principal": {
 						Type:         pluginsdk.TypeList,
 						Optional:     true,
 						MaxItems:     1,
-						ExactlyOneOf: []string{"blob_storage.0.account_key", "blob_storage.0.local_auth_reference", "blob_storage.0.sas_token", "blob_storage.0.service_principal"},
+						ExactlyOneOf: []string{"blob_storage.0.account_key", "blob_storage.0.local_auth_reference", "blob_storage.0.managed_identity", "blob_storage.0.sas_token", "blob_storage.0.service_principal"},
 						Elem: &pluginsdk.Resource{
 							Schema: map[string]*pluginsdk.Schema{
 								"client_id": {
@@ -510,6 +533,32 @@ func (r ArcKubernetesFluxConfigurationResource) Arguments() map[string]*pluginsd
 	}
 }
 
+func (r ArcKubernetesFluxConfigurationResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if !metadata.ResourceDiff.HasChange("kustomizations") || !metadata.ResourceDiff.NewValueKnown("kustomizations") {
+				return nil
+			}
+
+			var model ArcKubernetesFluxConfigurationModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			kustomizations := make([]validate.FluxKustomization, 0)
+			for _, k := range model.Kustomizations {
+				kustomizations = append(kustomizations, validate.FluxKustomization{
+					Name:      k.Name,
+					DependsOn: k.DependsOn,
+				})
+			}
+
+			return validate.FluxKustomizationDependencies(kustomizations)
+		},
+	}
+}
+
 func (r ArcKubernetesFluxConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
@@ -523,10 +572,6 @@ func (r ArcKubernetesFluxConfigurationResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
-			if err := validateArcKubernetesFluxConfigurationModel(&model); err != nil {
-				return err
-			}
-
 			client := metadata.Client.ArcKubernetes.FluxConfigurationClient
 			subscriptionId := metadata.Client.Account.SubscriptionId
 			clusterID, err := arckubernetes.ParseConnectedClusterID(model.ClusterID)
@@ -606,10 +651,6 @@ func (r ArcKubernetesFluxConfigurationResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
-			if err := validateArcKubernetesFluxConfigurationModel(&model); err != nil {
-				return err
-			}
-
 			resp, err := client.Get(ctx, *id)
 			if err != nil {
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
@@ -767,6 +808,7 @@ func expandArcAzureBlobDefinitionModel(inputList []AzureBlobDefinitionModel, sto
 
 	input := &inputList[0]
 	output := fluxconfiguration.AzureBlobDefinition{
+		ManagedIdentity:       expandManagedIdentityDefinitionModel(input.ManagedIdentity),
 		ServicePrincipal:      expandServicePrincipalDefinitionModel(input.ServicePrincipal),
 		SyncIntervalInSeconds: &input.SyncIntervalInSeconds,
 		TimeoutInSeconds:      &input.TimeoutInSeconds,
@@ -796,6 +838,20 @@ func expandArcAzureBlobDefinitionModel(inputList []AzureBlobDefinitionModel, sto
 	return &output, nil
 }
 
+func expandManagedIdentityDefinitionModel(inputList []ManagedIdentityDefinitionModel) *fluxconfiguration.ManagedIdentityDefinition {
+	if len(inputList) == 0 {
+		return nil
+	}
+
+	input := &inputList[0]
+	output := fluxconfiguration.ManagedIdentityDefinition{}
+	if input.ClientId != "" {
+		output.ClientId = &input.ClientId
+	}
+
+	return &output
+}
+
 func expandKustomizationDefinitionModel(inputList []KustomizationDefinitionModel) *map[string]fluxconfiguration.KustomizationDefinition {
 	if len(inputList) == 0 {
 		return nil
@@ -983,6 +1039,7 @@ func flattenArcAzureBlobDefinitionModel(input *fluxconfiguration.AzureBlobDefini
 	output := AzureBlobDefinitionModel{
 		ContainerID:           id.ID(),
 		LocalAuthRef:          pointer.From(input.LocalAuthRef),
+		ManagedIdentity:       flattenManagedIdentityDefinitionModel(input.ManagedIdentity),
 		SyncIntervalInSeconds: pointer.From(input.SyncIntervalInSeconds),
 		TimeoutInSeconds:      pointer.From(input.TimeoutInSeconds),
 	}
@@ -999,6 +1056,18 @@ func flattenArcAzureBlobDefinitionModel(input *fluxconfiguration.AzureBlobDefini
 	return append(outputList, output), nil
 }
 
+func flattenManagedIdentityDefinitionModel(input *fluxconfiguration.ManagedIdentityDefinition) []ManagedIdentityDefinitionModel {
+	outputList := make([]ManagedIdentityDefinitionModel, 0)
+	if input == nil {
+		return outputList
+	}
+	output := ManagedIdentityDefinitionModel{
+		ClientId: pointer.From(input.ClientId),
+	}
+
+	return append(outputList, output)
+}
+
 func flattenKustomizationDefinitionModel(inputList *map[string]fluxconfiguration.KustomizationDefinition) []KustomizationDefinitionModel {
 	outputList := make([]KustomizationDefinitionModel, 0)
 	if inputList == nil {
@@ -1126,19 +1195,6 @@ func flattenRepositoryRefDefinitionModel(input *fluxconfiguration.RepositoryRefD
 	return referenceType, referenceValue, nil
 }
 
-func validateArcKubernetesFluxConfigurationModel(model *ArcKubernetesFluxConfigurationModel) error {
-	allKeys := make(map[string]bool)
-	for _, k := range model.Kustomizations {
-		if _, exists := allKeys[k.Name]; exists {
-			return fmt.Errorf("kustomization name `%s` is not unique", k.Name)
-		}
-
-		allKeys[k.Name] = true
-	}
-
-	return nil
-}
-
 func setConfigurationProtectedSettings(metadata sdk.ResourceMetaData, model ArcKubernetesFluxConfigurationModel, properties *fluxconfiguration.FluxConfiguration) error {
 	if _, exists := metadata.ResourceData.GetOk("git_repository"); exists {
 		_, configurationProtectedSettings, err := expandGitRepositoryDefinitionModel(model.GitRepository)
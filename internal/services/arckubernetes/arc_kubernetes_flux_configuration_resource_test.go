@@ -131,6 +131,21 @@ func TestAccArcKubernetesFluxConfiguration_azureBlobWithAccountKey(t *testing.T)
 	})
 }
 
+func TestAccArcKubernetesFluxConfiguration_azureBlobWithManagedIdentity(t *testing.T) {
+	credential, privateKey, publicKey := ArcKubernetesClusterResource{}.getCredentials(t)
+	data := acceptance.BuildTestData(t, "azurerm_arc_kubernetes_flux_configuration", "test")
+	r := ArcKubernetesFluxConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.azureBlobWithManagedIdentity(data, credential, privateKey, publicKey),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccArcKubernetesFluxConfiguration_azureBlobWithSasToken(t *testing.T) {
 	credential, privateKey, publicKey := ArcKubernetesClusterResource{}.getCredentials(t)
 	data := acceptance.BuildTestData(t, "azurerm_arc_kubernetes_flux_configuration", "test")
@@ -195,6 +210,37 @@ func TestAccArcKubernetesFluxConfiguration_kustomizationNameDuplicated(t *testin
 	})
 }
 
+func TestAccArcKubernetesFluxConfiguration_kustomizationDependsOn(t *testing.T) {
+	credential, privateKey, publicKey := ArcKubernetesClusterResource{}.getCredentials(t)
+	data := acceptance.BuildTestData(t, "azurerm_arc_kubernetes_flux_configuration", "test")
+	r := ArcKubernetesFluxConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.kustomizationDependsOn(data, credential, privateKey, publicKey, `["kustomization-1"]`),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.kustomizationDependsOn(data, credential, privateKey, publicKey, `["kustomization-3"]`),
+			ExpectError: regexp.MustCompile("kustomization `kustomization-2` depends on `kustomization-3` which is not defined within `kustomizations`"),
+		},
+	})
+}
+
+func TestAccArcKubernetesFluxConfiguration_kustomizationDependsOnCircular(t *testing.T) {
+	credential, privateKey, publicKey := ArcKubernetesClusterResource{}.getCredentials(t)
+	data := acceptance.BuildTestData(t, "azurerm_arc_kubernetes_flux_configuration", "test")
+	r := ArcKubernetesFluxConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.kustomizationDependsOnCircular(data, credential, privateKey, publicKey),
+			ExpectError: regexp.MustCompile("has a circular `depends_on` reference"),
+		},
+	})
+}
+
 func (r ArcKubernetesFluxConfigurationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := fluxconfiguration.ParseScopedFluxConfigurationID(state.ID)
 	if err != nil {
@@ -435,6 +481,61 @@ resource "azurerm_arc_kubernetes_flux_configuration" "test" {
 `, r.template(data, credential, privateKey, publicKey), data.RandomInteger)
 }
 
+func (r ArcKubernetesFluxConfigurationResource) azureBlobWithManagedIdentity(data acceptance.TestData, credential string, privateKey string, publicKey string) string {
+	return fmt.Sprintf(`
+				%[1]s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "sa%[2]d"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "asc%[2]d"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[2]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Reader"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_arc_kubernetes_flux_configuration" "test" {
+  name       = "acctest-fc-%[2]d"
+  cluster_id = azurerm_arc_kubernetes_cluster.test.id
+  namespace  = "flux"
+
+  blob_storage {
+    container_id = azurerm_storage_container.test.id
+
+    managed_identity {
+      client_id = azurerm_user_assigned_identity.test.client_id
+    }
+  }
+
+  kustomizations {
+    name = "kustomization-1"
+  }
+
+  depends_on = [
+    azurerm_arc_kubernetes_cluster_extension.test,
+    azurerm_role_assignment.test
+  ]
+}
+`, r.template(data, credential, privateKey, publicKey), data.RandomInteger)
+}
+
 func (r ArcKubernetesFluxConfigurationResource) azureBlobWithSasToken(data acceptance.TestData, credential string, privateKey string, publicKey string) string {
 	utcNow := time.Now().UTC()
 	startDate := utcNow.Add(-time.Hour * 24).Format(time.RFC3339)
@@ -690,3 +791,70 @@ resource "azurerm_arc_kubernetes_flux_configuration" "test" {
 }
 `, template, data.RandomInteger)
 }
+
+func (r ArcKubernetesFluxConfigurationResource) kustomizationDependsOn(data acceptance.TestData, credential string, privateKey string, publicKey string, dependsOn string) string {
+	template := r.template(data, credential, privateKey, publicKey)
+	return fmt.Sprintf(`
+				%s
+
+resource "azurerm_arc_kubernetes_flux_configuration" "test" {
+  name       = "acctest-fc-%d"
+  cluster_id = azurerm_arc_kubernetes_cluster.test.id
+  namespace  = "flux"
+
+  git_repository {
+    url             = "https://github.com/Azure/arc-k8s-demo"
+    reference_type  = "branch"
+    reference_value = "main"
+  }
+
+  kustomizations {
+    name = "kustomization-1"
+  }
+
+  kustomizations {
+    name       = "kustomization-2"
+    path       = "./test/path"
+    depends_on = %s
+  }
+
+  depends_on = [
+    azurerm_arc_kubernetes_cluster_extension.test
+  ]
+}
+`, template, data.RandomInteger, dependsOn)
+}
+
+func (r ArcKubernetesFluxConfigurationResource) kustomizationDependsOnCircular(data acceptance.TestData, credential string, privateKey string, publicKey string) string {
+	template := r.template(data, credential, privateKey, publicKey)
+	return fmt.Sprintf(`
+				%s
+
+resource "azurerm_arc_kubernetes_flux_configuration" "test" {
+  name       = "acctest-fc-%d"
+  cluster_id = azurerm_arc_kubernetes_cluster.test.id
+  namespace  = "flux"
+
+  git_repository {
+    url             = "https://github.com/Azure/arc-k8s-demo"
+    reference_type  = "branch"
+    reference_value = "main"
+  }
+
+  kustomizations {
+    name       = "kustomization-1"
+    depends_on = ["kustomization-2"]
+  }
+
+  kustomizations {
+    name       = "kustomization-2"
+    path       = "./test/path"
+    depends_on = ["kustomization-1"]
+  }
+
+  depends_on = [
+    azurerm_arc_kubernetes_cluster_extension.test
+  ]
+}
+`, template, data.RandomInteger)
+}
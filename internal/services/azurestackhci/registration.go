@@ -59,5 +59,6 @@ func (r Registration) Resources() []sdk.Resource {
 		StackHCINetworkInterfaceResource{},
 		StackHCIStoragePathResource{},
 		StackHCIVirtualHardDiskResource{},
+		StackHCIVirtualMachineInstanceResource{},
 	}
 }
@@ -0,0 +1,1038 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package azurestackhci
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/galleryimages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/marketplacegalleryimages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/networkinterfaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/storagecontainers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/virtualharddisks"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/azurestackhci/2024-01-01/virtualmachineinstances"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/extendedlocation/2021-08-15/customlocations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/azurestackhci/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.Resource           = StackHCIVirtualMachineInstanceResource{}
+	_ sdk.ResourceWithUpdate = StackHCIVirtualMachineInstanceResource{}
+)
+
+type StackHCIVirtualMachineInstanceResource struct{}
+
+func (StackHCIVirtualMachineInstanceResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StackHCIVirtualMachineInstanceID
+}
+
+func (StackHCIVirtualMachineInstanceResource) ResourceType() string {
+	return "azurerm_stack_hci_virtual_machine_instance"
+}
+
+func (StackHCIVirtualMachineInstanceResource) ModelObject() interface{} {
+	return &StackHCIVirtualMachineInstanceResourceModel{}
+}
+
+type StackHCIVirtualMachineInstanceResourceModel struct {
+	ArcMachineId           string                                                 `tfschema:"arc_machine_id"`
+	CustomLocationId       string                                                 `tfschema:"custom_location_id"`
+	HardwareProfile        []StackHCIVirtualMachineInstanceHardwareProfileModel   `tfschema:"hardware_profile"`
+	HttpProxyConfiguration []StackHCIVirtualMachineInstanceHttpProxyConfiguration `tfschema:"http_proxy_configuration"`
+	NetworkInterfaceIds    []string                                               `tfschema:"network_interface_ids"`
+	OsProfile              []StackHCIVirtualMachineInstanceOsProfileModel         `tfschema:"os_profile"`
+	SecureBootEnabled      bool                                                   `tfschema:"secure_boot_enabled"`
+	SecurityType           string                                                 `tfschema:"security_type"`
+	StorageProfile         []StackHCIVirtualMachineInstanceStorageProfileModel    `tfschema:"storage_profile"`
+	TpmEnabled             bool                                                   `tfschema:"tpm_enabled"`
+}
+
+type StackHCIVirtualMachineInstanceHardwareProfileModel struct {
+	DynamicMemory  []StackHCIVirtualMachineInstanceDynamicMemoryModel `tfschema:"dynamic_memory"`
+	MemoryInMb     int64                                              `tfschema:"memory_in_mb"`
+	ProcessorCount int64                                              `tfschema:"processor_count"`
+	VmSize         string                                             `tfschema:"vm_size"`
+}
+
+type StackHCIVirtualMachineInstanceDynamicMemoryModel struct {
+	MaximumMemoryInMb            int64 `tfschema:"maximum_memory_in_mb"`
+	MinimumMemoryInMb            int64 `tfschema:"minimum_memory_in_mb"`
+	TargetMemoryBufferPercentage int64 `tfschema:"target_memory_buffer_percentage"`
+}
+
+type StackHCIVirtualMachineInstanceHttpProxyConfiguration struct {
+	HttpProxy  string   `tfschema:"http_proxy"`
+	HttpsProxy string   `tfschema:"https_proxy"`
+	NoProxy    []string `tfschema:"no_proxy"`
+	TrustedCa  string   `tfschema:"trusted_ca"`
+}
+
+type StackHCIVirtualMachineInstanceOsProfileModel struct {
+	AdminPassword        string                                                    `tfschema:"admin_password"`
+	AdminUsername        string                                                    `tfschema:"admin_username"`
+	ComputerName         string                                                    `tfschema:"computer_name"`
+	LinuxConfiguration   []StackHCIVirtualMachineInstanceLinuxConfigurationModel   `tfschema:"linux_configuration"`
+	WindowsConfiguration []StackHCIVirtualMachineInstanceWindowsConfigurationModel `tfschema:"windows_configuration"`
+}
+
+type StackHCIVirtualMachineInstanceLinuxConfigurationModel struct {
+	PasswordAuthenticationEnabled bool                                              `tfschema:"password_authentication_enabled"`
+	ProvisionVmAgentEnabled       bool                                              `tfschema:"provision_vm_agent_enabled"`
+	ProvisionVmConfigAgentEnabled bool                                              `tfschema:"provision_vm_config_agent_enabled"`
+	SshPublicKey                  []StackHCIVirtualMachineInstanceSshPublicKeyModel `tfschema:"ssh_public_key"`
+}
+
+type StackHCIVirtualMachineInstanceWindowsConfigurationModel struct {
+	AutomaticUpdateEnabled        bool                                              `tfschema:"automatic_update_enabled"`
+	ProvisionVmAgentEnabled       bool                                              `tfschema:"provision_vm_agent_enabled"`
+	ProvisionVmConfigAgentEnabled bool                                              `tfschema:"provision_vm_config_agent_enabled"`
+	SshPublicKey                  []StackHCIVirtualMachineInstanceSshPublicKeyModel `tfschema:"ssh_public_key"`
+	TimeZone                      string                                            `tfschema:"time_zone"`
+}
+
+type StackHCIVirtualMachineInstanceSshPublicKeyModel struct {
+	KeyData string `tfschema:"key_data"`
+	Path    string `tfschema:"path"`
+}
+
+type StackHCIVirtualMachineInstanceStorageProfileModel struct {
+	DataDiskIds           []string `tfschema:"data_disk_ids"`
+	ImageId               string   `tfschema:"image_id"`
+	VmConfigStoragePathId string   `tfschema:"vm_config_storage_path_id"`
+}
+
+func (StackHCIVirtualMachineInstanceResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": commonschema.ResourceIDReferenceRequiredForceNew(&machines.MachineId{}),
+
+		"custom_location_id": commonschema.ResourceIDReferenceRequiredForceNew(&customlocations.CustomLocationId{}),
+
+		"hardware_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"vm_size": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(virtualmachineinstances.PossibleValuesForVMSizeEnum(), false),
+					},
+
+					"processor_count": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"memory_in_mb": {
+						Type:         pluginsdk.TypeInt,
+						Optional:     true,
+						Computed:     true,
+						ValidateFunc: validation.IntAtLeast(1),
+					},
+
+					"dynamic_memory": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"maximum_memory_in_mb": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"minimum_memory_in_mb": {
+									Type:         pluginsdk.TypeInt,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntAtLeast(1),
+								},
+
+								"target_memory_buffer_percentage": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.IntBetween(5, 2000),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"os_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"admin_username": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"computer_name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"admin_password": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+
+					"linux_configuration": {
+						Type:          pluginsdk.TypeList,
+						Optional:      true,
+						MaxItems:      1,
+						ConflictsWith: []string{"os_profile.0.windows_configuration"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"password_authentication_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  true,
+								},
+
+								"provision_vm_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  true,
+								},
+
+								"provision_vm_config_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  true,
+								},
+
+								"ssh_public_key": stackHCIVirtualMachineInstanceSshPublicKeySchema(),
+							},
+						},
+					},
+
+					"windows_configuration": {
+						Type:          pluginsdk.TypeList,
+						Optional:      true,
+						MaxItems:      1,
+						ConflictsWith: []string{"os_profile.0.linux_configuration"},
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"automatic_update_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									ForceNew: true,
+									Default:  false,
+								},
+
+								"provision_vm_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  true,
+								},
+
+								"provision_vm_config_agent_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+									Default:  true,
+								},
+
+								"ssh_public_key": stackHCIVirtualMachineInstanceSshPublicKeySchema(),
+
+								"time_zone": {
+									Type:         pluginsdk.TypeString,
+									Optional:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+
+		"storage_profile": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"image_id": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						ForceNew: true,
+						ValidateFunc: validation.Any(
+							marketplacegalleryimages.ValidateMarketplaceGalleryImageID,
+							galleryimages.ValidateGalleryImageID,
+						),
+					},
+
+					"data_disk_ids": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: virtualharddisks.ValidateVirtualHardDiskID,
+						},
+					},
+
+					"vm_config_storage_path_id": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						Computed:     true,
+						ForceNew:     true,
+						ValidateFunc: storagecontainers.ValidateStorageContainerID,
+					},
+				},
+			},
+		},
+
+		"network_interface_ids": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: networkinterfaces.ValidateNetworkInterfaceID,
+			},
+		},
+
+		"http_proxy_configuration": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"http_proxy": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"https_proxy": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						ValidateFunc: validation.IsURLWithHTTPorHTTPS,
+					},
+
+					"no_proxy": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+
+					"trusted_ca": {
+						Type:         pluginsdk.TypeString,
+						Optional:     true,
+						ForceNew:     true,
+						Sensitive:    true,
+						ValidateFunc: validation.StringIsNotEmpty,
+					},
+				},
+			},
+		},
+
+		"secure_boot_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+
+		"security_type": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(virtualmachineinstances.PossibleValuesForSecurityTypes(), false),
+		},
+
+		"tpm_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  false,
+		},
+	}
+}
+
+func (StackHCIVirtualMachineInstanceResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstances
+
+			var config StackHCIVirtualMachineInstanceResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewStackHCIVirtualMachineInstanceID(config.ArcMachineId)
+
+			existing, err := client.Get(ctx, commonids.NewScopeID(id.Scope))
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			hardwareProfile, err := expandStackHCIVirtualMachineInstanceHardwareProfile(config.HardwareProfile)
+			if err != nil {
+				return err
+			}
+
+			payload := virtualmachineinstances.VirtualMachineInstance{
+				ExtendedLocation: &virtualmachineinstances.ExtendedLocation{
+					Name: pointer.To(config.CustomLocationId),
+					Type: pointer.To(virtualmachineinstances.ExtendedLocationTypesCustomLocation),
+				},
+				Properties: &virtualmachineinstances.VirtualMachineInstanceProperties{
+					HardwareProfile: hardwareProfile,
+					HTTPProxyConfig: expandStackHCIVirtualMachineInstanceHttpProxyConfiguration(config.HttpProxyConfiguration),
+					NetworkProfile: &virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfile{
+						NetworkInterfaces: expandStackHCIVirtualMachineInstanceNetworkInterfaces(config.NetworkInterfaceIds),
+					},
+					OsProfile: expandStackHCIVirtualMachineInstanceOsProfile(config.OsProfile),
+					SecurityProfile: &virtualmachineinstances.VirtualMachineInstancePropertiesSecurityProfile{
+						EnableTPM: pointer.To(config.TpmEnabled),
+						UefiSettings: &virtualmachineinstances.VirtualMachineInstancePropertiesSecurityProfileUefiSettings{
+							SecureBootEnabled: pointer.To(config.SecureBootEnabled),
+						},
+					},
+					StorageProfile: expandStackHCIVirtualMachineInstanceStorageProfile(config.StorageProfile),
+				},
+			}
+
+			if config.SecurityType != "" {
+				payload.Properties.SecurityProfile.SecurityType = pointer.To(virtualmachineinstances.SecurityTypes(config.SecurityType))
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, commonids.NewScopeID(id.Scope), payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstances
+
+			id, err := parse.StackHCIVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, commonids.NewScopeID(id.Scope))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			machineId, err := machines.ParseMachineIDInsensitively(id.Scope)
+			if err != nil {
+				return err
+			}
+
+			schema := StackHCIVirtualMachineInstanceResourceModel{
+				ArcMachineId: machineId.ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if model.ExtendedLocation != nil && model.ExtendedLocation.Name != nil {
+					customLocationId, err := customlocations.ParseCustomLocationIDInsensitively(*model.ExtendedLocation.Name)
+					if err != nil {
+						return err
+					}
+
+					schema.CustomLocationId = customLocationId.ID()
+				}
+
+				if props := model.Properties; props != nil {
+					schema.HardwareProfile = flattenStackHCIVirtualMachineInstanceHardwareProfile(props.HardwareProfile)
+
+					// the API doesn't return the `trusted_ca` or `admin_password`, so we look them up from the config
+					schema.HttpProxyConfiguration = flattenStackHCIVirtualMachineInstanceHttpProxyConfiguration(props.HTTPProxyConfig, metadata.ResourceData.Get("http_proxy_configuration.0.trusted_ca").(string))
+					schema.OsProfile = flattenStackHCIVirtualMachineInstanceOsProfile(props.OsProfile, metadata.ResourceData.Get("os_profile.0.admin_password").(string))
+
+					if props.NetworkProfile != nil {
+						networkInterfaceIds, err := flattenStackHCIVirtualMachineInstanceNetworkInterfaces(props.NetworkProfile.NetworkInterfaces)
+						if err != nil {
+							return err
+						}
+						schema.NetworkInterfaceIds = networkInterfaceIds
+					}
+
+					if v := props.SecurityProfile; v != nil {
+						schema.SecurityType = string(pointer.From(v.SecurityType))
+						schema.TpmEnabled = pointer.From(v.EnableTPM)
+						if v.UefiSettings != nil {
+							schema.SecureBootEnabled = pointer.From(v.UefiSettings.SecureBootEnabled)
+						}
+					}
+
+					storageProfile, err := flattenStackHCIVirtualMachineInstanceStorageProfile(props.StorageProfile)
+					if err != nil {
+						return err
+					}
+					schema.StorageProfile = storageProfile
+				}
+			}
+
+			return metadata.Encode(&schema)
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstances
+
+			id, err := parse.StackHCIVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model StackHCIVirtualMachineInstanceResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			parameters := virtualmachineinstances.VirtualMachineInstanceUpdateRequest{
+				Properties: &virtualmachineinstances.VirtualMachineInstanceUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("hardware_profile") {
+				hardwareProfile, err := expandStackHCIVirtualMachineInstanceHardwareProfile(model.HardwareProfile)
+				if err != nil {
+					return err
+				}
+
+				parameters.Properties.HardwareProfile = &virtualmachineinstances.HardwareProfileUpdate{
+					MemoryMB:   hardwareProfile.MemoryMB,
+					Processors: hardwareProfile.Processors,
+					VMSize:     hardwareProfile.VMSize,
+				}
+			}
+
+			if metadata.ResourceData.HasChange("network_interface_ids") {
+				networkInterfaces := make([]virtualmachineinstances.NetworkProfileUpdateNetworkInterfacesInlined, 0)
+				for _, v := range model.NetworkInterfaceIds {
+					networkInterfaces = append(networkInterfaces, virtualmachineinstances.NetworkProfileUpdateNetworkInterfacesInlined{
+						Id: pointer.To(v),
+					})
+				}
+
+				parameters.Properties.NetworkProfile = &virtualmachineinstances.NetworkProfileUpdate{
+					NetworkInterfaces: &networkInterfaces,
+				}
+			}
+
+			if metadata.ResourceData.HasChange("os_profile") && len(model.OsProfile) > 0 {
+				osProfile := model.OsProfile[0]
+				parameters.Properties.OsProfile = &virtualmachineinstances.OsProfileUpdate{}
+
+				if len(osProfile.LinuxConfiguration) > 0 {
+					parameters.Properties.OsProfile.LinuxConfiguration = &virtualmachineinstances.OsProfileUpdateLinuxConfiguration{
+						ProvisionVMAgent:       pointer.To(osProfile.LinuxConfiguration[0].ProvisionVmAgentEnabled),
+						ProvisionVMConfigAgent: pointer.To(osProfile.LinuxConfiguration[0].ProvisionVmConfigAgentEnabled),
+					}
+				}
+
+				if len(osProfile.WindowsConfiguration) > 0 {
+					parameters.Properties.OsProfile.WindowsConfiguration = &virtualmachineinstances.OsProfileUpdateWindowsConfiguration{
+						ProvisionVMAgent:       pointer.To(osProfile.WindowsConfiguration[0].ProvisionVmAgentEnabled),
+						ProvisionVMConfigAgent: pointer.To(osProfile.WindowsConfiguration[0].ProvisionVmConfigAgentEnabled),
+					}
+				}
+			}
+
+			if metadata.ResourceData.HasChange("storage_profile.0.data_disk_ids") && len(model.StorageProfile) > 0 {
+				dataDisks := make([]virtualmachineinstances.StorageProfileUpdateDataDisksInlined, 0)
+				for _, v := range model.StorageProfile[0].DataDiskIds {
+					dataDisks = append(dataDisks, virtualmachineinstances.StorageProfileUpdateDataDisksInlined{
+						Id: pointer.To(v),
+					})
+				}
+
+				parameters.Properties.StorageProfile = &virtualmachineinstances.StorageProfileUpdate{
+					DataDisks: &dataDisks,
+				}
+			}
+
+			// changing the size of the Virtual Machine requires it to be stopped, so a running Virtual Machine is stopped
+			// for the update and then returned to its prior power state - one which was already stopped is left stopped
+			wasRunning := false
+			if metadata.ResourceData.HasChange("hardware_profile") {
+				existing, err := client.Get(ctx, commonids.NewScopeID(id.Scope))
+				if err != nil {
+					return fmt.Errorf("retrieving %s: %+v", *id, err)
+				}
+
+				if model := existing.Model; model != nil && model.Properties != nil && model.Properties.Status != nil {
+					powerState := pointer.From(model.Properties.Status.PowerState)
+					wasRunning = powerState == virtualmachineinstances.PowerStateEnumRunning || powerState == virtualmachineinstances.PowerStateEnumStarting
+				}
+			}
+
+			if wasRunning {
+				if err := client.StopThenPoll(ctx, commonids.NewScopeID(id.Scope)); err != nil {
+					return fmt.Errorf("stopping %s: %+v", *id, err)
+				}
+
+				restarted := false
+				defer func() {
+					if restarted {
+						return
+					}
+
+					// the update failed, so the Virtual Machine is started again rather than being left stopped
+					startCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 30*time.Minute)
+					defer cancel()
+					if err := client.StartThenPoll(startCtx, commonids.NewScopeID(id.Scope)); err != nil {
+						metadata.Logger.Warnf("starting %s after a failed update: %+v", *id, err)
+					}
+				}()
+
+				if err := client.UpdateThenPoll(ctx, commonids.NewScopeID(id.Scope), parameters); err != nil {
+					return fmt.Errorf("updating %s: %+v", *id, err)
+				}
+
+				restarted = true
+				if err := client.StartThenPoll(ctx, commonids.NewScopeID(id.Scope)); err != nil {
+					return fmt.Errorf("starting %s: %+v", *id, err)
+				}
+
+				return nil
+			}
+
+			if err := client.UpdateThenPoll(ctx, commonids.NewScopeID(id.Scope), parameters); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r StackHCIVirtualMachineInstanceResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AzureStackHCI.VirtualMachineInstances
+
+			id, err := parse.StackHCIVirtualMachineInstanceID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, commonids.NewScopeID(id.Scope)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func stackHCIVirtualMachineInstanceSshPublicKeySchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"key_data": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+
+				"path": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ForceNew:     true,
+					ValidateFunc: validation.StringIsNotEmpty,
+				},
+			},
+		},
+	}
+}
+
+func expandStackHCIVirtualMachineInstanceHardwareProfile(input []StackHCIVirtualMachineInstanceHardwareProfileModel) (*virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile, error) {
+	if len(input) == 0 {
+		return nil, nil
+	}
+
+	v := input[0]
+	if v.VmSize == string(virtualmachineinstances.VMSizeEnumCustom) && (v.ProcessorCount == 0 || v.MemoryInMb == 0) {
+		return nil, fmt.Errorf("`processor_count` and `memory_in_mb` must be specified when `vm_size` is `%s`", virtualmachineinstances.VMSizeEnumCustom)
+	}
+
+	output := &virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile{
+		VMSize: pointer.To(virtualmachineinstances.VMSizeEnum(v.VmSize)),
+	}
+
+	if v.ProcessorCount != 0 {
+		output.Processors = pointer.To(v.ProcessorCount)
+	}
+
+	if v.MemoryInMb != 0 {
+		output.MemoryMB = pointer.To(v.MemoryInMb)
+	}
+
+	if len(v.DynamicMemory) > 0 {
+		dynamicMemory := v.DynamicMemory[0]
+		output.DynamicMemoryConfig = &virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfileDynamicMemoryConfig{
+			MaximumMemoryMB: pointer.To(dynamicMemory.MaximumMemoryInMb),
+			MinimumMemoryMB: pointer.To(dynamicMemory.MinimumMemoryInMb),
+		}
+
+		if dynamicMemory.TargetMemoryBufferPercentage != 0 {
+			output.DynamicMemoryConfig.TargetMemoryBuffer = pointer.To(dynamicMemory.TargetMemoryBufferPercentage)
+		}
+	}
+
+	return output, nil
+}
+
+func flattenStackHCIVirtualMachineInstanceHardwareProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesHardwareProfile) []StackHCIVirtualMachineInstanceHardwareProfileModel {
+	if input == nil {
+		return make([]StackHCIVirtualMachineInstanceHardwareProfileModel, 0)
+	}
+
+	output := StackHCIVirtualMachineInstanceHardwareProfileModel{
+		MemoryInMb:     pointer.From(input.MemoryMB),
+		ProcessorCount: pointer.From(input.Processors),
+		VmSize:         string(pointer.From(input.VMSize)),
+	}
+
+	if v := input.DynamicMemoryConfig; v != nil && (v.MaximumMemoryMB != nil || v.MinimumMemoryMB != nil) {
+		output.DynamicMemory = []StackHCIVirtualMachineInstanceDynamicMemoryModel{
+			{
+				MaximumMemoryInMb:            pointer.From(v.MaximumMemoryMB),
+				MinimumMemoryInMb:            pointer.From(v.MinimumMemoryMB),
+				TargetMemoryBufferPercentage: pointer.From(v.TargetMemoryBuffer),
+			},
+		}
+	}
+
+	return []StackHCIVirtualMachineInstanceHardwareProfileModel{output}
+}
+
+func expandStackHCIVirtualMachineInstanceHttpProxyConfiguration(input []StackHCIVirtualMachineInstanceHttpProxyConfiguration) *virtualmachineinstances.HTTPProxyConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := &virtualmachineinstances.HTTPProxyConfiguration{
+		NoProxy: pointer.To(v.NoProxy),
+	}
+
+	if v.HttpProxy != "" {
+		output.HTTPProxy = pointer.To(v.HttpProxy)
+	}
+
+	if v.HttpsProxy != "" {
+		output.HTTPSProxy = pointer.To(v.HttpsProxy)
+	}
+
+	if v.TrustedCa != "" {
+		output.TrustedCa = pointer.To(v.TrustedCa)
+	}
+
+	return output
+}
+
+func flattenStackHCIVirtualMachineInstanceHttpProxyConfiguration(input *virtualmachineinstances.HTTPProxyConfiguration, trustedCa string) []StackHCIVirtualMachineInstanceHttpProxyConfiguration {
+	if input == nil || (input.HTTPProxy == nil && input.HTTPSProxy == nil && input.NoProxy == nil) {
+		return make([]StackHCIVirtualMachineInstanceHttpProxyConfiguration, 0)
+	}
+
+	output := StackHCIVirtualMachineInstanceHttpProxyConfiguration{
+		HttpProxy:  pointer.From(input.HTTPProxy),
+		HttpsProxy: pointer.From(input.HTTPSProxy),
+		NoProxy:    pointer.From(input.NoProxy),
+		TrustedCa:  pointer.From(input.TrustedCa),
+	}
+
+	if output.TrustedCa == "" {
+		output.TrustedCa = trustedCa
+	}
+
+	return []StackHCIVirtualMachineInstanceHttpProxyConfiguration{output}
+}
+
+func expandStackHCIVirtualMachineInstanceNetworkInterfaces(input []string) *[]virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesInlined {
+	output := make([]virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesInlined, 0)
+	for _, v := range input {
+		output = append(output, virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesInlined{
+			Id: pointer.To(v),
+		})
+	}
+
+	return &output
+}
+
+func flattenStackHCIVirtualMachineInstanceNetworkInterfaces(input *[]virtualmachineinstances.VirtualMachineInstancePropertiesNetworkProfileNetworkInterfacesInlined) ([]string, error) {
+	output := make([]string, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	for _, v := range *input {
+		if v.Id == nil {
+			continue
+		}
+
+		networkInterfaceId, err := networkinterfaces.ParseNetworkInterfaceIDInsensitively(*v.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, networkInterfaceId.ID())
+	}
+
+	return output, nil
+}
+
+func expandStackHCIVirtualMachineInstanceOsProfile(input []StackHCIVirtualMachineInstanceOsProfileModel) *virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	output := &virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile{
+		AdminUsername: pointer.To(v.AdminUsername),
+		ComputerName:  pointer.To(v.ComputerName),
+	}
+
+	if v.AdminPassword != "" {
+		output.AdminPassword = pointer.To(v.AdminPassword)
+	}
+
+	if len(v.LinuxConfiguration) > 0 {
+		linuxConfiguration := v.LinuxConfiguration[0]
+		output.LinuxConfiguration = &virtualmachineinstances.VirtualMachineInstancePropertiesOsProfileLinuxConfiguration{
+			DisablePasswordAuthentication: pointer.To(!linuxConfiguration.PasswordAuthenticationEnabled),
+			ProvisionVMAgent:              pointer.To(linuxConfiguration.ProvisionVmAgentEnabled),
+			ProvisionVMConfigAgent:        pointer.To(linuxConfiguration.ProvisionVmConfigAgentEnabled),
+			Ssh:                           expandStackHCIVirtualMachineInstanceSshConfiguration(linuxConfiguration.SshPublicKey),
+		}
+	}
+
+	if len(v.WindowsConfiguration) > 0 {
+		windowsConfiguration := v.WindowsConfiguration[0]
+		output.WindowsConfiguration = &virtualmachineinstances.VirtualMachineInstancePropertiesOsProfileWindowsConfiguration{
+			EnableAutomaticUpdates: pointer.To(windowsConfiguration.AutomaticUpdateEnabled),
+			ProvisionVMAgent:       pointer.To(windowsConfiguration.ProvisionVmAgentEnabled),
+			ProvisionVMConfigAgent: pointer.To(windowsConfiguration.ProvisionVmConfigAgentEnabled),
+			Ssh:                    expandStackHCIVirtualMachineInstanceSshConfiguration(windowsConfiguration.SshPublicKey),
+		}
+
+		if windowsConfiguration.TimeZone != "" {
+			output.WindowsConfiguration.TimeZone = pointer.To(windowsConfiguration.TimeZone)
+		}
+	}
+
+	return output
+}
+
+func flattenStackHCIVirtualMachineInstanceOsProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesOsProfile, adminPassword string) []StackHCIVirtualMachineInstanceOsProfileModel {
+	if input == nil {
+		return make([]StackHCIVirtualMachineInstanceOsProfileModel, 0)
+	}
+
+	output := StackHCIVirtualMachineInstanceOsProfileModel{
+		AdminUsername: pointer.From(input.AdminUsername),
+		AdminPassword: adminPassword,
+		ComputerName:  pointer.From(input.ComputerName),
+	}
+
+	if v := input.LinuxConfiguration; v != nil {
+		output.LinuxConfiguration = []StackHCIVirtualMachineInstanceLinuxConfigurationModel{
+			{
+				PasswordAuthenticationEnabled: !pointer.From(v.DisablePasswordAuthentication),
+				ProvisionVmAgentEnabled:       pointer.From(v.ProvisionVMAgent),
+				ProvisionVmConfigAgentEnabled: pointer.From(v.ProvisionVMConfigAgent),
+				SshPublicKey:                  flattenStackHCIVirtualMachineInstanceSshConfiguration(v.Ssh),
+			},
+		}
+	}
+
+	if v := input.WindowsConfiguration; v != nil {
+		output.WindowsConfiguration = []StackHCIVirtualMachineInstanceWindowsConfigurationModel{
+			{
+				AutomaticUpdateEnabled:        pointer.From(v.EnableAutomaticUpdates),
+				ProvisionVmAgentEnabled:       pointer.From(v.ProvisionVMAgent),
+				ProvisionVmConfigAgentEnabled: pointer.From(v.ProvisionVMConfigAgent),
+				SshPublicKey:                  flattenStackHCIVirtualMachineInstanceSshConfiguration(v.Ssh),
+				TimeZone:                      pointer.From(v.TimeZone),
+			},
+		}
+	}
+
+	return []StackHCIVirtualMachineInstanceOsProfileModel{output}
+}
+
+func expandStackHCIVirtualMachineInstanceSshConfiguration(input []StackHCIVirtualMachineInstanceSshPublicKeyModel) *virtualmachineinstances.SshConfiguration {
+	if len(input) == 0 {
+		return nil
+	}
+
+	publicKeys := make([]virtualmachineinstances.SshPublicKey, 0)
+	for _, v := range input {
+		publicKeys = append(publicKeys, virtualmachineinstances.SshPublicKey{
+			KeyData: pointer.To(v.KeyData),
+			Path:    pointer.To(v.Path),
+		})
+	}
+
+	return &virtualmachineinstances.SshConfiguration{
+		PublicKeys: &publicKeys,
+	}
+}
+
+func flattenStackHCIVirtualMachineInstanceSshConfiguration(input *virtualmachineinstances.SshConfiguration) []StackHCIVirtualMachineInstanceSshPublicKeyModel {
+	output := make([]StackHCIVirtualMachineInstanceSshPublicKeyModel, 0)
+	if input == nil || input.PublicKeys == nil {
+		return output
+	}
+
+	for _, v := range *input.PublicKeys {
+		output = append(output, StackHCIVirtualMachineInstanceSshPublicKeyModel{
+			KeyData: pointer.From(v.KeyData),
+			Path:    pointer.From(v.Path),
+		})
+	}
+
+	return output
+}
+
+func expandStackHCIVirtualMachineInstanceStorageProfile(input []StackHCIVirtualMachineInstanceStorageProfileModel) *virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile {
+	if len(input) == 0 {
+		return nil
+	}
+
+	v := input[0]
+	dataDisks := make([]virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfileDataDisksInlined, 0)
+	for _, dataDiskId := range v.DataDiskIds {
+		dataDisks = append(dataDisks, virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfileDataDisksInlined{
+			Id: pointer.To(dataDiskId),
+		})
+	}
+
+	output := &virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile{
+		DataDisks: &dataDisks,
+		ImageReference: &virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfileImageReference{
+			Id: pointer.To(v.ImageId),
+		},
+	}
+
+	if v.VmConfigStoragePathId != "" {
+		output.VMConfigStoragePathId = pointer.To(v.VmConfigStoragePathId)
+	}
+
+	return output
+}
+
+func flattenStackHCIVirtualMachineInstanceStorageProfile(input *virtualmachineinstances.VirtualMachineInstancePropertiesStorageProfile) ([]StackHCIVirtualMachineInstanceStorageProfileModel, error) {
+	if input == nil {
+		return make([]StackHCIVirtualMachineInstanceStorageProfileModel, 0), nil
+	}
+
+	output := StackHCIVirtualMachineInstanceStorageProfileModel{
+		DataDiskIds: make([]string, 0),
+	}
+
+	if input.DataDisks != nil {
+		for _, v := range *input.DataDisks {
+			if v.Id == nil {
+				continue
+			}
+
+			dataDiskId, err := virtualharddisks.ParseVirtualHardDiskIDInsensitively(*v.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			output.DataDiskIds = append(output.DataDiskIds, dataDiskId.ID())
+		}
+	}
+
+	if input.ImageReference != nil && input.ImageReference.Id != nil {
+		// the image can either be a Marketplace Gallery Image or a Gallery Image
+		if marketplaceGalleryImageId, err := marketplacegalleryimages.ParseMarketplaceGalleryImageIDInsensitively(*input.ImageReference.Id); err == nil {
+			output.ImageId = marketplaceGalleryImageId.ID()
+		} else {
+			galleryImageId, err := galleryimages.ParseGalleryImageIDInsensitively(*input.ImageReference.Id)
+			if err != nil {
+				return nil, err
+			}
+
+			output.ImageId = galleryImageId.ID()
+		}
+	}
+
+	if input.VMConfigStoragePathId != nil {
+		storagePathId, err := storagecontainers.ParseStorageContainerIDInsensitively(*input.VMConfigStoragePathId)
+		if err != nil {
+			return nil, err
+		}
+
+		output.VmConfigStoragePathId = storagePathId.ID()
+	}
+
+	return []StackHCIVirtualMachineInstanceStorageProfileModel{output}, nil
+}
@@ -17,7 +17,9 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-07-01/batchaccount"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/batch/2024-07-01/pool"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -28,6 +30,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	batchDataplane "github.com/jackofallops/kermit/sdk/batch/2022-01.15.0/batch"
 )
 
 func resourceBatchPool() *pluginsdk.Resource {
@@ -355,6 +358,7 @@ func resourceBatchPool() *pluginsdk.Resource {
 						"azure_file_share": {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
+							MaxItems: 1,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
 									"account_name": {
@@ -389,6 +393,7 @@ func resourceBatchPool() *pluginsdk.Resource {
 						"cifs_mount": {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
+							MaxItems: 1,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
 									"user_name": {
@@ -423,6 +428,7 @@ func resourceBatchPool() *pluginsdk.Resource {
 						"nfs_mount": {
 							Type:     pluginsdk.TypeList,
 							Optional: true,
+							MaxItems: 1,
 							Elem: &pluginsdk.Resource{
 								Schema: map[string]*pluginsdk.Schema{
 									"source": {
@@ -860,6 +866,10 @@ func resourceBatchPool() *pluginsdk.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			resourceBatchPoolValidateMountConfiguration,
+		),
 	}
 
 	return resource
@@ -1009,6 +1019,17 @@ func resourceBatchPoolUpdate(d *pluginsdk.ResourceData, meta interface{}) error
 		return err
 	}
 
+	// the formula can only be evaluated once automatic scaling has been enabled on the Pool
+	if d.HasChange("auto_scale.0.formula") {
+		if oldAutoScale, _ := d.GetChange("auto_scale"); len(oldAutoScale.([]interface{})) > 0 {
+			if formula := d.Get("auto_scale.0.formula").(string); formula != "" {
+				if err := evaluateBatchPoolAutoScaleFormula(ctx, meta, *id, formula); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
 	resp, err := client.Get(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("retrieving %s: %+v", *id, err)
@@ -1349,6 +1370,82 @@ func resourceBatchPoolDelete(d *pluginsdk.ResourceData, meta interface{}) error
 	return nil
 }
 
+// evaluateBatchPoolAutoScaleFormula performs a dry-run of an updated autoscale formula against an existing Pool, so that
+// an invalid formula is caught before any changes are made to the Pool. This happens during apply rather than plan,
+// since a CustomizeDiff can't surface the resulting target node counts as a warning.
+func evaluateBatchPoolAutoScaleFormula(ctx context.Context, meta interface{}, id pool.PoolId, formula string) error {
+	accountId := batchaccount.NewBatchAccountID(id.SubscriptionId, id.ResourceGroupName, id.BatchAccountName)
+	client, err := meta.(*clients.Client).Batch.DataPlanePoolClient(ctx, accountId)
+	if err != nil {
+		return fmt.Errorf("building data plane client for %s: %+v", id, err)
+	}
+
+	parameters := batchDataplane.PoolEvaluateAutoScaleParameter{
+		AutoScaleFormula: pointer.To(strings.TrimSpace(formula)),
+	}
+	result, err := client.EvaluateAutoScale(ctx, id.PoolName, parameters, nil, nil, nil, nil)
+	if err != nil {
+		return fmt.Errorf("evaluating the `auto_scale` formula for %s: %+v", id, err)
+	}
+
+	if result.Error != nil {
+		return fmt.Errorf("evaluating the `auto_scale` formula for %s: %s: %s", id, pointer.From(result.Error.Code), pointer.From(result.Error.Message))
+	}
+
+	log.Printf("[DEBUG] evaluating the `auto_scale` formula for %s returned: %s", id, pointer.From(result.Results))
+
+	return nil
+}
+
+// resourceBatchPoolValidateMountConfiguration ensures that each `mount` block specifies exactly one type of mount,
+// and that an `azure_blob_file_system` mount specifies exactly one means of authentication
+func resourceBatchPoolValidateMountConfiguration(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	mounts := diff.GetRawConfig().GetAttr("mount")
+	if !mounts.IsKnown() || mounts.IsNull() {
+		return nil
+	}
+
+	for i, mount := range mounts.AsValueSlice() {
+		if !mount.IsKnown() || mount.IsNull() {
+			continue
+		}
+
+		// values which aren't known until apply may or may not be set, so are only used to avoid a false positive
+		mountTypes, unknownMountTypes := 0, 0
+		for _, mountType := range []string{"azure_blob_file_system", "azure_file_share", "cifs_mount", "nfs_mount"} {
+			v := mount.GetAttr(mountType)
+			if !v.IsKnown() {
+				unknownMountTypes++
+			} else if !v.IsNull() && v.LengthInt() > 0 {
+				mountTypes++
+			}
+		}
+		if mountTypes > 1 || (mountTypes == 0 && unknownMountTypes == 0) {
+			return fmt.Errorf("`mount.%d` must specify exactly one of `azure_blob_file_system`, `azure_file_share`, `cifs_mount` or `nfs_mount`", i)
+		}
+
+		blob := mount.GetAttr("azure_blob_file_system")
+		if !blob.IsKnown() || blob.IsNull() || blob.LengthInt() == 0 {
+			continue
+		}
+
+		credentials, unknownCredentials := 0, 0
+		for _, credential := range []string{"account_key", "sas_key", "identity_id"} {
+			v := blob.Index(cty.NumberIntVal(0)).GetAttr(credential)
+			if !v.IsKnown() {
+				unknownCredentials++
+			} else if !v.IsNull() {
+				credentials++
+			}
+		}
+		if credentials > 1 || (credentials == 0 && unknownCredentials == 0) {
+			return fmt.Errorf("`mount.%d.azure_blob_file_system` must specify exactly one of `account_key`, `sas_key` or `identity_id`", i)
+		}
+	}
+
+	return nil
+}
+
 func expandBatchPoolScaleSettings(d *pluginsdk.ResourceData) (*pool.ScaleSettings, error) {
 	scaleSettings := &pool.ScaleSettings{}
 
@@ -422,6 +422,37 @@ func TestAccBatchPool_validateResourceFileWithMultipleSources(t *testing.T) {
 	})
 }
 
+func TestAccBatchPool_autoScaleInvalidFormula(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_batch_pool", "test")
+	r := BatchPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoScaleFormula(data, "$TargetDedicatedNodes=1;"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("stop_pending_resize_operation"),
+		{
+			Config:      r.autoScaleFormula(data, "$TargetDedicatedNodes=min(;"),
+			ExpectError: regexp.MustCompile("evaluating the `auto_scale` formula"),
+		},
+	})
+}
+
+func TestAccBatchPool_mountConfigurationMultipleCredentials(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_batch_pool", "test")
+	r := BatchPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.mountConfigurationMultipleCredentials(data),
+			ExpectError: regexp.MustCompile("must specify exactly one of `account_key`, `sas_key` or `identity_id`"),
+		},
+	})
+}
+
 func TestAccBatchPool_validateResourceFileBlobPrefixWithoutAutoStorageContainerUrl(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_batch_pool", "test")
 	r := BatchPoolResource{}
@@ -1008,6 +1039,83 @@ EOF
 `, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString, data.RandomString)
 }
 
+func (BatchPoolResource) autoScaleFormula(data acceptance.TestData, formula string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-batch-%d"
+  location = "%s"
+}
+
+resource "azurerm_batch_account" "test" {
+  name                = "testaccbatch%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+
+resource "azurerm_batch_pool" "test" {
+  name                          = "testaccpool%s"
+  resource_group_name           = azurerm_resource_group.test.name
+  account_name                  = azurerm_batch_account.test.name
+  vm_size                       = "STANDARD_A1_V2"
+  node_agent_sku_id             = "batch.node.ubuntu 22.04"
+  stop_pending_resize_operation = true
+
+  auto_scale {
+    evaluation_interval = "PT15M"
+    formula             = "%s"
+  }
+
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, data.RandomString, formula)
+}
+
+func (BatchPoolResource) mountConfigurationMultipleCredentials(data acceptance.TestData) string {
+	template := BatchPoolResource{}.template(data)
+	return fmt.Sprintf(`
+%s
+resource "azurerm_batch_account" "test" {
+  name                = "testaccbatch%s"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+}
+resource "azurerm_batch_pool" "test" {
+  name                = "testaccpool%s"
+  resource_group_name = azurerm_resource_group.test.name
+  account_name        = azurerm_batch_account.test.name
+  node_agent_sku_id   = "batch.node.ubuntu 22.04"
+  vm_size             = "STANDARD_A1_V2"
+  mount {
+    azure_blob_file_system {
+      account_name        = "accbatchsa%s"
+      container_name      = "accbatchsc%s"
+      account_key         = "not-a-real-key"
+      sas_key             = "not-a-real-sas"
+      relative_mount_path = "/mnt/"
+    }
+  }
+  fixed_scale {
+    target_dedicated_nodes = 1
+  }
+  storage_image_reference {
+    publisher = "Canonical"
+    offer     = "0001-com-ubuntu-server-jammy"
+    sku       = "22_04-lts"
+    version   = "latest"
+  }
+}
+`, template, data.RandomString, data.RandomString, data.RandomString, data.RandomString)
+}
+
 func (BatchPoolResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -60,6 +60,30 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 }
 
 func (r *Client) JobClient(ctx context.Context, accountId batchaccount.BatchAccountId) (*batchDataplane.JobClient, error) {
+	endpoint, err := r.accountEndpoint(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy the client since we'll manipulate its BatchURL
+	c := batchDataplane.NewJobClient(*endpoint)
+	c.BaseClient.Client.Authorizer = r.BatchManagementAuthorizer
+	return &c, nil
+}
+
+func (r *Client) DataPlanePoolClient(ctx context.Context, accountId batchaccount.BatchAccountId) (*batchDataplane.PoolClient, error) {
+	endpoint, err := r.accountEndpoint(ctx, accountId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Copy the client since we'll manipulate its BatchURL
+	c := batchDataplane.NewPoolClient(*endpoint)
+	c.BaseClient.Client.Authorizer = r.BatchManagementAuthorizer
+	return &c, nil
+}
+
+func (r *Client) accountEndpoint(ctx context.Context, accountId batchaccount.BatchAccountId) (*string, error) {
 	// Retrieve the batch account to find the batch account endpoint
 	accountClient := r.AccountClient
 	account, err := accountClient.Get(ctx, accountId)
@@ -68,15 +92,12 @@ func (r *Client) JobClient(ctx context.Context, accountId batchaccount.BatchAcco
 	}
 
 	endpoint := ""
-	if account.Model != nil && account.Model.Properties != nil {
+	if account.Model != nil && account.Model.Properties != nil && account.Model.Properties.AccountEndpoint != nil {
 		endpoint = "https://" + *account.Model.Properties.AccountEndpoint
 	}
 	if endpoint == "" {
 		return nil, fmt.Errorf("retrieving %s: `properties.AccountEndpoint` was empty", accountId)
 	}
 
-	// Copy the client since we'll manipulate its BatchURL
-	c := batchDataplane.NewJobClient(endpoint)
-	c.BaseClient.Client.Authorizer = r.BatchManagementAuthorizer
-	return &c, nil
+	return &endpoint, nil
 }
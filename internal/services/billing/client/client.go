@@ -0,0 +1,38 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/environments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+const (
+	// RetailPricesApiVersion is the API Version of the Azure Retail Prices API, which isn't exposed via Resource Manager
+	// and so has no corresponding SDK - see https://learn.microsoft.com/rest/api/cost-management/retail-prices/azure-retail-prices
+	RetailPricesApiVersion = "2023-01-01-preview"
+
+	retailPricesEndpoint = "https://prices.azure.com"
+)
+
+type Client struct {
+	// RetailPricesClient is nil when the Azure Retail Prices API isn't available in the configured Environment
+	RetailPricesClient *client.Client
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	out := &Client{}
+
+	// the Azure Retail Prices API is unauthenticated and only available in Azure Public
+	if strings.EqualFold(o.Environment.Name, environments.AzurePublic().Name) {
+		retailPricesClient := client.NewClient(retailPricesEndpoint, "retailprices", RetailPricesApiVersion)
+		o.Configure(retailPricesClient, nil)
+		out.RetailPricesClient = retailPricesClient
+	}
+
+	return out, nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billing
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	billingClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/billing/client"
+)
+
+// retailPricesMaxPages is the maximum number of pages (of up to 1000 prices each) which are retrieved, to avoid an
+// overly broad filter paging through the entire price list
+const retailPricesMaxPages = 50
+
+type retailPricesPage struct {
+	BillingCurrency string            `json:"BillingCurrency"`
+	Items           []retailPriceItem `json:"Items"`
+	NextPageLink    *string           `json:"NextPageLink"`
+}
+
+type retailPriceItem struct {
+	ArmRegionName      string  `json:"armRegionName"`
+	ArmSkuName         string  `json:"armSkuName"`
+	CurrencyCode       string  `json:"currencyCode"`
+	EffectiveStartDate string  `json:"effectiveStartDate"`
+	MeterId            string  `json:"meterId"`
+	MeterName          string  `json:"meterName"`
+	ProductName        string  `json:"productName"`
+	ReservationTerm    string  `json:"reservationTerm"`
+	RetailPrice        float64 `json:"retailPrice"`
+	ServiceFamily      string  `json:"serviceFamily"`
+	ServiceName        string  `json:"serviceName"`
+	SkuName            string  `json:"skuName"`
+	TierMinimumUnits   float64 `json:"tierMinimumUnits"`
+	Type               string  `json:"type"`
+	UnitOfMeasure      string  `json:"unitOfMeasure"`
+	UnitPrice          float64 `json:"unitPrice"`
+}
+
+// listRetailPrices returns all of the prices matching the specified OData filter, following each `NextPageLink` until
+// all pages have been retrieved or retailPricesMaxPages is reached.
+func listRetailPrices(ctx context.Context, c *client.Client, filter string, currencyCode string) ([]retailPriceItem, error) {
+	if c == nil {
+		return nil, fmt.Errorf("the Azure Retail Prices API is only available in the Azure Public Cloud")
+	}
+
+	if _, ok := ctx.Deadline(); !ok {
+		return nil, fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	query := url.Values{}
+	query.Set("api-version", billingClient.RetailPricesApiVersion)
+	query.Set("currencyCode", currencyCode)
+	if filter != "" {
+		query.Set("$filter", filter)
+	}
+
+	baseUri, err := url.Parse(c.BaseUri)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", c.BaseUri, err)
+	}
+	nextLink := baseUri.JoinPath("api", "retail", "prices")
+	nextLink.RawQuery = query.Encode()
+
+	items := make([]retailPriceItem, 0)
+	for pages := 0; nextLink != nil; pages++ {
+		if pages == retailPricesMaxPages {
+			return nil, fmt.Errorf("more than %d pages of prices were returned - the filter should be narrowed down to match fewer prices", retailPricesMaxPages)
+		}
+
+		page, err := getRetailPricesPage(ctx, c, nextLink)
+		if err != nil {
+			return nil, err
+		}
+
+		items = append(items, page.Items...)
+
+		nextLink = nil
+		if page.NextPageLink != nil && *page.NextPageLink != "" {
+			nextLink, err = url.Parse(*page.NextPageLink)
+			if err != nil {
+				return nil, fmt.Errorf("parsing the next page link %q: %+v", *page.NextPageLink, err)
+			}
+			if !strings.EqualFold(nextLink.Host, baseUri.Host) {
+				return nil, fmt.Errorf("the next page link %q isn't for the host %q", *page.NextPageLink, baseUri.Host)
+			}
+		}
+	}
+
+	return items, nil
+}
+
+func getRetailPricesPage(ctx context.Context, c *client.Client, endpoint *url.URL) (*retailPricesPage, error) {
+	req, err := c.NewRequest(ctx, client.RequestOptions{
+		ContentType:         "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{http.StatusOK},
+		HttpMethod:          http.MethodGet,
+		Path:                endpoint.Path,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("preparing request for %q: %+v", endpoint, err)
+	}
+	req.URL.RawQuery = endpoint.RawQuery
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %q: %+v", endpoint, err)
+	}
+
+	var page retailPricesPage
+	if err := resp.Unmarshal(&page); err != nil {
+		return nil, fmt.Errorf("unmarshaling response for %q: %+v", endpoint, err)
+	}
+
+	return &page, nil
+}
+
+// buildRetailPricesFilter combines the specified field/value pairs into an OData filter, skipping any empty values.
+func buildRetailPricesFilter(fields [][2]string) string {
+	clauses := make([]string, 0)
+	for _, v := range fields {
+		if v[1] == "" {
+			continue
+		}
+		// single quotes within a value must be escaped by doubling them
+		clauses = append(clauses, fmt.Sprintf("%s eq '%s'", v[0], strings.ReplaceAll(v[1], "'", "''")))
+	}
+	return strings.Join(clauses, " and ")
+}
@@ -0,0 +1,247 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billing
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceRetailPrices() *pluginsdk.Resource {
+	filterFields := []string{
+		"service_name",
+		"product_name",
+		"sku_name",
+		"arm_sku_name",
+		"meter_id",
+	}
+
+	return &pluginsdk.Resource{
+		Read: dataSourceRetailPricesRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"service_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				AtLeastOneOf: filterFields,
+			},
+
+			"product_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				AtLeastOneOf: filterFields,
+			},
+
+			"sku_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				AtLeastOneOf: filterFields,
+			},
+
+			"arm_sku_name": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				AtLeastOneOf: filterFields,
+			},
+
+			"meter_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.IsUUID,
+				AtLeastOneOf: filterFields,
+			},
+
+			"location": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				ValidateFunc:     validation.StringIsNotEmpty,
+				StateFunc:        location.StateFunc,
+				DiffSuppressFunc: location.DiffSuppressFunc,
+			},
+
+			"price_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Consumption",
+					"DevTestConsumption",
+					"Reservation",
+				}, false),
+			},
+
+			"currency_code": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Default:      "USD",
+				ValidateFunc: validation.StringLenBetween(3, 3),
+			},
+
+			"prices": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"arm_sku_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"currency_code": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"effective_start_date": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"location": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"meter_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"meter_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"product_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"reservation_term": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"retail_price": {
+							Type:     pluginsdk.TypeFloat,
+							Computed: true,
+						},
+
+						"service_family": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"service_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"sku_name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"tier_minimum_units": {
+							Type:     pluginsdk.TypeFloat,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"unit_of_measure": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"unit_price": {
+							Type:     pluginsdk.TypeFloat,
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceRetailPricesRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	filter := buildRetailPricesFilter([][2]string{
+		{"serviceName", d.Get("service_name").(string)},
+		{"productName", d.Get("product_name").(string)},
+		{"skuName", d.Get("sku_name").(string)},
+		{"armSkuName", d.Get("arm_sku_name").(string)},
+		{"meterId", d.Get("meter_id").(string)},
+		{"armRegionName", location.Normalize(d.Get("location").(string))},
+		{"priceType", d.Get("price_type").(string)},
+	})
+	currencyCode := d.Get("currency_code").(string)
+
+	items, err := listRetailPrices(ctx, meta.(*clients.Client).Billing.RetailPricesClient, filter, currencyCode)
+	if err != nil {
+		return fmt.Errorf("listing Retail Prices matching %q: %+v", filter, err)
+	}
+
+	if err := d.Set("prices", flattenRetailPrices(items)); err != nil {
+		return fmt.Errorf("setting `prices`: %+v", err)
+	}
+
+	// the ID is derived from the query, so that it only changes when the query does
+	d.SetId(fmt.Sprintf("retailPrices-%x", sha256.Sum256([]byte(fmt.Sprintf("%s|%s", currencyCode, filter)))))
+
+	return nil
+}
+
+// flattenRetailPrices returns the prices ordered by ascending retail price, such that the cheapest matching price is
+// always the first item.
+func flattenRetailPrices(input []retailPriceItem) []interface{} {
+	sort.SliceStable(input, func(i, j int) bool {
+		return input[i].RetailPrice < input[j].RetailPrice
+	})
+
+	result := make([]interface{}, 0)
+	for _, v := range input {
+		result = append(result, map[string]interface{}{
+			"arm_sku_name":         v.ArmSkuName,
+			"currency_code":        v.CurrencyCode,
+			"effective_start_date": v.EffectiveStartDate,
+			"location":             location.Normalize(v.ArmRegionName),
+			"meter_id":             v.MeterId,
+			"meter_name":           v.MeterName,
+			"product_name":         v.ProductName,
+			"reservation_term":     v.ReservationTerm,
+			"retail_price":         v.RetailPrice,
+			"service_family":       v.ServiceFamily,
+			"service_name":         v.ServiceName,
+			"sku_name":             v.SkuName,
+			"tier_minimum_units":   v.TierMinimumUnits,
+			"type":                 v.Type,
+			"unit_of_measure":      v.UnitOfMeasure,
+			"unit_price":           v.UnitPrice,
+		})
+	}
+
+	return result
+}
@@ -0,0 +1,77 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package billing_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type RetailPricesDataSource struct{}
+
+func TestAccRetailPricesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_retail_prices", "test")
+	r := RetailPricesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("prices.#").Exists(),
+				check.That(data.ResourceName).Key("prices.0.arm_sku_name").HasValue("Standard_D2s_v5"),
+				check.That(data.ResourceName).Key("prices.0.currency_code").HasValue("USD"),
+				check.That(data.ResourceName).Key("prices.0.retail_price").Exists(),
+			),
+		},
+	})
+}
+
+func TestAccRetailPricesDataSource_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_retail_prices", "test")
+	r := RetailPricesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("prices.#").Exists(),
+				check.That(data.ResourceName).Key("prices.0.location").HasValue(data.Locations.Primary),
+				check.That(data.ResourceName).Key("prices.0.currency_code").HasValue("EUR"),
+				check.That(data.ResourceName).Key("prices.0.type").HasValue("Consumption"),
+			),
+		},
+	})
+}
+
+func (RetailPricesDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_retail_prices" "test" {
+  service_name = "Virtual Machines"
+  arm_sku_name = "Standard_D2s_v5"
+}
+`
+}
+
+func (RetailPricesDataSource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_retail_prices" "test" {
+  service_name  = "Virtual Machines"
+  arm_sku_name  = "Standard_D2s_v5"
+  location      = "%s"
+  price_type    = "Consumption"
+  currency_code = "EUR"
+}
+`, data.Locations.Primary)
+}
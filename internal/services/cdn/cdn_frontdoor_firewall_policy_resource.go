@@ -576,6 +576,17 @@ func resourceCdnFrontDoorFirewallPolicy() *pluginsdk.Resource {
 				}
 				return nil
 			}),
+
+			// Verify that the managed rule set versions and override actions are valid...
+			pluginsdk.CustomizeDiffShim(func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				// the rule set types, versions and actions may reference values which aren't known until apply
+				if !diff.NewValueKnown("managed_rule") {
+					return nil
+				}
+
+				_, err := expandCdnFrontDoorFirewallManagedRules(diff.Get("managed_rule").([]interface{}))
+				return err
+			}),
 		),
 	}
 }
@@ -1010,6 +1021,8 @@ func expandCdnFrontDoorFirewallManagedRules(input []interface{}) (*waf.ManagedRu
 			return nil, fmt.Errorf("the managed rule set type %q and version %q is not supported. If you wish to use the 'DefaultRuleSet' type please update your 'version' field to be '1.0' or 'preview-0.1', got %q", ruleType, version, version)
 		} else if ruleType == "Microsoft_DefaultRuleSet" && fVersion < 1.1 {
 			return nil, fmt.Errorf("the managed rule set type %q and version %q is not supported. If you wish to use the 'Microsoft_DefaultRuleSet' type please update your 'version' field to be '1.1', '2.0' or '2.1', got %q", ruleType, version, version)
+		} else if ruleType == "Microsoft_BotManagerRuleSet" && version != "1.0" && version != "1.1" {
+			return nil, fmt.Errorf("the managed rule set type %q and version %q is not supported. If you wish to use the 'Microsoft_BotManagerRuleSet' type please update your 'version' field to be '1.0' or '1.1', got %q", ruleType, version, version)
 		}
 
 		ruleGroupOverrides, err := expandCdnFrontDoorFirewallManagedRuleGroupOverride(overrides, version, fVersion, ruleType)
@@ -261,6 +261,39 @@ func TestAccCdnFrontDoorFirewallPolicy_jsChallengeManagedRuleUpdate(t *testing.T
 	})
 }
 
+func TestAccCdnFrontDoorFirewallPolicy_botManagerVersionUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_firewall_policy", "test")
+	r := CdnFrontDoorFirewallPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.botManagerRuleSet(data, "1.0"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.botManagerRuleSet(data, "1.1"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_rule.0.version").HasValue("1.1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccCdnFrontDoorFirewallPolicy_botManagerVersionError(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_firewall_policy", "test")
+	r := CdnFrontDoorFirewallPolicyResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.botManagerRuleSet(data, "2.0"),
+			ExpectError: regexp.MustCompile(`If you wish to use the 'Microsoft_BotManagerRuleSet' type please update your 'version' field to be '1.0' or '1.1'`),
+		},
+	})
+}
+
 func TestAccCdnFrontDoorFirewallPolicy_jsChallengeCustomRuleUpdate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_cdn_frontdoor_firewall_policy", "test")
 	r := CdnFrontDoorFirewallPolicyResource{}
@@ -1760,6 +1793,46 @@ resource "azurerm_cdn_frontdoor_firewall_policy" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r CdnFrontDoorFirewallPolicyResource) botManagerRuleSet(data acceptance.TestData, version string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cdn_frontdoor_firewall_policy" "test" {
+  name                = "accTestWAF%d"
+  resource_group_name = azurerm_resource_group.test.name
+  sku_name            = azurerm_cdn_frontdoor_profile.test.sku_name
+  enabled             = true
+  mode                = "Prevention"
+
+  managed_rule {
+    type    = "Microsoft_BotManagerRuleSet"
+    version = "%s"
+    action  = "Log"
+
+    override {
+      rule_group_name = "BadBots"
+
+      rule {
+        rule_id = "Bot100200"
+        enabled = true
+        action  = "JSChallenge"
+      }
+    }
+
+    override {
+      rule_group_name = "GoodBots"
+
+      rule {
+        rule_id = "Bot200100"
+        enabled = true
+        action  = "Log"
+      }
+    }
+  }
+}
+`, r.template(data), data.RandomInteger, version)
+}
+
 func (r CdnFrontDoorFirewallPolicyResource) jsChallengeCustomRuleBasic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
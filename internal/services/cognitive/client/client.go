@@ -8,16 +8,18 @@ import (
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/cognitiveservicesaccounts"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/deployments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/modelcapacities"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/raiblocklists"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/raipolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	AccountsClient      *cognitiveservicesaccounts.CognitiveServicesAccountsClient
-	DeploymentsClient   *deployments.DeploymentsClient
-	RaiBlocklistsClient *raiblocklists.RaiBlocklistsClient
-	RaiPoliciesClient   *raipolicies.RaiPoliciesClient
+	AccountsClient        *cognitiveservicesaccounts.CognitiveServicesAccountsClient
+	DeploymentsClient     *deployments.DeploymentsClient
+	ModelCapacitiesClient *modelcapacities.ModelCapacitiesClient
+	RaiBlocklistsClient   *raiblocklists.RaiBlocklistsClient
+	RaiPoliciesClient     *raipolicies.RaiPoliciesClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -33,6 +35,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(deploymentsClient.Client, o.Authorizers.ResourceManager)
 
+	modelCapacitiesClient, err := modelcapacities.NewModelCapacitiesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Model Capacities client: %+v", err)
+	}
+	o.Configure(modelCapacitiesClient.Client, o.Authorizers.ResourceManager)
+
 	raiPoliciesClient, err := raipolicies.NewRaiPoliciesClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Rai Policies client: %+v", err)
@@ -46,9 +54,10 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(raiBlobklistsClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
-		AccountsClient:      accountsClient,
-		DeploymentsClient:   deploymentsClient,
-		RaiBlocklistsClient: raiBlobklistsClient,
-		RaiPoliciesClient:   raiPoliciesClient,
+		AccountsClient:        accountsClient,
+		DeploymentsClient:     deploymentsClient,
+		ModelCapacitiesClient: modelCapacitiesClient,
+		RaiBlocklistsClient:   raiBlobklistsClient,
+		RaiPoliciesClient:     raiPoliciesClient,
 	}, nil
 }
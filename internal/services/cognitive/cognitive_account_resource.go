@@ -5,6 +5,7 @@ package cognitive
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"time"
@@ -383,9 +384,33 @@ func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
+	location := azure.NormalizeLocation(d.Get("location").(string))
+
+	// before creating check to see if the resource exists in the soft delete state
+	recoverSoftDeleted := false
+	deletedAccountId := cognitiveservicesaccounts.NewDeletedAccountID(id.SubscriptionId, location, id.ResourceGroupName, id.AccountName)
+	softDeleted, err := client.DeletedAccountsGet(ctx, deletedAccountId)
+	if err != nil {
+		// If Terraform lacks permission to read at the Subscription we'll get 403, not 404
+		if !response.WasNotFound(softDeleted.HttpResponse) && !response.WasForbidden(softDeleted.HttpResponse) {
+			return fmt.Errorf("checking for the presence of an existing Soft-Deleted %s: %+v", id, err)
+		}
+	}
+
+	// if so, does the user want us to recover it?
+	if !response.WasNotFound(softDeleted.HttpResponse) && !response.WasForbidden(softDeleted.HttpResponse) {
+		if !meta.(*clients.Client).Features.CognitiveAccount.RecoverSoftDeleted {
+			// this exists but the users opted out, so they must import this it out-of-band
+			return errors.New(optedOutOfRecoveringSoftDeletedCognitiveAccountErrorFmt(id.AccountName, location))
+		}
+
+		log.Printf("[DEBUG] Recovering Soft-Deleted %s..", id)
+		recoverSoftDeleted = true
+	}
+
 	props := cognitiveservicesaccounts.Account{
 		Kind:     utils.String(kind),
-		Location: utils.String(azure.NormalizeLocation(d.Get("location").(string))),
+		Location: utils.String(location),
 		Sku:      &sku,
 		Properties: &cognitiveservicesaccounts.AccountProperties{
 			ApiProperties:                 apiProps,
@@ -402,6 +427,10 @@ func resourceCognitiveAccountCreate(d *pluginsdk.ResourceData, meta interface{})
 		Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
+	if recoverSoftDeleted {
+		props.Properties.Restore = utils.Bool(true)
+	}
+
 	identity, err := identity.ExpandSystemAndUserAssignedMap(d.Get("identity").([]interface{}))
 	if err != nil {
 		return fmt.Errorf("expanding `identity`: %+v", err)
@@ -919,3 +948,20 @@ func flattenCognitiveAccountCustomerManagedKey(input *cognitiveservicesaccounts.
 		},
 	}, nil
 }
+
+func optedOutOfRecoveringSoftDeletedCognitiveAccountErrorFmt(name, location string) string {
+	message := `
+An existing soft-deleted Cognitive Account exists with the Name %q in the location %q, however
+automatically recovering this Cognitive Account has been disabled via the "features" block.
+
+Terraform can automatically recover the soft-deleted Cognitive Account when this behaviour is
+enabled within the "features" block (located within the "provider" block) - more
+information can be found here:
+
+https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs/guides/features-block
+
+Alternatively you can manually recover this (e.g. using the Azure CLI) and then import
+this into Terraform via "terraform import", or pick a different name/location.
+`
+	return fmt.Sprintf(message, name, location)
+}
@@ -27,6 +27,7 @@ type cognitiveDeploymentModel struct {
 	RaiPolicyName            string                 `tfschema:"rai_policy_name"`
 	Sku                      []DeploymentSkuModel   `tfschema:"sku"`
 	VersionUpgradeOption     string                 `tfschema:"version_upgrade_option"`
+	CurrentCapacity          int64                  `tfschema:"current_capacity"`
 }
 
 type DeploymentModelModel struct {
@@ -45,7 +46,10 @@ type DeploymentSkuModel struct {
 
 type CognitiveDeploymentResource struct{}
 
-var _ sdk.Resource = CognitiveDeploymentResource{}
+var (
+	_ sdk.ResourceWithUpdate        = CognitiveDeploymentResource{}
+	_ sdk.ResourceWithCustomizeDiff = CognitiveDeploymentResource{}
+)
 
 func (r CognitiveDeploymentResource) ResourceType() string {
 	return "azurerm_cognitive_deployment"
@@ -120,7 +124,6 @@ func (r CognitiveDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
 					"name": {
 						Type:     pluginsdk.TypeString,
 						Required: true,
-						ForceNew: true,
 						ValidateFunc: validation.StringInSlice([]string{
 							"Standard",
 							"DataZoneBatch",
@@ -188,7 +191,31 @@ func (r CognitiveDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
 }
 
 func (r CognitiveDeploymentResource) Attributes() map[string]*pluginsdk.Schema {
-	return map[string]*pluginsdk.Schema{}
+	return map[string]*pluginsdk.Schema{
+		"current_capacity": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+	}
+}
+
+func (r CognitiveDeploymentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// provisioned throughput deployments can be migrated in-place between the regional, data zone and global
+			// deployment types, any other change to the `sku.0.name` requires the deployment to be recreated
+			if oldVal, newVal := metadata.ResourceDiff.GetChange("sku.0.name"); oldVal.(string) != "" && oldVal.(string) != newVal.(string) {
+				if !isProvisionedDeploymentSku(oldVal.(string)) || !isProvisionedDeploymentSku(newVal.(string)) {
+					if err := metadata.ResourceDiff.ForceNew("sku.0.name"); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+	}
 }
 
 func (r CognitiveDeploymentResource) Create() sdk.ResourceFunc {
@@ -283,6 +310,10 @@ func (r CognitiveDeploymentResource) Update() sdk.ResourceFunc {
 				properties.Properties.DynamicThrottlingEnabled = pointer.To(model.DynamicThrottlingEnabled)
 			}
 
+			if metadata.ResourceData.HasChange("sku.0.name") {
+				properties.Sku.Name = model.Sku[0].Name
+			}
+
 			if metadata.ResourceData.HasChange("sku.0.capacity") {
 				properties.Sku.Capacity = pointer.To(model.Sku[0].Capacity)
 			}
@@ -343,6 +374,7 @@ func (r CognitiveDeploymentResource) Read() sdk.ResourceFunc {
 				state.DynamicThrottlingEnabled = pointer.From(properties.DynamicThrottlingEnabled)
 				state.RaiPolicyName = pointer.From(properties.RaiPolicyName)
 				state.VersionUpgradeOption = string(pointer.From(properties.VersionUpgradeOption))
+				state.CurrentCapacity = pointer.From(properties.CurrentCapacity)
 			}
 			if sku := flattenDeploymentSkuModel(model.Sku); sku != nil {
 				state.Sku = sku
@@ -472,3 +504,12 @@ func flattenDeploymentSkuModel(input *deployments.Sku) []DeploymentSkuModel {
 	}
 	return []DeploymentSkuModel{output}
 }
+
+func isProvisionedDeploymentSku(input string) bool {
+	switch input {
+	case "ProvisionedManaged", "DataZoneProvisionedManaged", "GlobalProvisionedManaged":
+		return true
+	}
+
+	return false
+}
@@ -6,6 +6,7 @@ package cognitive_test
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -114,6 +115,42 @@ func TestAccCognitiveDeployment_update(t *testing.T) {
 	})
 }
 
+func TestAccCognitiveDeployment_provisionedManaged(t *testing.T) {
+	// Provisioned Throughput Units are billed hourly and require quota to be assigned to the subscription
+	if os.Getenv("ARM_TEST_COGNITIVE_PTU_ENABLED") == "" {
+		t.Skip("Skipping as `ARM_TEST_COGNITIVE_PTU_ENABLED` is not specified")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_cognitive_deployment", "test")
+	r := CognitiveDeploymentTestResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.provisionedManaged(data, "GlobalProvisionedManaged", 15),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("current_capacity").HasValue("15"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.provisionedManaged(data, "GlobalProvisionedManaged", 30),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("current_capacity").HasValue("30"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.provisionedManaged(data, "DataZoneProvisionedManaged", 30),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (r CognitiveDeploymentTestResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := deployments.ParseDeploymentID(state.ID)
 	if err != nil {
@@ -282,3 +319,24 @@ resource "azurerm_cognitive_deployment" "test" {
 }
 `, template, data.RandomInteger, versionUpgradeOption)
 }
+
+func (r CognitiveDeploymentTestResource) provisionedManaged(data acceptance.TestData, skuName string, capacity int) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_cognitive_deployment" "test" {
+  name                 = "acctest-cd-%d"
+  cognitive_account_id = azurerm_cognitive_account.test.id
+  model {
+    format  = "OpenAI"
+    name    = "gpt-4o"
+    version = "2024-08-06"
+  }
+  sku {
+    name     = "%s"
+    capacity = %d
+  }
+}
+`, template, data.RandomInteger, skuName, capacity)
+}
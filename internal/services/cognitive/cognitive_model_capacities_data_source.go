@@ -0,0 +1,166 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/cognitive/2024-10-01/modelcapacities"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = CognitiveModelCapacitiesDataSource{}
+
+type CognitiveModelCapacitiesDataSource struct{}
+
+type CognitiveModelCapacitiesDataSourceModel struct {
+	ModelFormat  string                   `tfschema:"model_format"`
+	ModelName    string                   `tfschema:"model_name"`
+	ModelVersion string                   `tfschema:"model_version"`
+	SkuName      string                   `tfschema:"sku_name"`
+	Capacities   []CognitiveModelCapacity `tfschema:"capacities"`
+}
+
+type CognitiveModelCapacity struct {
+	Location                  string  `tfschema:"location"`
+	SkuName                   string  `tfschema:"sku_name"`
+	AvailableCapacity         float64 `tfschema:"available_capacity"`
+	AvailableFinetuneCapacity float64 `tfschema:"available_finetune_capacity"`
+}
+
+func (r CognitiveModelCapacitiesDataSource) ResourceType() string {
+	return "azurerm_cognitive_model_capacities"
+}
+
+func (r CognitiveModelCapacitiesDataSource) ModelObject() interface{} {
+	return &CognitiveModelCapacitiesDataSourceModel{}
+}
+
+func (r CognitiveModelCapacitiesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"model_format": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"model_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"model_version": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"sku_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r CognitiveModelCapacitiesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"capacities": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"location": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"sku_name": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"available_capacity": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+
+					"available_finetune_capacity": {
+						Type:     pluginsdk.TypeFloat,
+						Computed: true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r CognitiveModelCapacitiesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Cognitive.ModelCapacitiesClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var model CognitiveModelCapacitiesDataSourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := commonids.NewSubscriptionID(subscriptionId)
+			options := modelcapacities.ListOperationOptions{
+				ModelFormat:  pointer.To(model.ModelFormat),
+				ModelName:    pointer.To(model.ModelName),
+				ModelVersion: pointer.To(model.ModelVersion),
+			}
+			resp, err := client.ListComplete(ctx, id, options)
+			if err != nil {
+				return fmt.Errorf("listing model capacities for %s: %+v", id, err)
+			}
+
+			capacities := make([]CognitiveModelCapacity, 0)
+			for _, item := range resp.Items {
+				props := item.Properties
+				if props == nil {
+					continue
+				}
+
+				skuName := pointer.From(props.SkuName)
+				if model.SkuName != "" && !strings.EqualFold(skuName, model.SkuName) {
+					continue
+				}
+
+				capacities = append(capacities, CognitiveModelCapacity{
+					Location:                  location.NormalizeNilable(item.Location),
+					SkuName:                   skuName,
+					AvailableCapacity:         pointer.From(props.AvailableCapacity),
+					AvailableFinetuneCapacity: pointer.From(props.AvailableFinetuneCapacity),
+				})
+			}
+
+			// order the regions with the most available capacity first, so the first entry can be used for placement
+			sort.SliceStable(capacities, func(i, j int) bool {
+				if capacities[i].AvailableCapacity != capacities[j].AvailableCapacity {
+					return capacities[i].AvailableCapacity > capacities[j].AvailableCapacity
+				}
+				return capacities[i].Location < capacities[j].Location
+			})
+			model.Capacities = capacities
+
+			metadata.ResourceData.SetId(fmt.Sprintf("%s/providers/Microsoft.CognitiveServices/modelCapacities/%s/%s/%s", id.ID(), model.ModelFormat, model.ModelName, model.ModelVersion))
+
+			return metadata.Encode(&model)
+		},
+	}
+}
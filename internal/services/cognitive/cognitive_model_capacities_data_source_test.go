@@ -0,0 +1,44 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cognitive_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type CognitiveModelCapacitiesDataSource struct{}
+
+func TestAccCognitiveModelCapacitiesDataSource_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_cognitive_model_capacities", "test")
+	r := CognitiveModelCapacitiesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("capacities.#").IsSet(),
+				check.That(data.ResourceName).Key("capacities.0.location").IsSet(),
+				check.That(data.ResourceName).Key("capacities.0.sku_name").HasValue("GlobalStandard"),
+			),
+		},
+	})
+}
+
+func (CognitiveModelCapacitiesDataSource) basic() string {
+	return `
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_cognitive_model_capacities" "test" {
+  model_format  = "OpenAI"
+  model_name    = "gpt-4o"
+  model_version = "2024-08-06"
+  sku_name      = "GlobalStandard"
+}
+`
+}
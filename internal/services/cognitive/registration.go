@@ -48,7 +48,9 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 
 // DataSources returns a list of Data Sources supported by this Service
 func (r Registration) DataSources() []sdk.DataSource {
-	return []sdk.DataSource{}
+	return []sdk.DataSource{
+		CognitiveModelCapacitiesDataSource{},
+	}
 }
 
 // Resources returns a list of Resources supported by this Service
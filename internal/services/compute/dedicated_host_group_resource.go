@@ -8,6 +8,7 @@ import (
 	"log"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
@@ -67,6 +68,14 @@ func resourceDedicatedHostGroup() *pluginsdk.Resource {
 				ForceNew: true,
 				Default:  false,
 			},
+
+			"ultra_ssd_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				ForceNew: true,
+				Default:  false,
+			},
+
 			"zone": commonschema.ZoneSingleOptionalForceNew(),
 
 			"tags": commonschema.Tags(),
@@ -114,6 +123,12 @@ func resourceDedicatedHostGroupCreate(d *pluginsdk.ResourceData, meta interface{
 		payload.Properties.SupportAutomaticPlacement = utils.Bool(v.(bool))
 	}
 
+	if d.Get("ultra_ssd_enabled").(bool) {
+		payload.Properties.AdditionalCapabilities = &dedicatedhostgroups.DedicatedHostGroupPropertiesAdditionalCapabilities{
+			UltraSSDEnabled: utils.Bool(true),
+		}
+	}
+
 	if _, err := client.CreateOrUpdate(ctx, id, payload); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
@@ -158,6 +173,12 @@ func resourceDedicatedHostGroupRead(d *pluginsdk.ResourceData, meta interface{})
 		if props := model.Properties; props != nil {
 			d.Set("platform_fault_domain_count", props.PlatformFaultDomainCount)
 			d.Set("automatic_placement_enabled", props.SupportAutomaticPlacement)
+
+			ultraSSDEnabled := false
+			if props.AdditionalCapabilities != nil {
+				ultraSSDEnabled = pointer.From(props.AdditionalCapabilities.UltraSSDEnabled)
+			}
+			d.Set("ultra_ssd_enabled", ultraSSDEnabled)
 		}
 
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -63,7 +64,6 @@ func resourceDedicatedHost() *pluginsdk.Resource {
 
 			"sku_name": {
 				Type:     pluginsdk.TypeString,
-				ForceNew: true,
 				Required: true,
 				ValidateFunc: validation.StringInSlice([]string{
 					"DADSv5-Type1",
@@ -140,6 +140,13 @@ func resourceDedicatedHost() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			// a Dedicated Host can only be resized to another SKU within the same VM series (e.g. `DSv3-Type1` -> `DSv3-Type4`)
+			pluginsdk.ForceNewIfChange("sku_name", func(ctx context.Context, old, new, meta interface{}) bool {
+				return !strings.EqualFold(dedicatedHostSkuSeries(old.(string)), dedicatedHostSkuSeries(new.(string)))
+			}),
+		),
 	}
 }
 
@@ -257,6 +264,12 @@ func resourceDedicatedHostUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		}
 	}
 
+	if d.HasChange("sku_name") {
+		payload.Sku = &dedicatedhosts.Sku{
+			Name: pointer.To(d.Get("sku_name").(string)),
+		}
+	}
+
 	if d.HasChange("tags") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
@@ -314,3 +327,8 @@ func dedicatedHostDeletedRefreshFunc(ctx context.Context, client *dedicatedhosts
 		return res, "Exists", nil
 	}
 }
+
+// dedicatedHostSkuSeries returns the VM series portion of a Dedicated Host SKU, e.g. `DSv3` for `DSv3-Type1`
+func dedicatedHostSkuSeries(input string) string {
+	return strings.Split(input, "-")[0]
+}
@@ -49,6 +49,29 @@ func TestAccDedicatedHost_basicNewSku(t *testing.T) {
 	})
 }
 
+func TestAccDedicatedHost_resizeSku(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dedicated_host", "test")
+	r := DedicatedHostResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.resizedSku(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("sku_name").HasValue("DSv3-Type4"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccDedicatedHost_autoReplaceOnFailure(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_dedicated_host", "test")
 	r := DedicatedHostResource{}
@@ -211,6 +234,20 @@ resource "azurerm_dedicated_host" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r DedicatedHostResource) resizedSku(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_dedicated_host" "test" {
+  name                    = "acctest-DH-%d"
+  location                = azurerm_resource_group.test.location
+  dedicated_host_group_id = azurerm_dedicated_host_group.test.id
+  sku_name                = "DSv3-Type4"
+  platform_fault_domain   = 1
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r DedicatedHostResource) autoReplaceOnFailure(data acceptance.TestData, replace bool) string {
 	return fmt.Sprintf(`
 %s
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
@@ -50,6 +51,21 @@ func dataSourceDiskEncryptionSet() *pluginsdk.Resource {
 				Computed: true,
 			},
 
+			"managed_hsm_key_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"encryption_type": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
+			"federated_client_id": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			"identity": commonschema.SystemAssignedUserAssignedIdentityComputed(),
 
 			"tags": commonschema.TagsDataSource(),
@@ -97,6 +113,14 @@ func dataSourceDiskEncryptionSetRead(d *pluginsdk.ResourceData, meta interface{}
 				d.Set("key_vault_key_url", keyVaultURI)
 			}
 		}
+
+		encryptionType := ""
+		if props.EncryptionType != nil {
+			encryptionType = string(*props.EncryptionType)
+		}
+		d.Set("encryption_type", encryptionType)
+
+		d.Set("federated_client_id", pointer.From(props.FederatedClientId))
 	}
 
 	flattenedIdentity, err := identity.FlattenSystemAndUserAssignedMap(model.Identity)
@@ -22,6 +22,8 @@ func TestAccDataSourceDiskEncryptionSet_basic(t *testing.T) {
 			Check: acceptance.ComposeTestCheckFunc(
 				check.That(data.ResourceName).Key("location").Exists(),
 				check.That(data.ResourceName).Key("auto_key_rotation_enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("encryption_type").HasValue("EncryptionAtRestWithCustomerKey"),
+				check.That(data.ResourceName).Key("key_vault_key_url").Exists(),
 			),
 		},
 	})
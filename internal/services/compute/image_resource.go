@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/images"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
@@ -77,6 +78,14 @@ func resourceImage() *pluginsdk.Resource {
 				ValidateFunc: commonids.ValidateVirtualMachineID,
 			},
 
+			"generalize_source_vm": {
+				Type:         pluginsdk.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      false,
+				RequiredWith: []string{"source_virtual_machine_id"},
+			},
+
 			"os_disk": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -247,6 +256,17 @@ func resourceImageCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	sourceVM := images.SubResource{}
 	if v, ok := d.GetOk("source_virtual_machine_id"); ok {
 		sourceVM.Id = pointer.To(v.(string))
+
+		if d.IsNewResource() && d.Get("generalize_source_vm").(bool) {
+			virtualMachineId, err := virtualmachines.ParseVirtualMachineIDInsensitively(v.(string))
+			if err != nil {
+				return err
+			}
+
+			if err := deallocateAndGeneralizeVirtualMachine(ctx, meta.(*clients.Client).Compute.VirtualMachinesClient, *virtualMachineId); err != nil {
+				return err
+			}
+		}
 	}
 
 	storageProfile := images.ImageStorageProfile{
@@ -304,6 +324,9 @@ func resourceImageRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	d.Set("name", id.ImageName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
+	// this isn't returned by the API, so we look it up from the config/state (defaulting to `false` when imported)
+	d.Set("generalize_source_vm", d.Get("generalize_source_vm").(bool))
+
 	if model := resp.Model; model != nil {
 		d.Set("location", location.Normalize(model.Location))
 
@@ -142,6 +142,21 @@ func TestAccImage_customImageFromVMWithManagedDisks(t *testing.T) {
 	})
 }
 
+func TestAccImage_customImageFromVMWithManagedDisksGeneralized(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_image", "test")
+	r := ImageResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.customImageFromManagedDiskVMGeneralized(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("generalize_source_vm"),
+	})
+}
+
 func TestAccImage_customImageFromVMSSWithUnmanagedDisks(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_image", "test")
 	r := ImageResource{}
@@ -721,6 +736,21 @@ resource "azurerm_virtual_machine" "testdestination" {
 `, template)
 }
 
+func (r ImageResource) customImageFromManagedDiskVMGeneralized(data acceptance.TestData) string {
+	template := r.setupManagedDisks(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_image" "test" {
+  name                      = "acctest-${local.number}"
+  location                  = azurerm_resource_group.test.location
+  resource_group_name       = azurerm_resource_group.test.name
+  source_virtual_machine_id = azurerm_virtual_machine.testsource.id
+  generalize_source_vm      = true
+}
+`, template)
+}
+
 func (r ImageResource) customImageFromVMSSWithUnmanagedDisksProvision(data acceptance.TestData) string {
 	template := r.setupUnmanagedDisks(data)
 
@@ -309,6 +309,19 @@ func resourceManagedDisk() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("encryption_settings", func(ctx context.Context, old, new, meta interface{}) bool {
 				return len(old.([]interface{})) > 0 && len(new.([]interface{})) == 0
 			}),
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, i interface{}) error {
+				// `tier` is Computed, so only validate it when it's been explicitly specified
+				if tier := diff.GetRawConfig().AsValueMap()["tier"]; tier.IsNull() || !tier.IsKnown() {
+					return nil
+				}
+
+				storageAccountType := diff.Get("storage_account_type").(string)
+				if storageAccountType != string(disks.DiskStorageAccountTypesPremiumZRS) && storageAccountType != string(disks.DiskStorageAccountTypesPremiumLRS) {
+					return fmt.Errorf("`tier` can only be specified when `storage_account_type` is set to `Premium_LRS` or `Premium_ZRS`")
+				}
+
+				return nil
+			},
 		),
 	}
 }
@@ -633,6 +646,15 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 
 	if d.HasChange("max_shares") {
+		// unlike the other properties, the VM(s) a shared disk is attached to can't be shut down to change this - the disk must be detached from all of them
+		if model := disk.Model; model != nil && (model.ManagedBy != nil || len(pointer.From(model.ManagedByExtended)) > 0) {
+			attachedTo := pointer.From(model.ManagedByExtended)
+			if len(attachedTo) == 0 {
+				attachedTo = []string{*model.ManagedBy}
+			}
+			return fmt.Errorf("`max_shares` can only be changed when %s isn't attached to any Virtual Machines, however it's currently attached to: %s", id, strings.Join(attachedTo, ", "))
+		}
+
 		diskUpdate.Properties.MaxShares = pointer.To(int64(maxShares))
 		var skuName disks.DiskStorageAccountTypes
 		for _, v := range disks.PossibleValuesForDiskStorageAccountTypes() {
@@ -649,7 +671,10 @@ func resourceManagedDiskUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		if storageAccountType != string(disks.DiskStorageAccountTypesPremiumZRS) && storageAccountType != string(disks.DiskStorageAccountTypesPremiumLRS) {
 			return fmt.Errorf("`tier` can only be specified when `storage_account_type` is set to `Premium_LRS` or `Premium_ZRS`")
 		}
-		shouldShutDown = true
+		// the performance tier can be changed without any downtime, other than for shared disks
+		if maxShares > 1 {
+			shouldShutDown = true
+		}
 		tier := d.Get("tier").(string)
 		diskUpdate.Properties.Tier = &tier
 	}
@@ -6,6 +6,7 @@ package compute_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 	"time"
@@ -411,6 +412,18 @@ func TestAccManagedDisk_attachedTierUpdate(t *testing.T) {
 	})
 }
 
+func TestAccManagedDisk_tierUnsupportedStorageAccountType(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_managed_disk", "test")
+	r := ManagedDiskResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.tierUnsupportedStorageAccountType(data),
+			ExpectError: regexp.MustCompile("`tier` can only be specified when `storage_account_type` is set to `Premium_LRS` or `Premium_ZRS`"),
+		},
+	})
+}
+
 func TestAccManagedDisk_networkPolicy(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_managed_disk", "test")
 	r := ManagedDiskResource{}
@@ -1747,6 +1760,29 @@ resource "azurerm_virtual_machine_data_disk_attachment" "test" {
 `, r.templateAttached(data), data.RandomInteger, diskSize)
 }
 
+func (ManagedDiskResource) tierUnsupportedStorageAccountType(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_managed_disk" "test" {
+  name                 = "acctestd-%d"
+  location             = azurerm_resource_group.test.location
+  resource_group_name  = azurerm_resource_group.test.name
+  storage_account_type = "Standard_LRS"
+  create_option        = "Empty"
+  disk_size_gb         = "1"
+  tier                 = "P10"
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
+
 func (r ManagedDiskResource) tierUpdateWhileAttached(data acceptance.TestData, tier string) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -22,6 +22,7 @@ func resourceMarketplaceAgreement() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceMarketplaceAgreementCreate,
 		Read:   resourceMarketplaceAgreementRead,
+		Update: resourceMarketplaceAgreementUpdate,
 		Delete: resourceMarketplaceAgreementDelete,
 		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
 			_, err := agreements.ParsePlanID(id)
@@ -31,6 +32,7 @@ func resourceMarketplaceAgreement() *pluginsdk.Resource {
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(5 * time.Minute),
 			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
 		},
 
@@ -56,6 +58,17 @@ func resourceMarketplaceAgreement() *pluginsdk.Resource {
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 
+			"adopt_existing": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"adopted": {
+				Type:     pluginsdk.TypeBool,
+				Computed: true,
+			},
+
 			"license_text_link": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -96,7 +109,15 @@ func resourceMarketplaceAgreementCreate(d *pluginsdk.ResourceData, meta interfac
 		}
 	}
 	if accepted {
-		return tf.ImportAsExistsError("azurerm_marketplace_agreement", id.ID())
+		if !d.Get("adopt_existing").(bool) {
+			return tf.ImportAsExistsError("azurerm_marketplace_agreement", id.ID())
+		}
+
+		// the existing agreement wasn't accepted by Terraform, so it mustn't be cancelled when this resource is destroyed
+		log.Printf("[DEBUG] The Marketplace Terms for %s have already been accepted - adopting the existing agreement", id)
+		d.SetId(id.ID())
+		d.Set("adopted", true)
+		return resourceMarketplaceAgreementRead(d, meta)
 	}
 
 	resp, err := client.MarketplaceAgreementsGet(ctx, agreementId)
@@ -165,6 +186,11 @@ func resourceMarketplaceAgreementRead(d *pluginsdk.ResourceData, meta interface{
 	return nil
 }
 
+func resourceMarketplaceAgreementUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	// `adopt_existing` is the only updatable field and is only used during creation, so changing it is a no-op
+	return resourceMarketplaceAgreementRead(d, meta)
+}
+
 func resourceMarketplaceAgreementDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Compute.MarketplaceAgreementsClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -175,6 +201,11 @@ func resourceMarketplaceAgreementDelete(d *pluginsdk.ResourceData, meta interfac
 		return err
 	}
 
+	if d.Get("adopted").(bool) {
+		log.Printf("[DEBUG] The Marketplace Terms for %s were accepted outside of Terraform - removing from state without cancelling", *id)
+		return nil
+	}
+
 	if _, err = client.MarketplaceAgreementsCancel(ctx, *id); err != nil {
 		return fmt.Errorf("cancelling agreement for %s: %s", *id, err)
 	}
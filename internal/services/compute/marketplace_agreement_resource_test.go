@@ -70,6 +70,35 @@ func TestAccMarketplaceAgreement_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccMarketplaceAgreement_adoptExisting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_agreement", "test")
+	r := MarketplaceAgreementResource{}
+	offer := "barracuda-ng-firewall"
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.empty(),
+			Check: acceptance.ComposeTestCheckFunc(
+				data.CheckWithClientWithoutResource(r.cancelExistingAgreement(offer)),
+			),
+		},
+		{
+			Config: r.basic(offer),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.adoptExisting(offer),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_marketplace_agreement.adopted").ExistsInAzure(r),
+				check.That("azurerm_marketplace_agreement.adopted").Key("adopted").HasValue("true"),
+			),
+		},
+	})
+}
+
 func (t MarketplaceAgreementResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := agreements.ParsePlanID(state.ID)
 	if err != nil {
@@ -123,6 +152,19 @@ resource "azurerm_marketplace_agreement" "import" {
 `, r.basic(offer))
 }
 
+func (r MarketplaceAgreementResource) adoptExisting(offer string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_agreement" "adopted" {
+  publisher      = azurerm_marketplace_agreement.test.publisher
+  offer          = azurerm_marketplace_agreement.test.offer
+  plan           = azurerm_marketplace_agreement.test.plan
+  adopt_existing = true
+}
+`, r.basic(offer))
+}
+
 func (MarketplaceAgreementResource) empty() string {
 	return `
 provider "azurerm" {
@@ -0,0 +1,123 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachineimages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+func dataSourceMarketplaceImageVersions() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Read: dataSourceMarketplaceImageVersionsRead,
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Read: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"location": commonschema.Location(),
+
+			"publisher": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"offer": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"sku": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"versions": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Schema{
+					Type: pluginsdk.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func dataSourceMarketplaceImageVersionsRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Compute.VirtualMachineImagesClient
+	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id := virtualmachineimages.NewSkuID(subscriptionId, location.Normalize(d.Get("location").(string)), d.Get("publisher").(string), d.Get("offer").(string), d.Get("sku").(string))
+
+	resp, err := client.List(ctx, id, virtualmachineimages.DefaultListOperationOptions())
+	if err != nil {
+		return fmt.Errorf("listing versions for %s: %+v", id, err)
+	}
+
+	versions := make([]string, 0)
+	if model := resp.Model; model != nil {
+		for _, item := range *model {
+			versions = append(versions, item.Name)
+		}
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return compareMarketplaceImageVersions(versions[i], versions[j]) < 0
+	})
+
+	d.SetId(id.ID())
+
+	d.Set("location", location.Normalize(id.LocationName))
+	d.Set("publisher", id.PublisherName)
+	d.Set("offer", id.OfferName)
+	d.Set("sku", id.SkuName)
+	if err := d.Set("versions", versions); err != nil {
+		return fmt.Errorf("setting `versions`: %+v", err)
+	}
+
+	return nil
+}
+
+// compareMarketplaceImageVersions compares two image versions (e.g. `22.04.202310040`) segment by segment,
+// numerically where both segments are numbers and lexically otherwise
+func compareMarketplaceImageVersions(a, b string) int {
+	aSegments := strings.Split(a, ".")
+	bSegments := strings.Split(b, ".")
+
+	for i := 0; i < len(aSegments) && i < len(bSegments); i++ {
+		aInt, aErr := strconv.ParseInt(aSegments[i], 10, 64)
+		bInt, bErr := strconv.ParseInt(bSegments[i], 10, 64)
+		if aErr == nil && bErr == nil {
+			if aInt != bInt {
+				if aInt < bInt {
+					return -1
+				}
+				return 1
+			}
+			continue
+		}
+
+		if c := strings.Compare(aSegments[i], bSegments[i]); c != 0 {
+			return c
+		}
+	}
+
+	return len(aSegments) - len(bSegments)
+}
@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type MarketplaceImageVersionsDataSource struct{}
+
+func TestAccDataSourceMarketplaceImageVersions_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_marketplace_image_versions", "test")
+	r := MarketplaceImageVersionsDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("publisher").HasValue("Canonical"),
+				check.That(data.ResourceName).Key("offer").HasValue("0001-com-ubuntu-server-jammy"),
+				check.That(data.ResourceName).Key("sku").HasValue("22_04-lts"),
+				check.That(data.ResourceName).Key("versions.#").Exists(),
+				check.That(data.ResourceName).Key("versions.0").Exists(),
+			),
+		},
+	})
+}
+
+func (MarketplaceImageVersionsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_marketplace_image_versions" "test" {
+  location  = "%s"
+  publisher = "Canonical"
+  offer     = "0001-com-ubuntu-server-jammy"
+  sku       = "22_04-lts"
+}
+`, data.Locations.Primary)
+}
@@ -0,0 +1,284 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/galleries"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/gallerysharingupdate"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type SharedImageGallerySharingResource struct{}
+
+var _ sdk.ResourceWithUpdate = SharedImageGallerySharingResource{}
+
+type SharedImageGallerySharingResourceModel struct {
+	GalleryId       string   `tfschema:"gallery_id"`
+	SubscriptionIds []string `tfschema:"subscription_ids"`
+	TenantIds       []string `tfschema:"tenant_ids"`
+}
+
+func (r SharedImageGallerySharingResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"gallery_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSharedImageGalleryID,
+		},
+
+		"subscription_ids": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+			AtLeastOneOf: []string{"subscription_ids", "tenant_ids"},
+		},
+
+		"tenant_ids": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsUUID,
+			},
+			AtLeastOneOf: []string{"subscription_ids", "tenant_ids"},
+		},
+	}
+}
+
+func (r SharedImageGallerySharingResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SharedImageGallerySharingResource) ResourceType() string {
+	return "azurerm_shared_image_gallery_sharing"
+}
+
+func (r SharedImageGallerySharingResource) ModelObject() interface{} {
+	return &SharedImageGallerySharingResourceModel{}
+}
+
+func (r SharedImageGallerySharingResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateSharedImageGalleryID
+}
+
+func (r SharedImageGallerySharingResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.GalleriesClient
+			sharingClient := metadata.Client.Compute.GallerySharingUpdateClient
+
+			var state SharedImageGallerySharingResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			id, err := commonids.ParseSharedImageGalleryID(state.GalleryId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			resp, err := client.Get(ctx, *id, galleries.GetOperationOptions{Select: pointer.To(galleries.SelectPermissionsPermissions)})
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			profile := sharingProfileForGallery(resp.Model)
+			if profile == nil || pointer.From(profile.Permissions) != galleries.GallerySharingPermissionTypesGroups {
+				return fmt.Errorf("the `sharing` block of %s must have `permission` set to `Groups` to share it with Subscriptions and Tenants", id)
+			}
+
+			for _, group := range pointer.From(profile.Groups) {
+				if len(pointer.From(group.Ids)) > 0 {
+					return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+				}
+			}
+
+			payload := gallerysharingupdate.SharingUpdate{
+				OperationType: gallerysharingupdate.SharingUpdateOperationTypesAdd,
+				Groups:        expandSharedImageGallerySharingGroups(state.SubscriptionIds, state.TenantIds),
+			}
+			if err := sharingClient.GallerySharingProfileUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("adding sharing groups to %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r SharedImageGallerySharingResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Compute.GalleriesClient
+
+			id, err := commonids.ParseSharedImageGalleryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id, galleries.GetOperationOptions{Select: pointer.To(galleries.SelectPermissionsPermissions)})
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			profile := sharingProfileForGallery(resp.Model)
+			if profile == nil || pointer.From(profile.Permissions) != galleries.GallerySharingPermissionTypesGroups {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := SharedImageGallerySharingResourceModel{
+				GalleryId:       id.ID(),
+				SubscriptionIds: make([]string, 0),
+				TenantIds:       make([]string, 0),
+			}
+			for _, group := range pointer.From(profile.Groups) {
+				switch {
+				case strings.EqualFold(string(pointer.From(group.Type)), string(galleries.SharingProfileGroupTypesSubscriptions)):
+					state.SubscriptionIds = append(state.SubscriptionIds, pointer.From(group.Ids)...)
+				case strings.EqualFold(string(pointer.From(group.Type)), string(galleries.SharingProfileGroupTypesAADTenants)):
+					state.TenantIds = append(state.TenantIds, pointer.From(group.Ids)...)
+				}
+			}
+
+			if len(state.SubscriptionIds) == 0 && len(state.TenantIds) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			return metadata.Encode(&state)
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+func (r SharedImageGallerySharingResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			sharingClient := metadata.Client.Compute.GallerySharingUpdateClient
+
+			id, err := commonids.ParseSharedImageGalleryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			oldSubscriptionIds, newSubscriptionIds := metadata.ResourceData.GetChange("subscription_ids")
+			oldTenantIds, newTenantIds := metadata.ResourceData.GetChange("tenant_ids")
+
+			removedSubscriptionIds := oldSubscriptionIds.(*pluginsdk.Set).Difference(newSubscriptionIds.(*pluginsdk.Set))
+			removedTenantIds := oldTenantIds.(*pluginsdk.Set).Difference(newTenantIds.(*pluginsdk.Set))
+			if removedSubscriptionIds.Len() > 0 || removedTenantIds.Len() > 0 {
+				payload := gallerysharingupdate.SharingUpdate{
+					OperationType: gallerysharingupdate.SharingUpdateOperationTypesRemove,
+					Groups:        expandSharedImageGallerySharingGroups(pointer.From(utils.ExpandStringSlice(removedSubscriptionIds.List())), pointer.From(utils.ExpandStringSlice(removedTenantIds.List()))),
+				}
+				if err := sharingClient.GallerySharingProfileUpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("removing sharing groups from %s: %+v", id, err)
+				}
+			}
+
+			addedSubscriptionIds := newSubscriptionIds.(*pluginsdk.Set).Difference(oldSubscriptionIds.(*pluginsdk.Set))
+			addedTenantIds := newTenantIds.(*pluginsdk.Set).Difference(oldTenantIds.(*pluginsdk.Set))
+			if addedSubscriptionIds.Len() > 0 || addedTenantIds.Len() > 0 {
+				payload := gallerysharingupdate.SharingUpdate{
+					OperationType: gallerysharingupdate.SharingUpdateOperationTypesAdd,
+					Groups:        expandSharedImageGallerySharingGroups(pointer.From(utils.ExpandStringSlice(addedSubscriptionIds.List())), pointer.From(utils.ExpandStringSlice(addedTenantIds.List()))),
+				}
+				if err := sharingClient.GallerySharingProfileUpdateThenPoll(ctx, *id, payload); err != nil {
+					return fmt.Errorf("adding sharing groups to %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func (r SharedImageGallerySharingResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			sharingClient := metadata.Client.Compute.GallerySharingUpdateClient
+
+			var state SharedImageGallerySharingResourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			id, err := commonids.ParseSharedImageGalleryID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			// a `Reset` would also change the `permission` of the Gallery, which is managed by `azurerm_shared_image_gallery`
+			payload := gallerysharingupdate.SharingUpdate{
+				OperationType: gallerysharingupdate.SharingUpdateOperationTypesRemove,
+				Groups:        expandSharedImageGallerySharingGroups(state.SubscriptionIds, state.TenantIds),
+			}
+			if err := sharingClient.GallerySharingProfileUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("removing sharing groups from %s: %+v", id, err)
+			}
+
+			return nil
+		},
+		Timeout: 30 * time.Minute,
+	}
+}
+
+func sharingProfileForGallery(input *galleries.Gallery) *galleries.SharingProfile {
+	if input == nil || input.Properties == nil {
+		return nil
+	}
+
+	return input.Properties.SharingProfile
+}
+
+func expandSharedImageGallerySharingGroups(subscriptionIds []string, tenantIds []string) *[]gallerysharingupdate.SharingProfileGroup {
+	groups := make([]gallerysharingupdate.SharingProfileGroup, 0)
+
+	if len(subscriptionIds) > 0 {
+		groups = append(groups, gallerysharingupdate.SharingProfileGroup{
+			Type: pointer.To(gallerysharingupdate.SharingProfileGroupTypesSubscriptions),
+			Ids:  pointer.To(subscriptionIds),
+		})
+	}
+
+	if len(tenantIds) > 0 {
+		groups = append(groups, gallerysharingupdate.SharingProfileGroup{
+			Type: pointer.To(gallerysharingupdate.SharingProfileGroupTypesAADTenants),
+			Ids:  pointer.To(tenantIds),
+		})
+	}
+
+	return &groups
+}
@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package compute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/galleries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SharedImageGallerySharingResource struct{}
+
+func TestAccSharedImageGallerySharing_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_shared_image_gallery_sharing", "test")
+	r := SharedImageGallerySharingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccSharedImageGallerySharing_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_shared_image_gallery_sharing", "test")
+	r := SharedImageGallerySharingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSharedImageGallerySharing_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_shared_image_gallery_sharing", "test")
+	r := SharedImageGallerySharingResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SharedImageGallerySharingResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseSharedImageGalleryID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Compute.GalleriesClient.Get(ctx, *id, galleries.GetOperationOptions{Select: pointer.To(galleries.SelectPermissionsPermissions)})
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.SharingProfile != nil {
+		for _, group := range pointer.From(model.Properties.SharingProfile.Groups) {
+			if len(pointer.From(group.Ids)) > 0 {
+				return pointer.To(true), nil
+			}
+		}
+	}
+
+	return pointer.To(false), nil
+}
+
+func (r SharedImageGallerySharingResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_gallery_sharing" "test" {
+  gallery_id       = azurerm_shared_image_gallery.test.id
+  subscription_ids = [data.azurerm_client_config.current.subscription_id]
+}
+`, r.template(data))
+}
+
+func (r SharedImageGallerySharingResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_gallery_sharing" "import" {
+  gallery_id       = azurerm_shared_image_gallery_sharing.test.gallery_id
+  subscription_ids = azurerm_shared_image_gallery_sharing.test.subscription_ids
+}
+`, r.basic(data))
+}
+
+func (r SharedImageGallerySharingResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_shared_image_gallery_sharing" "test" {
+  gallery_id       = azurerm_shared_image_gallery.test.id
+  subscription_ids = [data.azurerm_client_config.current.subscription_id]
+  tenant_ids       = [data.azurerm_client_config.current.tenant_id]
+}
+`, r.template(data))
+}
+
+func (SharedImageGallerySharingResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_shared_image_gallery" "test" {
+  name                = "acctestsig%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sharing {
+    permission = "Groups"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomInteger)
+}
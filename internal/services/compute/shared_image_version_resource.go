@@ -18,6 +18,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-01/images"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2022-03-03/galleryimageversions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
@@ -166,6 +167,14 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 				ExactlyOneOf: []string{"blob_uri", "os_disk_snapshot_id", "managed_image_id"},
 			},
 
+			"generalize_source_vm": {
+				Type:         pluginsdk.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      false,
+				RequiredWith: []string{"managed_image_id"},
+			},
+
 			"replication_mode": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
@@ -197,6 +206,19 @@ func resourceSharedImageVersion() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("end_of_life_date", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old.(string) != "" && new.(string) == ""
 			}),
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, i interface{}) error {
+				if !diff.Get("generalize_source_vm").(bool) {
+					return nil
+				}
+
+				if managedImageId := diff.Get("managed_image_id").(string); managedImageId != "" {
+					if _, err := commonids.ParseVirtualMachineIDInsensitively(managedImageId); err != nil {
+						return fmt.Errorf("`generalize_source_vm` can only be enabled when `managed_image_id` is the ID of a Virtual Machine")
+					}
+				}
+
+				return nil
+			},
 		),
 	}
 }
@@ -249,6 +271,17 @@ func resourceSharedImageVersionCreate(d *pluginsdk.ResourceData, meta interface{
 	}
 
 	if v, ok := d.GetOk("managed_image_id"); ok {
+		if d.Get("generalize_source_vm").(bool) {
+			virtualMachineId, err := virtualmachines.ParseVirtualMachineIDInsensitively(v.(string))
+			if err != nil {
+				return err
+			}
+
+			if err := deallocateAndGeneralizeVirtualMachine(ctx, meta.(*clients.Client).Compute.VirtualMachinesClient, *virtualMachineId); err != nil {
+				return err
+			}
+		}
+
 		version.Properties.StorageProfile.Source = &galleryimageversions.GalleryArtifactVersionFullSource{
 			Id: utils.String(v.(string)),
 		}
@@ -368,6 +401,9 @@ func resourceSharedImageVersionRead(d *pluginsdk.ResourceData, meta interface{})
 	d.Set("gallery_name", id.GalleryName)
 	d.Set("resource_group_name", id.ResourceGroupName)
 
+	// this isn't returned by the API, so we look it up from the config/state (defaulting to `false` when imported)
+	d.Set("generalize_source_vm", d.Get("generalize_source_vm").(bool))
+
 	if model := resp.Model; model != nil {
 		d.Set("location", location.Normalize(model.Location))
 
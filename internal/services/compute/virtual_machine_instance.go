@@ -4,9 +4,13 @@
 package compute
 
 import (
+	"context"
+	"fmt"
+	"log"
 	"strings"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/compute/2024-03-01/virtualmachines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 )
 
 // virtualMachineShouldBeStarted determines if the Virtual Machine should be started after
@@ -35,3 +39,22 @@ func virtualMachineShouldBeStarted(instanceView *virtualmachines.VirtualMachineI
 
 	return false
 }
+
+// deallocateAndGeneralizeVirtualMachine deallocates and then generalizes the specified Virtual Machine so that it can be
+// used as the source of an Image or Shared Image Version - once generalized the Virtual Machine can no longer be started.
+func deallocateAndGeneralizeVirtualMachine(ctx context.Context, client *virtualmachines.VirtualMachinesClient, id virtualmachines.VirtualMachineId) error {
+	locks.ByName(id.VirtualMachineName, VirtualMachineResourceName)
+	defer locks.UnlockByName(id.VirtualMachineName, VirtualMachineResourceName)
+
+	log.Printf("[DEBUG] Deallocating %s..", id)
+	if err := client.DeallocateThenPoll(ctx, id, virtualmachines.DefaultDeallocateOperationOptions()); err != nil {
+		return fmt.Errorf("deallocating %s: %+v", id, err)
+	}
+
+	log.Printf("[DEBUG] Generalizing %s..", id)
+	if _, err := client.Generalize(ctx, id); err != nil {
+		return fmt.Errorf("generalizing %s: %+v", id, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,335 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-02-02-preview/javacomponents"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-03-01/managedenvironments"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/containerapps/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContainerAppEnvironmentJavaComponentResource struct{}
+
+type ContainerAppEnvironmentJavaComponentModel struct {
+	Name                      string                                   `tfschema:"name"`
+	ContainerAppEnvironmentId string                                   `tfschema:"container_app_environment_id"`
+	ComponentType             string                                   `tfschema:"component_type"`
+	Configuration             map[string]string                        `tfschema:"configuration"`
+	ServiceBinds              []ContainerAppEnvironmentJavaServiceBind `tfschema:"service_bind"`
+}
+
+type ContainerAppEnvironmentJavaServiceBind struct {
+	Name      string `tfschema:"name"`
+	ServiceId string `tfschema:"service_id"`
+}
+
+var _ sdk.ResourceWithUpdate = ContainerAppEnvironmentJavaComponentResource{}
+
+func (r ContainerAppEnvironmentJavaComponentResource) ModelObject() interface{} {
+	return &ContainerAppEnvironmentJavaComponentModel{}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) ResourceType() string {
+	return "azurerm_container_app_environment_java_component"
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return javacomponents.ValidateJavaComponentID
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.JavaComponentName,
+			Description:  "The name for this Java Component.",
+		},
+
+		"container_app_environment_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: managedenvironments.ValidateManagedEnvironmentID,
+			Description:  "The Container App Managed Environment ID to configure this Java Component on.",
+		},
+
+		"component_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice(javacomponents.PossibleValuesForJavaComponentType(), false),
+			Description:  "The type of the Java Component. Possible values are `Nacos`, `SpringBootAdmin`, `SpringCloudConfig` and `SpringCloudEureka`.",
+		},
+
+		"configuration": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+			Description: "A mapping of Spring configuration property names to values for this Java Component. e.g. `spring.cloud.config.server.git.uri`.",
+		},
+
+		"service_bind": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringIsNotEmpty,
+						Description:  "The name of the Service Bind.",
+					},
+
+					"service_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: azure.ValidateResourceID,
+						Description:  "The ID of the service to bind to, such as another Java Component in the same Container App Environment.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+
+			var javaComponent ContainerAppEnvironmentJavaComponentModel
+			if err := metadata.Decode(&javaComponent); err != nil {
+				return err
+			}
+
+			managedEnvironmentId, err := managedenvironments.ParseManagedEnvironmentID(javaComponent.ContainerAppEnvironmentId)
+			if err != nil {
+				return err
+			}
+
+			id := javacomponents.NewJavaComponentID(managedEnvironmentId.SubscriptionId, managedEnvironmentId.ResourceGroupName, managedEnvironmentId.ManagedEnvironmentName, javaComponent.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := javacomponents.JavaComponent{
+				Properties: expandJavaComponentProperties(javaComponent),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+			id, err := javacomponents.ParseJavaComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ContainerAppEnvironmentJavaComponentModel{
+				Name:                      id.JavaComponentName,
+				ContainerAppEnvironmentId: managedenvironments.NewManagedEnvironmentID(id.SubscriptionId, id.ResourceGroupName, id.ManagedEnvironmentName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if model.Properties != nil {
+					props := model.Properties.JavaComponentProperties()
+					state.ComponentType = string(props.ComponentType)
+					state.Configuration = flattenJavaComponentConfigurations(props.Configurations)
+					state.ServiceBinds = flattenJavaComponentServiceBinds(props.ServiceBinds)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+			id, err := javacomponents.ParseJavaComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.ContainerApps.JavaComponentsClient
+			id, err := javacomponents.ParseJavaComponentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ContainerAppEnvironmentJavaComponentModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// every property of the Java Component is managed by this resource, so the payload is rebuilt from the config
+			payload := javacomponents.JavaComponent{
+				Properties: expandJavaComponentProperties(state),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandJavaComponentProperties(input ContainerAppEnvironmentJavaComponentModel) javacomponents.JavaComponentProperties {
+	componentType := javacomponents.JavaComponentType(input.ComponentType)
+	configurations := expandJavaComponentConfigurations(input.Configuration)
+	serviceBinds := expandJavaComponentServiceBinds(input.ServiceBinds)
+
+	switch componentType {
+	case javacomponents.JavaComponentTypeNacos:
+		return javacomponents.NacosComponent{
+			ComponentType:  componentType,
+			Configurations: configurations,
+			ServiceBinds:   serviceBinds,
+		}
+	case javacomponents.JavaComponentTypeSpringBootAdmin:
+		return javacomponents.SpringBootAdminComponent{
+			ComponentType:  componentType,
+			Configurations: configurations,
+			ServiceBinds:   serviceBinds,
+		}
+	case javacomponents.JavaComponentTypeSpringCloudConfig:
+		return javacomponents.SpringCloudConfigComponent{
+			ComponentType:  componentType,
+			Configurations: configurations,
+			ServiceBinds:   serviceBinds,
+		}
+	case javacomponents.JavaComponentTypeSpringCloudEureka:
+		return javacomponents.SpringCloudEurekaComponent{
+			ComponentType:  componentType,
+			Configurations: configurations,
+			ServiceBinds:   serviceBinds,
+		}
+	}
+
+	return javacomponents.BaseJavaComponentPropertiesImpl{
+		ComponentType:  componentType,
+		Configurations: configurations,
+		ServiceBinds:   serviceBinds,
+	}
+}
+
+func expandJavaComponentConfigurations(input map[string]string) *[]javacomponents.JavaComponentConfigurationProperty {
+	result := make([]javacomponents.JavaComponentConfigurationProperty, 0)
+	for k, v := range input {
+		result = append(result, javacomponents.JavaComponentConfigurationProperty{
+			PropertyName: pointer.To(k),
+			Value:        pointer.To(v),
+		})
+	}
+
+	return &result
+}
+
+func flattenJavaComponentConfigurations(input *[]javacomponents.JavaComponentConfigurationProperty) map[string]string {
+	result := make(map[string]string)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		if v.PropertyName == nil {
+			continue
+		}
+		result[*v.PropertyName] = pointer.From(v.Value)
+	}
+
+	return result
+}
+
+func expandJavaComponentServiceBinds(input []ContainerAppEnvironmentJavaServiceBind) *[]javacomponents.JavaComponentServiceBind {
+	result := make([]javacomponents.JavaComponentServiceBind, 0)
+	for _, v := range input {
+		result = append(result, javacomponents.JavaComponentServiceBind{
+			Name:      pointer.To(v.Name),
+			ServiceId: pointer.To(v.ServiceId),
+		})
+	}
+
+	return &result
+}
+
+func flattenJavaComponentServiceBinds(input *[]javacomponents.JavaComponentServiceBind) []ContainerAppEnvironmentJavaServiceBind {
+	result := make([]ContainerAppEnvironmentJavaServiceBind, 0)
+	if input == nil {
+		return result
+	}
+
+	for _, v := range *input {
+		result = append(result, ContainerAppEnvironmentJavaServiceBind{
+			Name:      pointer.From(v.Name),
+			ServiceId: pointer.From(v.ServiceId),
+		})
+	}
+
+	return result
+}
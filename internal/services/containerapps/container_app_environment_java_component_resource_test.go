@@ -0,0 +1,172 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containerapps_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerapps/2024-02-02-preview/javacomponents"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ContainerAppEnvironmentJavaComponentResource struct{}
+
+func TestAccContainerAppEnvironmentJavaComponent_eureka(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_java_component", "test")
+	r := ContainerAppEnvironmentJavaComponentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "SpringCloudEureka"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppEnvironmentJavaComponent_springBootAdmin(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_java_component", "test")
+	r := ContainerAppEnvironmentJavaComponentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "SpringBootAdmin"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccContainerAppEnvironmentJavaComponent_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_java_component", "test")
+	r := ContainerAppEnvironmentJavaComponentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "SpringCloudEureka"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccContainerAppEnvironmentJavaComponent_configServerUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_app_environment_java_component", "test")
+	r := ContainerAppEnvironmentJavaComponentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data, "SpringCloudConfig"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.configServer(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("service_bind.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data, "SpringCloudConfig"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := javacomponents.ParseJavaComponentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.ContainerApps.JavaComponentsClient.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) basic(data acceptance.TestData, componentType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_container_app_environment_java_component" "test" {
+  name                         = "acctest-java-%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  component_type               = "%[3]s"
+}
+`, ContainerAppEnvironmentResource{}.basicNoProvider(data), data.RandomInteger, componentType)
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_container_app_environment_java_component" "import" {
+  name                         = azurerm_container_app_environment_java_component.test.name
+  container_app_environment_id = azurerm_container_app_environment_java_component.test.container_app_environment_id
+  component_type               = azurerm_container_app_environment_java_component.test.component_type
+}
+`, r.basic(data, "SpringCloudEureka"))
+}
+
+func (r ContainerAppEnvironmentJavaComponentResource) configServer(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_container_app_environment_java_component" "eureka" {
+  name                         = "acctest-eureka-%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  component_type               = "SpringCloudEureka"
+}
+
+resource "azurerm_container_app_environment_java_component" "test" {
+  name                         = "acctest-java-%[2]d"
+  container_app_environment_id = azurerm_container_app_environment.test.id
+  component_type               = "SpringCloudConfig"
+
+  configuration = {
+    "spring.cloud.config.server.git.uri"           = "https://github.com/Azure-Samples/azure-spring-cloud-config-java-aca.git"
+    "spring.cloud.config.server.git.default-label" = "main"
+  }
+
+  service_bind {
+    name       = "eureka"
+    service_id = azurerm_container_app_environment_java_component.eureka.id
+  }
+}
+`, ContainerAppEnvironmentResource{}.basicNoProvider(data), data.RandomInteger)
+}
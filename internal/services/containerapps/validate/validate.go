@@ -192,3 +192,17 @@ func ContainerAppScaleRuleConcurrentRequests(i interface{}, k string) (warnings
 
 	return
 }
+
+func JavaComponentName(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if matched := regexp.MustCompile(`^[a-z][a-z0-9-]{0,30}[a-z0-9]$`).Match([]byte(v)); !matched || strings.Contains(v, "--") {
+		errors = append(errors, fmt.Errorf("%q must consist of lower case alphanumeric characters or '-', start with an alphabetic character, and end with an alphanumeric character and cannot have '--'. The length must be between 2 and 32 characters", k))
+	}
+
+	return
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-07-01/credentialsets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.DataSource = ContainerRegistryCredentialSetDataSource{}
+
+type ContainerRegistryCredentialSetDataSource struct{}
+
+type ContainerRegistryCredentialSetDataSourceModel struct {
+	Name                     string                         `tfschema:"name"`
+	ContainerRegistryId      string                         `tfschema:"container_registry_id"`
+	LoginServer              string                         `tfschema:"login_server"`
+	AuthenticationCredential []AuthenticationCredential     `tfschema:"authentication_credentials"`
+	Identity                 []identity.ModelSystemAssigned `tfschema:"identity"`
+}
+
+func (ContainerRegistryCredentialSetDataSource) ResourceType() string {
+	return "azurerm_container_registry_credential_set"
+}
+
+func (ContainerRegistryCredentialSetDataSource) ModelObject() interface{} {
+	return &ContainerRegistryCredentialSetDataSourceModel{}
+}
+
+func (ContainerRegistryCredentialSetDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"container_registry_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: registries.ValidateRegistryID,
+		},
+	}
+}
+
+func (ContainerRegistryCredentialSetDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"login_server": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"authentication_credentials": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"username_secret_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+
+					"password_secret_id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+				},
+			},
+		},
+
+		"identity": commonschema.SystemAssignedIdentityComputed(),
+	}
+}
+
+func (ContainerRegistryCredentialSetDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Containers.CredentialSetsClient
+			subscriptionId := metadata.Client.Account.SubscriptionId
+
+			var state ContainerRegistryCredentialSetDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return err
+			}
+
+			registryId, err := registries.ParseRegistryID(state.ContainerRegistryId)
+			if err != nil {
+				return err
+			}
+
+			id := credentialsets.NewCredentialSetID(subscriptionId, registryId.ResourceGroupName, registryId.RegistryName, state.Name)
+
+			resp, err := client.Get(ctx, id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("%s was not found", id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			state.Name = id.CredentialSetName
+			state.ContainerRegistryId = registryId.ID()
+
+			if model := resp.Model; model != nil {
+				state.Identity = identity.FlattenSystemAssignedToModel(model.Identity)
+				if props := model.Properties; props != nil {
+					state.LoginServer = pointer.From(props.LoginServer)
+					state.AuthenticationCredential = flattenAuthCredentials(props.AuthCredentials)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
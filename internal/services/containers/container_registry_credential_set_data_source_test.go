@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package containers_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ContainerRegistryCredentialSetDataSource struct{}
+
+func TestAccDataSourceAzureRMContainerRegistryCredentialSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_container_registry_credential_set", "test")
+	r := ContainerRegistryCredentialSetDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("login_server").Exists(),
+				check.That(data.ResourceName).Key("authentication_credentials.#").HasValue("1"),
+				check.That(data.ResourceName).Key("authentication_credentials.0.username_secret_id").Exists(),
+				check.That(data.ResourceName).Key("authentication_credentials.0.password_secret_id").Exists(),
+				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
+			),
+		},
+	})
+}
+
+func (ContainerRegistryCredentialSetDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_container_registry_credential_set" "test" {
+  name                  = azurerm_container_registry_credential_set.test.name
+  container_registry_id = azurerm_container_registry_credential_set.test.container_registry_id
+}
+`, ContainerRegistryCredentialSetResource{}.basic(data))
+}
@@ -908,8 +908,8 @@ func (r ContainerRegistryTaskResource) Update() sdk.ResourceFunc {
 			}
 
 			if existing.Model.Properties.Trigger != nil {
-				if !metadata.ResourceData.HasChange("source_triggers") && existing.Model.Properties.Trigger.SourceTriggers != nil {
-					// For update that is not affecting source_triggers, we need to patch the source_triggers to include the properties missing in the response of GET.
+				if !metadata.ResourceData.HasChange("source_trigger") && existing.Model.Properties.Trigger.SourceTriggers != nil {
+					// For update that is not affecting source_trigger, we need to patch the source_triggers to include the properties missing in the response of GET.
 					existing.Model.Properties.Trigger.SourceTriggers = patchRegistryTaskTriggerSourceTrigger(*existing.Model.Properties.Trigger.SourceTriggers, model)
 				}
 			}
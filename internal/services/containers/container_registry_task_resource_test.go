@@ -11,6 +11,8 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2019-06-01-preview/tasks"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -155,6 +157,42 @@ func TestAccContainerRegistryTask_encodedTaskStep(t *testing.T) {
 	})
 }
 
+func TestAccContainerRegistryTask_encodedTaskStepMultiStep(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
+
+	preCheckGithubRepo(t)
+
+	r := ContainerRegistryTaskResource{
+		githubRepo: githubRepo{
+			url:   os.Getenv("ARM_TEST_ACR_TASK_GITHUB_REPO_URL"),
+			token: os.Getenv("ARM_TEST_ACR_TASK_GITHUB_USER_TOKEN"),
+		},
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.encodedTaskStepMultiStep(data, "0 21 * * *"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("encoded_step.0.context_access_token"),
+		{
+			// updating the timer trigger should update the task in-place
+			Config: r.encodedTaskStepMultiStep(data, "0 12 * * *"),
+			ConfigPlanChecks: resource.ConfigPlanChecks{
+				PreApply: []plancheck.PlanCheck{
+					plancheck.ExpectResourceAction(data.ResourceName, plancheck.ResourceActionUpdate),
+				},
+			},
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("encoded_step.0.context_access_token"),
+	})
+}
+
 func TestAccContainerRegistryTask_dockerStepBaseImageTrigger(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_container_registry_task", "test")
 
@@ -654,6 +692,39 @@ EOF
 `, template, data.RandomInteger, r.githubRepo.url, r.githubRepo.token)
 }
 
+func (r ContainerRegistryTaskResource) encodedTaskStepMultiStep(data acceptance.TestData, schedule string) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_container_registry_task" "test" {
+  name                  = "testacccrTask%d"
+  container_registry_id = azurerm_container_registry.test.id
+  platform {
+    os = "Linux"
+  }
+  encoded_step {
+    task_content         = <<EOF
+version: v1.1.0
+steps:
+  - build: -t $Registry/{{.Values.image}}:{{.Run.ID}} .
+  - push:
+    - $Registry/{{.Values.image}}:{{.Run.ID}}
+EOF
+    value_content        = <<EOF
+image: helloworld
+EOF
+    context_path         = "%s"
+    context_access_token = "%s"
+  }
+  timer_trigger {
+    name     = "default"
+    schedule = "%s"
+  }
+}
+`, template, data.RandomInteger, r.githubRepo.url, r.githubRepo.token, schedule)
+}
+
 func (r ContainerRegistryTaskResource) dockerStepBaseImageTrigger(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
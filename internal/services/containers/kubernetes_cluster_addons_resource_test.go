@@ -5,6 +5,7 @@ package containers_test
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -350,6 +351,18 @@ func TestAccKubernetesCluster_addonProfileServiceMeshProfile_revisions(t *testin
 	})
 }
 
+func TestAccKubernetesCluster_addonProfileServiceMeshProfile_duplicateRevisions(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_cluster", "test")
+	r := KubernetesClusterResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.addonProfileServiceMeshProfileRevisionsConfig(data, `["asm-1-22", "asm-1-22"]`),
+			ExpectError: regexp.MustCompile("must contain two different revisions"),
+		},
+	})
+}
+
 func (KubernetesClusterResource) addonProfileAciConnectorLinuxConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -112,6 +112,25 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 				// Once it is GA, an additional logic is needed to handle the uninstallation of network policy.
 				return old.(string) != ""
 			}),
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				serviceMeshProfile := d.Get("service_mesh_profile").([]interface{})
+				if len(serviceMeshProfile) == 0 || serviceMeshProfile[0] == nil {
+					return nil
+				}
+				raw := serviceMeshProfile[0].(map[string]interface{})
+
+				// during a canary upgrade both the current and the new revision are installed side-by-side
+				if revisions := raw["revisions"].([]interface{}); len(revisions) == 2 && revisions[0] == revisions[1] {
+					return fmt.Errorf("`service_mesh_profile.0.revisions` must contain two different revisions when performing a canary upgrade, got %q twice", revisions[0])
+				}
+
+				// the plugin certificates are read from the Key Vault using the Secrets Store CSI Driver
+				if certificateAuthority := raw["certificate_authority"].([]interface{}); len(certificateAuthority) > 0 && len(d.Get("key_vault_secrets_provider").([]interface{})) == 0 {
+					return fmt.Errorf("`key_vault_secrets_provider` must be configured when `service_mesh_profile.0.certificate_authority` is specified")
+				}
+
+				return nil
+			},
 		),
 
 		Timeouts: &pluginsdk.ResourceTimeout{
@@ -1367,7 +1386,7 @@ func resourceKubernetesCluster() *pluginsdk.Resource {
 							MaxItems: 2,
 							Elem: &pluginsdk.Schema{
 								Type:         pluginsdk.TypeString,
-								ValidateFunc: validation.StringIsNotEmpty,
+								ValidateFunc: containerValidate.KubernetesServiceMeshRevision,
 							},
 						},
 					},
@@ -124,6 +124,8 @@ type KubernetesFluxConfigurationResource struct{}
 
 var _ sdk.ResourceWithUpdate = KubernetesFluxConfigurationResource{}
 
+var _ sdk.ResourceWithCustomizeDiff = KubernetesFluxConfigurationResource{}
+
 func (r KubernetesFluxConfigurationResource) ResourceType() string {
 	return "azurerm_kubernetes_flux_configuration"
 }
@@ -598,6 +600,32 @@ func (r KubernetesFluxConfigurationResource) Arguments() map[string]*pluginsdk.S
 	}
 }
 
+func (r KubernetesFluxConfigurationResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			if !metadata.ResourceDiff.HasChange("kustomizations") || !metadata.ResourceDiff.NewValueKnown("kustomizations") {
+				return nil
+			}
+
+			var model KubernetesFluxConfigurationModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			kustomizations := make([]validate.FluxKustomization, 0)
+			for _, k := range model.Kustomizations {
+				kustomizations = append(kustomizations, validate.FluxKustomization{
+					Name:      k.Name,
+					DependsOn: k.DependsOn,
+				})
+			}
+
+			return validate.FluxKustomizationDependencies(kustomizations)
+		},
+	}
+}
+
 func (r KubernetesFluxConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
@@ -611,10 +639,6 @@ func (r KubernetesFluxConfigurationResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
-			if err := validateKubernetesFluxConfigurationModel(&model); err != nil {
-				return err
-			}
-
 			client := metadata.Client.Containers.KubernetesFluxConfigurationClient
 			clusterID, err := commonids.ParseKubernetesClusterID(model.ClusterID)
 			if err != nil {
@@ -692,10 +716,6 @@ func (r KubernetesFluxConfigurationResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("decoding: %+v", err)
 			}
 
-			if err := validateKubernetesFluxConfigurationModel(&model); err != nil {
-				return err
-			}
-
 			resp, err := client.Get(ctx, *id)
 			if err != nil {
 				return fmt.Errorf("retrieving %s: %+v", *id, err)
@@ -1320,19 +1340,6 @@ func flattenRepositoryRefDefinitionModel(input *fluxconfiguration.RepositoryRefD
 	return referenceType, referenceValue, nil
 }
 
-func validateKubernetesFluxConfigurationModel(model *KubernetesFluxConfigurationModel) error {
-	allKeys := make(map[string]bool)
-	for _, k := range model.Kustomizations {
-		if _, exists := allKeys[k.Name]; exists {
-			return fmt.Errorf("kustomization name `%s` is not unique", k.Name)
-		}
-
-		allKeys[k.Name] = true
-	}
-
-	return nil
-}
-
 func setConfigurationProtectedSettings(metadata sdk.ResourceMetaData, model KubernetesFluxConfigurationModel, properties *fluxconfiguration.FluxConfiguration) error {
 	if _, exists := metadata.ResourceData.GetOk("git_repository"); exists {
 		_, configurationProtectedSettings, err := expandGitRepositoryDefinitionModel(model.GitRepository)
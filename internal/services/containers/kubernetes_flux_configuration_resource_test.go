@@ -196,6 +196,17 @@ func TestAccKubernetesFluxConfiguration_kustomizationNameDuplicated(t *testing.T
 	})
 }
 
+func TestAccKubernetesFluxConfiguration_kustomizationDependsOnCircular(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_kubernetes_flux_configuration", "test")
+	r := KubernetesFluxConfigurationResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.kustomizationDependsOnCircular(data),
+			ExpectError: regexp.MustCompile("has a circular `depends_on` reference"),
+		},
+	})
+}
+
 func TestAccKubernetesFluxConfiguration_kustomizationPostBuild(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_kubernetes_flux_configuration", "test")
 	r := KubernetesFluxConfigurationResource{}
@@ -775,6 +786,40 @@ resource "azurerm_kubernetes_flux_configuration" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r KubernetesFluxConfigurationResource) kustomizationDependsOnCircular(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+				%s
+
+resource "azurerm_kubernetes_flux_configuration" "test" {
+  name       = "acctest-fc-%d"
+  cluster_id = azurerm_kubernetes_cluster.test.id
+  namespace  = "flux"
+
+  git_repository {
+    url             = "https://github.com/Azure/arc-k8s-demo"
+    reference_type  = "branch"
+    reference_value = "main"
+  }
+
+  kustomizations {
+    name       = "kustomization-1"
+    depends_on = ["kustomization-2"]
+  }
+
+  kustomizations {
+    name       = "kustomization-2"
+    path       = "./test/path"
+    depends_on = ["kustomization-1"]
+  }
+
+  depends_on = [
+    azurerm_kubernetes_cluster_extension.test
+  ]
+}
+`, template, data.RandomInteger)
+}
+
 func (r KubernetesFluxConfigurationResource) kustomizationPostBuild(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
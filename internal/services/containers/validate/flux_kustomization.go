@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "fmt"
+
+// FluxKustomization is the subset of a Flux Kustomization needed to validate the references between Kustomizations,
+// which is shared between the AKS and Arc Kubernetes Flux Configurations.
+type FluxKustomization struct {
+	Name      string
+	DependsOn []string
+}
+
+// FluxKustomizationDependencies validates that the Kustomization names are unique and that each `depends_on` only
+// references other Kustomizations within the same Flux Configuration, without forming a cycle.
+func FluxKustomizationDependencies(input []FluxKustomization) error {
+	allKeys := make(map[string]bool)
+	for _, k := range input {
+		if _, exists := allKeys[k.Name]; exists {
+			return fmt.Errorf("kustomization name `%s` is not unique", k.Name)
+		}
+
+		allKeys[k.Name] = true
+	}
+
+	dependencies := make(map[string][]string)
+	for _, k := range input {
+		for _, dependency := range k.DependsOn {
+			if dependency == k.Name {
+				return fmt.Errorf("kustomization `%s` cannot depend on itself", k.Name)
+			}
+
+			if !allKeys[dependency] {
+				return fmt.Errorf("kustomization `%s` depends on `%s` which is not defined within `kustomizations`", k.Name, dependency)
+			}
+		}
+
+		dependencies[k.Name] = k.DependsOn
+	}
+
+	// Flux only reconciles a kustomization once everything it depends on is ready, so a cycle would never become ready
+	const (
+		visiting = 1
+		visited  = 2
+	)
+	state := make(map[string]int)
+	var visit func(name string) error
+	visit = func(name string) error {
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("kustomization `%s` has a circular `depends_on` reference", name)
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+		for _, dependency := range dependencies[name] {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+
+		return nil
+	}
+
+	for _, k := range input {
+		if err := visit(k.Name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,75 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import (
+	"testing"
+)
+
+func TestFluxKustomizationDependencies(t *testing.T) {
+	cases := []struct {
+		Input []FluxKustomization
+		Valid bool
+	}{
+		{
+			Input: []FluxKustomization{},
+			Valid: true,
+		},
+		{
+			Input: []FluxKustomization{
+				{Name: "infra"},
+				{Name: "apps", DependsOn: []string{"infra"}},
+			},
+			Valid: true,
+		},
+		{
+			// duplicate names
+			Input: []FluxKustomization{
+				{Name: "apps"},
+				{Name: "apps"},
+			},
+			Valid: false,
+		},
+		{
+			// depends on itself
+			Input: []FluxKustomization{
+				{Name: "apps", DependsOn: []string{"apps"}},
+			},
+			Valid: false,
+		},
+		{
+			// depends on an undefined kustomization
+			Input: []FluxKustomization{
+				{Name: "apps", DependsOn: []string{"infra"}},
+			},
+			Valid: false,
+		},
+		{
+			// circular reference
+			Input: []FluxKustomization{
+				{Name: "infra", DependsOn: []string{"config"}},
+				{Name: "config", DependsOn: []string{"apps"}},
+				{Name: "apps", DependsOn: []string{"infra"}},
+			},
+			Valid: false,
+		},
+		{
+			// shared dependency isn't a cycle
+			Input: []FluxKustomization{
+				{Name: "infra"},
+				{Name: "config", DependsOn: []string{"infra"}},
+				{Name: "apps", DependsOn: []string{"infra", "config"}},
+			},
+			Valid: true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := FluxKustomizationDependencies(tc.Input)
+		valid := err == nil
+		if valid != tc.Valid {
+			t.Fatalf("expected %t but got %t for %+v: %+v", tc.Valid, valid, tc.Input, err)
+		}
+	}
+}
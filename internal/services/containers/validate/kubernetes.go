@@ -62,6 +62,21 @@ func KubernetesDNSPrefix(i interface{}, k string) (warnings []string, errors []e
 	return warnings, errors
 }
 
+var kubernetesServiceMeshRevisionRegex = regexp.MustCompile(`^asm-[1-9]\d*-\d+$`)
+
+func KubernetesServiceMeshRevision(i interface{}, k string) (warnings []string, errors []error) {
+	revision, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %q to be string", k)}
+	}
+
+	if !kubernetesServiceMeshRevisionRegex.MatchString(revision) {
+		errors = append(errors, fmt.Errorf("the %q must be an Istio revision in the format `asm-{major}-{minor}` (for example `asm-1-22`), got %q", k, revision))
+	}
+
+	return warnings, errors
+}
+
 func KubernetesGitRepositoryUrl() pluginsdk.SchemaValidateFunc {
 	return func(i interface{}, k string) ([]string, []error) {
 		v, ok := i.(string)
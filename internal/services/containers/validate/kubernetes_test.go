@@ -165,6 +165,56 @@ func TestKubernetesDNSPrefix(t *testing.T) {
 	}
 }
 
+func TestKubernetesServiceMeshRevision(t *testing.T) {
+	cases := []struct {
+		Revision string
+		Errors   int
+	}{
+		{
+			Revision: "",
+			Errors:   1,
+		},
+		{
+			Revision: "asm-1-22",
+			Errors:   0,
+		},
+		{
+			Revision: "asm-2-0",
+			Errors:   0,
+		},
+		{
+			Revision: "asm-1.22",
+			Errors:   1,
+		},
+		{
+			Revision: "1-22",
+			Errors:   1,
+		},
+		{
+			Revision: "ASM-1-22",
+			Errors:   1,
+		},
+		{
+			Revision: "asm-0-1",
+			Errors:   1,
+		},
+		{
+			Revision: "asm-1-22-1",
+			Errors:   1,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Revision, func(t *testing.T) {
+			_, errors := KubernetesServiceMeshRevision(tc.Revision, "test")
+
+			if len(errors) != tc.Errors {
+				t.Fatalf("Expected KubernetesServiceMeshRevision to return %d error(s) not %d", tc.Errors, len(errors))
+			}
+		})
+	}
+}
+
 func TestKubernetesGitRepositoryUrl(t *testing.T) {
 	cases := []struct {
 		Input string
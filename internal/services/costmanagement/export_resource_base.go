@@ -62,6 +62,12 @@ func (br costManagementExportBaseResource) arguments(fields map[string]*pluginsd
 			}, false),
 		},
 
+		"partition_data_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
+
 		"export_data_storage_location": {
 			Type:     pluginsdk.TypeList,
 			MaxItems: 1,
@@ -206,6 +212,7 @@ func (br costManagementExportBaseResource) readFunc(scopeFieldName string) sdk.R
 						return fmt.Errorf("setting `export_data_options`: %+v", err)
 					}
 					metadata.ResourceData.Set("file_format", string(pointer.From(props.Format)))
+					metadata.ResourceData.Set("partition_data_enabled", pointer.From(props.PartitionData))
 				}
 			}
 
@@ -292,9 +299,10 @@ func createOrUpdateCostManagementExport(ctx context.Context, client *exports.Exp
 				},
 				Status: &status,
 			},
-			DeliveryInfo: *deliveryInfo,
-			Format:       &format,
-			Definition:   *expandExportDefinition(metadata.ResourceData.Get("export_data_options").([]interface{})),
+			DeliveryInfo:  *deliveryInfo,
+			Format:        &format,
+			PartitionData: pointer.To(metadata.ResourceData.Get("partition_data_enabled").(bool)),
+			Definition:    *expandExportDefinition(metadata.ResourceData.Get("export_data_options").([]interface{})),
 		},
 	}
 
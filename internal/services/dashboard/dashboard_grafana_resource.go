@@ -7,6 +7,8 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -39,6 +41,7 @@ type DashboardGrafanaModel struct {
 	GrafanaVersion                    string                                            `tfschema:"grafana_version"`
 	GrafanaMajorVersion               string                                            `tfschema:"grafana_major_version"`
 	OutboundIPs                       []string                                          `tfschema:"outbound_ip"`
+	PluginIds                         []string                                          `tfschema:"plugin_ids"`
 }
 
 type AzureMonitorWorkspaceIntegrationModel struct {
@@ -58,7 +61,10 @@ type SMTPConfigurationModel struct {
 
 type DashboardGrafanaResource struct{}
 
-var _ sdk.ResourceWithUpdate = DashboardGrafanaResource{}
+var (
+	_ sdk.ResourceWithUpdate        = DashboardGrafanaResource{}
+	_ sdk.ResourceWithCustomizeDiff = DashboardGrafanaResource{}
+)
 
 func (r DashboardGrafanaResource) ResourceType() string {
 	return "azurerm_dashboard_grafana"
@@ -178,7 +184,7 @@ func (r DashboardGrafanaResource) Arguments() map[string]*pluginsdk.Schema {
 			},
 		},
 
-		"identity": commonschema.SystemOrUserAssignedIdentityOptionalForceNew(),
+		"identity": commonschema.SystemOrUserAssignedIdentityOptional(),
 
 		"public_network_access_enabled": {
 			Type:     pluginsdk.TypeBool,
@@ -186,10 +192,18 @@ func (r DashboardGrafanaResource) Arguments() map[string]*pluginsdk.Schema {
 			Default:  true,
 		},
 
+		"plugin_ids": {
+			Type:     pluginsdk.TypeSet,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
 		"grafana_major_version": {
 			Type:     pluginsdk.TypeString,
 			Required: true,
-			ForceNew: true,
 			ValidateFunc: validation.StringInSlice([]string{
 				"10", "11",
 			}, false),
@@ -294,6 +308,7 @@ func (r DashboardGrafanaResource) Create() sdk.ResourceFunc {
 					DeterministicOutboundIP:           &deterministicOutboundIP,
 					GrafanaIntegrations:               expandGrafanaIntegrationsModel(model.AzureMonitorWorkspaceIntegrations),
 					GrafanaMajorVersion:               &model.GrafanaMajorVersion,
+					GrafanaPlugins:                    expandGrafanaPlugins(model.PluginIds),
 					PublicNetworkAccess:               &publicNetworkAccess,
 					ZoneRedundancy:                    &zoneRedundancy,
 				},
@@ -382,6 +397,23 @@ func (r DashboardGrafanaResource) Update() sdk.ResourceFunc {
 				properties.Properties.GrafanaConfigurations = expandSMTPConfigurationModel(model.SMTP)
 			}
 
+			if metadata.ResourceData.HasChange("grafana_major_version") {
+				properties.Properties.GrafanaMajorVersion = pointer.To(model.GrafanaMajorVersion)
+			}
+
+			if metadata.ResourceData.HasChange("plugin_ids") {
+				// an empty map is sent rather than omitting the field, so that any removed plugins are uninstalled
+				plugins := expandGrafanaPlugins(model.PluginIds)
+				if plugins == nil {
+					plugins = &map[string]grafanaresource.GrafanaPlugin{}
+				}
+				properties.Properties.GrafanaPlugins = plugins
+			}
+
+			if metadata.ResourceData.HasChange("identity") {
+				properties.Identity = expandLegacySystemAndUserAssignedMap(metadata.ResourceData.Get("identity").([]interface{}))
+			}
+
 			if err := client.GrafanaCreateThenPoll(ctx, *id, *properties); err != nil {
 				return fmt.Errorf("updating %s: %+v", *id, err)
 			}
@@ -391,6 +423,27 @@ func (r DashboardGrafanaResource) Update() sdk.ResourceFunc {
 	}
 }
 
+func (r DashboardGrafanaResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			// Grafana can be upgraded to a newer major version in-place, but downgrading requires a new instance
+			if metadata.ResourceDiff.HasChange("grafana_major_version") {
+				oldRaw, newRaw := metadata.ResourceDiff.GetChange("grafana_major_version")
+				oldVersion, errOld := strconv.Atoi(oldRaw.(string))
+				newVersion, errNew := strconv.Atoi(newRaw.(string))
+				if errOld == nil && errNew == nil && newVersion < oldVersion {
+					if err := metadata.ResourceDiff.ForceNew("grafana_major_version"); err != nil {
+						return err
+					}
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r DashboardGrafanaResource) Read() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 5 * time.Minute,
@@ -473,6 +526,8 @@ func (r DashboardGrafanaResource) Read() sdk.ResourceFunc {
 					state.OutboundIPs = *properties.OutboundIPs
 				}
 
+				state.PluginIds = flattenGrafanaPlugins(properties.GrafanaPlugins)
+
 				if properties.PublicNetworkAccess != nil {
 					if *properties.PublicNetworkAccess == grafanaresource.PublicNetworkAccessEnabled {
 						state.PublicNetworkAccessEnabled = true
@@ -662,3 +717,31 @@ func flattenLegacySystemAndUserAssignedMap(input *identity.LegacySystemAndUserAs
 	}
 	return output
 }
+
+func expandGrafanaPlugins(input []string) *map[string]grafanaresource.GrafanaPlugin {
+	if len(input) == 0 {
+		return nil
+	}
+
+	// the plugins are keyed by their ID, with the `pluginId` within each plugin being read-only
+	output := make(map[string]grafanaresource.GrafanaPlugin)
+	for _, v := range input {
+		output[v] = grafanaresource.GrafanaPlugin{}
+	}
+
+	return &output
+}
+
+func flattenGrafanaPlugins(input *map[string]grafanaresource.GrafanaPlugin) []string {
+	output := make([]string, 0)
+	if input == nil {
+		return output
+	}
+
+	for k := range *input {
+		output = append(output, k)
+	}
+	sort.Strings(output)
+
+	return output
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/databricks/2022-10-01-preview/accessconnector"
@@ -139,6 +140,7 @@ func resourceDatabricksWorkspace() *pluginsdk.Resource {
 				Type:         pluginsdk.TypeString,
 				Optional:     true,
 				RequiredWith: []string{"default_storage_firewall_enabled"},
+				ValidateFunc: accessconnector.ValidateAccessConnectorID,
 			},
 
 			"network_security_group_rules_required": {
@@ -679,35 +681,12 @@ func resourceDatabricksWorkspaceCreate(d *pluginsdk.ResourceData, meta interface
 	}
 
 	if defaultStorageFirewallEnabledRaw {
-		accessConnectorProperties := workspaces.WorkspacePropertiesAccessConnector{}
-		accessConnectorIdRaw := d.Get("access_connector_id").(string)
-		accessConnectorId, err := accessconnector.ParseAccessConnectorID(accessConnectorIdRaw)
+		accessConnector, err := expandWorkspaceAccessConnector(ctx, acClient, d.Get("access_connector_id").(string))
 		if err != nil {
-			return fmt.Errorf("parsing Access Connector ID %s: %+v", accessConnectorIdRaw, err)
-		}
-
-		accessConnector, err := acClient.Get(ctx, *accessConnectorId)
-		if err != nil {
-			return fmt.Errorf("retrieving Access Connector %s: %+v", accessConnectorId.AccessConnectorName, err)
-		}
-
-		if accessConnector.Model.Identity != nil {
-			accIdentityId := ""
-			for raw := range accessConnector.Model.Identity.IdentityIds {
-				id, err := commonids.ParseUserAssignedIdentityIDInsensitively(raw)
-				if err != nil {
-					return fmt.Errorf("parsing %q as a User Assigned Identity ID: %+v", raw, err)
-				}
-				accIdentityId = id.ID()
-				break
-			}
-
-			accessConnectorProperties.Id = *accessConnector.Model.Id
-			accessConnectorProperties.IdentityType = workspaces.IdentityType(accessConnector.Model.Identity.Type)
-			accessConnectorProperties.UserAssignedIdentityId = &accIdentityId
+			return err
 		}
 
-		workspace.Properties.AccessConnector = &accessConnectorProperties
+		workspace.Properties.AccessConnector = accessConnector
 		workspace.Properties.DefaultStorageFirewall = &defaultStorageFirewallEnabled
 	}
 
@@ -985,42 +964,18 @@ func resourceDatabricksWorkspaceUpdate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
-	if d.HasChange("default_storage_firewall_enabled") {
+	if d.HasChanges("default_storage_firewall_enabled", "access_connector_id") {
 		defaultStorageFirewallEnabled := workspaces.DefaultStorageFirewallDisabled
-		defaultStorageFirewallEnabledRaw := d.Get("default_storage_firewall_enabled").(bool)
 
-		if defaultStorageFirewallEnabledRaw {
+		if d.Get("default_storage_firewall_enabled").(bool) {
 			defaultStorageFirewallEnabled = workspaces.DefaultStorageFirewallEnabled
 
-			accessConnectorProperties := workspaces.WorkspacePropertiesAccessConnector{}
-			accessConnectorIdRaw := d.Get("access_connector_id").(string)
-			accessConnectorId, err := accessconnector.ParseAccessConnectorID(accessConnectorIdRaw)
+			accessConnector, err := expandWorkspaceAccessConnector(ctx, acClient, d.Get("access_connector_id").(string))
 			if err != nil {
 				return err
 			}
 
-			accessConnector, err := acClient.Get(ctx, *accessConnectorId)
-			if err != nil {
-				return fmt.Errorf("retrieving Access Connector %s: %+v", accessConnectorId.AccessConnectorName, err)
-			}
-
-			if accessConnector.Model.Identity != nil {
-				accIdentityId := ""
-				for raw := range accessConnector.Model.Identity.IdentityIds {
-					identityId, err := commonids.ParseUserAssignedIdentityIDInsensitively(raw)
-					if err != nil {
-						return err
-					}
-					accIdentityId = identityId.ID()
-					break
-				}
-
-				accessConnectorProperties.Id = *accessConnector.Model.Id
-				accessConnectorProperties.IdentityType = workspaces.IdentityType(accessConnector.Model.Identity.Type)
-				accessConnectorProperties.UserAssignedIdentityId = &accIdentityId
-			}
-
-			props.AccessConnector = &accessConnectorProperties
+			props.AccessConnector = accessConnector
 		}
 
 		props.DefaultStorageFirewall = &defaultStorageFirewallEnabled
@@ -1565,6 +1520,44 @@ func expandWorkspaceEnhancedSecurity(input []interface{}) *workspaces.EnhancedSe
 	}
 }
 
+// expandWorkspaceAccessConnector builds the Access Connector used by the workspace to access the default storage
+// account when the storage firewall is enabled, using the Managed Identity assigned to the Access Connector
+func expandWorkspaceAccessConnector(ctx context.Context, client *accessconnector.AccessConnectorClient, input string) (*workspaces.WorkspacePropertiesAccessConnector, error) {
+	accessConnectorId, err := accessconnector.ParseAccessConnectorID(input)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, *accessConnectorId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", accessConnectorId, err)
+	}
+
+	if resp.Model == nil || resp.Model.Identity == nil || resp.Model.Identity.Type == identity.TypeNone {
+		return nil, fmt.Errorf("%s must have a Managed Identity assigned to be used with `default_storage_firewall_enabled`", accessConnectorId)
+	}
+
+	accessConnector := workspaces.WorkspacePropertiesAccessConnector{
+		Id:           accessConnectorId.ID(),
+		IdentityType: workspaces.IdentityTypeSystemAssigned,
+	}
+
+	if resp.Model.Identity.Type == identity.TypeUserAssigned {
+		for raw := range resp.Model.Identity.IdentityIds {
+			userAssignedIdentityId, err := commonids.ParseUserAssignedIdentityIDInsensitively(raw)
+			if err != nil {
+				return nil, fmt.Errorf("parsing %q as a User Assigned Identity ID: %+v", raw, err)
+			}
+
+			accessConnector.IdentityType = workspaces.IdentityTypeUserAssigned
+			accessConnector.UserAssignedIdentityId = pointer.To(userAssignedIdentityId.ID())
+			break
+		}
+	}
+
+	return &accessConnector, nil
+}
+
 func checkSubnetDelegations(ctx context.Context, client *subnets.SubnetsClient, vnetID, publicSubnetName, privateSubnetName string) error {
 	requiredDelegationService := "Microsoft.Databricks/workspaces"
 
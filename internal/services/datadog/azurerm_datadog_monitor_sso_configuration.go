@@ -55,6 +55,7 @@ func resourceDatadogSingleSignOnConfigurations() *pluginsdk.Resource {
 			"name": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
+				ForceNew: true,
 				Default:  "default",
 			},
 
@@ -118,7 +119,8 @@ func resourceDatadogSingleSignOnConfigurationsCreate(d *pluginsdk.ResourceData,
 			return fmt.Errorf("checking for existing %s: %+v", id, err)
 		}
 	}
-	if !response.WasNotFound(existing.HttpResponse) {
+	// a Single Sign-On Configuration which hasn't been configured yet is adopted, rather than requiring an import
+	if !response.WasNotFound(existing.HttpResponse) && !isDefaultSingleSignOnConfiguration(existing.Model) {
 		return tf.ImportAsExistsError("azurerm_datadog_monitor_sso_configuration", id.ID())
 	}
 
@@ -159,6 +161,7 @@ func resourceDatadogSingleSignOnConfigurationsRead(d *pluginsdk.ResourceData, me
 			d.SetId("")
 			return nil
 		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
 	d.Set("name", id.SingleSignOnConfigurationName)
@@ -196,10 +199,13 @@ func resourceDatadogSingleSignOnConfigurationsUpdate(d *pluginsdk.ResourceData,
 		},
 	}
 
-	if v, ok := d.GetOk("single_sign_on"); ok && d.HasChange("single_sign_on") {
-		payload.Properties.SingleSignOnState = pointer.To(singlesignon.SingleSignOnStates(v.(string)))
-	} else if !features.FivePointOh() {
-		payload.Properties.SingleSignOnState = pointer.To(singlesignon.SingleSignOnStates(d.Get("single_sign_on_enabled").(string)))
+	// the state is always sent, since omitting it resets the Single Sign-On Configuration
+	singleSignOnState := d.Get("single_sign_on").(string)
+	if !features.FivePointOh() && d.HasChange("single_sign_on_enabled") && !d.HasChange("single_sign_on") {
+		singleSignOnState = d.Get("single_sign_on_enabled").(string)
+	}
+	if singleSignOnState != "" {
+		payload.Properties.SingleSignOnState = pointer.To(singlesignon.SingleSignOnStates(singleSignOnState))
 	}
 
 	if err := client.ConfigurationsCreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
@@ -233,3 +239,12 @@ func resourceDatadogSingleSignOnConfigurationsDelete(d *pluginsdk.ResourceData,
 
 	return nil
 }
+
+func isDefaultSingleSignOnConfiguration(input *singlesignon.DatadogSingleSignOnResource) bool {
+	if input == nil || input.Properties == nil {
+		return false
+	}
+
+	state := pointer.From(input.Properties.SingleSignOnState)
+	return pointer.From(input.Properties.EnterpriseAppId) == "" && (state == "" || state == singlesignon.SingleSignOnStatesInitial || state == singlesignon.SingleSignOnStatesDisable)
+}
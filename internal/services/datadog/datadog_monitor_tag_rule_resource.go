@@ -53,6 +53,7 @@ func resourceDatadogTagRules() *pluginsdk.Resource {
 			"name": {
 				Type:     pluginsdk.TypeString,
 				Optional: true,
+				ForceNew: true,
 				Default:  "default",
 			},
 
@@ -186,6 +187,7 @@ func resourceDatadogTagRulesRead(d *pluginsdk.ResourceData, meta interface{}) er
 			d.SetId("")
 			return nil
 		}
+		return fmt.Errorf("retrieving %s: %+v", *id, err)
 	}
 
 	monitorId := monitorsresource.NewMonitorID(id.SubscriptionId, id.ResourceGroupName, id.MonitorName)
@@ -222,6 +224,22 @@ func resourceDatadogTagRulesUpdate(d *pluginsdk.ResourceData, meta interface{})
 			MetricRules: expandMetricRules(d.Get("metric").([]interface{})),
 		},
 	}
+
+	// omitting the rules leaves them unchanged, so removed rules are explicitly reset
+	if payload.Properties.LogRules == nil {
+		payload.Properties.LogRules = &rules.LogRules{
+			SendAadLogs:          utils.Bool(false),
+			SendSubscriptionLogs: utils.Bool(false),
+			SendResourceLogs:     utils.Bool(false),
+			FilteringTags:        &[]rules.FilteringTag{},
+		}
+	}
+	if payload.Properties.MetricRules == nil {
+		payload.Properties.MetricRules = &rules.MetricRules{
+			FilteringTags: &[]rules.FilteringTag{},
+		}
+	}
+
 	if _, err := client.TagRulesCreateOrUpdate(ctx, *id, payload); err != nil {
 		return fmt.Errorf("updating %s: %+v", id, err)
 	}
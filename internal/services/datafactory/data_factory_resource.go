@@ -29,8 +29,13 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
+	"github.com/jackofallops/kermit/sdk/datafactory/2018-06-01/datafactory" // nolint: staticcheck
 )
 
+// dataFactoryManagedVirtualNetworkIntegrationRuntimeName is the name used by Data Factory Studio for the Azure
+// Integration Runtime which is provisioned inside the Managed Virtual Network
+const dataFactoryManagedVirtualNetworkIntegrationRuntimeName = "AutoResolveIntegrationRuntime"
+
 func resourceDataFactory() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceDataFactoryCreateUpdate,
@@ -187,6 +192,11 @@ func resourceDataFactory() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"managed_virtual_network_integration_runtime_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
 			"public_network_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
@@ -219,6 +229,12 @@ func resourceDataFactory() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("managed_virtual_network_enabled", func(ctx context.Context, old, new, meta interface{}) bool {
 				return old.(bool) && !new.(bool)
 			}),
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+				if diff.Get("managed_virtual_network_integration_runtime_enabled").(bool) && !diff.Get("managed_virtual_network_enabled").(bool) {
+					return fmt.Errorf("`managed_virtual_network_enabled` must be set to `true` when `managed_virtual_network_integration_runtime_enabled` is set to `true`")
+				}
+				return nil
+			},
 		),
 	}
 }
@@ -226,6 +242,7 @@ func resourceDataFactory() *pluginsdk.Resource {
 func resourceDataFactoryCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).DataFactory.Factories
 	managedVirtualNetworksClient := meta.(*clients.Client).DataFactory.ManagedVirtualNetworks
+	integrationRuntimesClient := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreateUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -331,12 +348,29 @@ func resourceDataFactoryCreateUpdate(d *pluginsdk.ResourceData, meta interface{}
 		}
 	}
 
+	// the Integration Runtime can only reference the Managed Virtual Network once it exists, so this is done here
+	// rather than requiring a separate `azurerm_data_factory_integration_runtime_azure` to be chained after it
+	if d.HasChange("managed_virtual_network_integration_runtime_enabled") {
+		if d.Get("managed_virtual_network_integration_runtime_enabled").(bool) {
+			integrationRuntime := expandDataFactoryManagedVirtualNetworkIntegrationRuntime("default")
+			if _, err := integrationRuntimesClient.CreateOrUpdate(ctx, id.ResourceGroupName, id.FactoryName, dataFactoryManagedVirtualNetworkIntegrationRuntimeName, integrationRuntime, ""); err != nil {
+				return fmt.Errorf("creating Managed Virtual Network Integration Runtime for %s: %+v", id, err)
+			}
+		} else {
+			resp, err := integrationRuntimesClient.Delete(ctx, id.ResourceGroupName, id.FactoryName, dataFactoryManagedVirtualNetworkIntegrationRuntimeName)
+			if err != nil && !utils.ResponseWasNotFound(resp) {
+				return fmt.Errorf("deleting Managed Virtual Network Integration Runtime for %s: %+v", id, err)
+			}
+		}
+	}
+
 	return resourceDataFactoryRead(d, meta)
 }
 
 func resourceDataFactoryRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).DataFactory.Factories
 	managedVirtualNetworksClient := meta.(*clients.Client).DataFactory.ManagedVirtualNetworks
+	integrationRuntimesClient := meta.(*clients.Client).DataFactory.IntegrationRuntimesClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -439,6 +473,23 @@ func resourceDataFactoryRead(d *pluginsdk.ResourceData, meta interface{}) error
 	}
 	d.Set("managed_virtual_network_enabled", managedVirtualNetworkName != nil)
 
+	integrationRuntimeEnabled := false
+	if managedVirtualNetworkName != nil {
+		integrationRuntime, err := integrationRuntimesClient.Get(ctx, id.ResourceGroupName, id.FactoryName, dataFactoryManagedVirtualNetworkIntegrationRuntimeName, "")
+		if err != nil {
+			if !utils.ResponseWasNotFound(integrationRuntime.Response) {
+				return fmt.Errorf("retrieving Managed Virtual Network Integration Runtime for %s: %+v", *id, err)
+			}
+		}
+
+		if integrationRuntime.Properties != nil {
+			if managed, ok := integrationRuntime.Properties.AsManagedIntegrationRuntime(); ok && managed.ManagedVirtualNetwork != nil {
+				integrationRuntimeEnabled = pointer.From(managed.ManagedVirtualNetwork.ReferenceName) != ""
+			}
+		}
+	}
+	d.Set("managed_virtual_network_integration_runtime_enabled", integrationRuntimeEnabled)
+
 	return nil
 }
 
@@ -511,6 +562,28 @@ func flattenDataFactoryGlobalParameters(input *map[string]factories.GlobalParame
 	return &output, nil
 }
 
+func expandDataFactoryManagedVirtualNetworkIntegrationRuntime(managedVirtualNetworkName string) datafactory.IntegrationRuntimeResource {
+	managedIntegrationRuntime := datafactory.ManagedIntegrationRuntime{
+		Type: datafactory.TypeBasicIntegrationRuntimeTypeManaged,
+		ManagedIntegrationRuntimeTypeProperties: &datafactory.ManagedIntegrationRuntimeTypeProperties{
+			ComputeProperties: &datafactory.IntegrationRuntimeComputeProperties{
+				Location: pointer.To("AutoResolve"),
+			},
+		},
+		ManagedVirtualNetwork: &datafactory.ManagedVirtualNetworkReference{
+			Type:          pointer.To("ManagedVirtualNetworkReference"),
+			ReferenceName: pointer.To(managedVirtualNetworkName),
+		},
+	}
+
+	basicIntegrationRuntime, _ := managedIntegrationRuntime.AsBasicIntegrationRuntime()
+
+	return datafactory.IntegrationRuntimeResource{
+		Name:       pointer.To(dataFactoryManagedVirtualNetworkIntegrationRuntimeName),
+		Properties: basicIntegrationRuntime,
+	}
+}
+
 func getManagedVirtualNetworkName(ctx context.Context, client *managedvirtualnetworks.ManagedVirtualNetworksClient, subscriptionId, resourceGroup, factoryName string) (*string, error) {
 	factoryId := managedvirtualnetworks.NewFactoryID(subscriptionId, resourceGroup, factoryName)
 	resp, err := client.ListByFactory(ctx, factoryId)
@@ -43,8 +43,14 @@ type RetentionRule struct {
 }
 
 type LifeCycle struct {
-	DataStoreType string `tfschema:"data_store_type"`
-	Duration      string `tfschema:"duration"`
+	DataStoreType     string              `tfschema:"data_store_type"`
+	Duration          string              `tfschema:"duration"`
+	TargetCopySetting []TargetCopySetting `tfschema:"target_copy_setting"`
+}
+
+type TargetCopySetting struct {
+	CopyOption string `tfschema:"copy_option"`
+	Duration   string `tfschema:"duration"`
 }
 
 type Criteria struct {
@@ -57,7 +63,7 @@ type Criteria struct {
 
 type DataProtectionBackupPolicyKubernatesClusterResource struct{}
 
-var _ sdk.Resource = DataProtectionBackupPolicyKubernatesClusterResource{}
+var _ sdk.ResourceWithCustomizeDiff = DataProtectionBackupPolicyKubernatesClusterResource{}
 
 func (r DataProtectionBackupPolicyKubernatesClusterResource) ResourceType() string {
 	return "azurerm_data_protection_backup_policy_kubernetes_cluster"
@@ -119,9 +125,8 @@ func (r DataProtectionBackupPolicyKubernatesClusterResource) Arguments() map[str
 									Required: true,
 									ForceNew: true,
 									ValidateFunc: validation.StringInSlice([]string{
-										// confirmed with the service team that current possible value only support `OperationalStore`.
-										// However, considering that `VaultStore` might be supported in the future, it would be exposed for user specification.
 										string(backuppolicies.DataStoreTypesOperationalStore),
+										string(backuppolicies.DataStoreTypesVaultStore),
 									}, false),
 								},
 
@@ -131,6 +136,8 @@ func (r DataProtectionBackupPolicyKubernatesClusterResource) Arguments() map[str
 									ForceNew:     true,
 									ValidateFunc: validate.ISO8601Duration,
 								},
+
+								"target_copy_setting": backupPolicyKubernetesClusterTargetCopySettingSchema(),
 							},
 						},
 					},
@@ -223,9 +230,8 @@ func (r DataProtectionBackupPolicyKubernatesClusterResource) Arguments() map[str
 									Required: true,
 									ForceNew: true,
 									ValidateFunc: validation.StringInSlice([]string{
-										// confirmed with the service team that currently only `OperationalStore` is supported.
-										// However, since `VaultStore` is in public preview and will be supported in the future, it is open to user specification.
 										string(backuppolicies.DataStoreTypesOperationalStore),
+										string(backuppolicies.DataStoreTypesVaultStore),
 									}, false),
 								},
 
@@ -235,6 +241,8 @@ func (r DataProtectionBackupPolicyKubernatesClusterResource) Arguments() map[str
 									ForceNew:     true,
 									ValidateFunc: validate.ISO8601Duration,
 								},
+
+								"target_copy_setting": backupPolicyKubernetesClusterTargetCopySettingSchema(),
 							},
 						},
 					},
@@ -258,6 +266,36 @@ func (r DataProtectionBackupPolicyKubernatesClusterResource) Arguments() map[str
 	return arguments
 }
 
+func backupPolicyKubernetesClusterTargetCopySettingSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Optional: true,
+		ForceNew: true,
+		MaxItems: 1,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"copy_option": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ForceNew: true,
+					ValidateFunc: validation.StringInSlice([]string{
+						"CopyOnExpiryOption",
+						"CustomCopyOption",
+						"ImmediateCopyOption",
+					}, false),
+				},
+
+				"duration": {
+					Type:         pluginsdk.TypeString,
+					Optional:     true,
+					ForceNew:     true,
+					ValidateFunc: validate.ISO8601Duration,
+				},
+			},
+		},
+	}
+}
+
 func (r DataProtectionBackupPolicyKubernatesClusterResource) Attributes() map[string]*pluginsdk.Schema {
 	return map[string]*pluginsdk.Schema{}
 }
@@ -435,7 +473,7 @@ func expandBackupPolicyKubernetesClusterLifeCycle(input []LifeCycle) []backuppol
 				DataStoreType: backuppolicies.DataStoreTypes(item.DataStoreType),
 				ObjectType:    "DataStoreInfoBase",
 			},
-			TargetDataStoreCopySettings: &[]backuppolicies.TargetCopySetting{},
+			TargetDataStoreCopySettings: expandBackupPolicyKubernetesClusterTargetCopySettings(item.TargetCopySetting),
 		}
 		results = append(results, sourceLifeCycle)
 	}
@@ -443,6 +481,74 @@ func expandBackupPolicyKubernetesClusterLifeCycle(input []LifeCycle) []backuppol
 	return results
 }
 
+// recovery points can only be copied from the `OperationalStore` into the `VaultStore`
+func expandBackupPolicyKubernetesClusterTargetCopySettings(input []TargetCopySetting) *[]backuppolicies.TargetCopySetting {
+	results := make([]backuppolicies.TargetCopySetting, 0)
+	for _, item := range input {
+		var copyOption backuppolicies.CopyOption
+		switch item.CopyOption {
+		case "CopyOnExpiryOption":
+			copyOption = backuppolicies.CopyOnExpiryOption{}
+		case "CustomCopyOption":
+			copyOption = backuppolicies.CustomCopyOption{
+				Duration: pointer.To(item.Duration),
+			}
+		case "ImmediateCopyOption":
+			copyOption = backuppolicies.ImmediateCopyOption{}
+		}
+
+		results = append(results, backuppolicies.TargetCopySetting{
+			CopyAfter: copyOption,
+			DataStore: backuppolicies.DataStoreInfoBase{
+				DataStoreType: backuppolicies.DataStoreTypesVaultStore,
+				ObjectType:    "DataStoreInfoBase",
+			},
+		})
+	}
+
+	return &results
+}
+
+func (r DataProtectionBackupPolicyKubernatesClusterResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model BackupPolicyKubernatesClusterModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return validateBackupPolicyKubernetesClusterLifeCycles(model)
+		},
+	}
+}
+
+func validateBackupPolicyKubernetesClusterLifeCycles(model BackupPolicyKubernatesClusterModel) error {
+	lifeCycles := make([]LifeCycle, 0)
+	for _, rule := range model.DefaultRetentionRule {
+		lifeCycles = append(lifeCycles, rule.LifeCycle...)
+	}
+	for _, rule := range model.RetentionRule {
+		lifeCycles = append(lifeCycles, rule.LifeCycle...)
+	}
+
+	for _, lifeCycle := range lifeCycles {
+		for _, setting := range lifeCycle.TargetCopySetting {
+			if lifeCycle.DataStoreType != string(backuppolicies.DataStoreTypesOperationalStore) {
+				return fmt.Errorf("`target_copy_setting` can only be specified for a `life_cycle` with a `data_store_type` of `OperationalStore`")
+			}
+			if setting.CopyOption == "CustomCopyOption" && setting.Duration == "" {
+				return fmt.Errorf("`duration` must be specified within `target_copy_setting` when `copy_option` is `CustomCopyOption`")
+			}
+			if setting.CopyOption != "CustomCopyOption" && setting.Duration != "" {
+				return fmt.Errorf("`duration` can only be specified within `target_copy_setting` when `copy_option` is `CustomCopyOption`")
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandBackupPolicyKubernetesClusterTaggingCriteriaArray(input []RetentionRule) (*[]backuppolicies.TaggingCriteria, error) {
 	results := []backuppolicies.TaggingCriteria{
 		{
@@ -691,9 +797,34 @@ func flattenBackupPolicyKubernetesClusterBackupLifeCycleArray(input []backuppoli
 		dataStoreType = string(item.SourceDataStore.DataStoreType)
 
 		results = append(results, LifeCycle{
-			Duration:      duration,
-			DataStoreType: dataStoreType,
+			Duration:          duration,
+			DataStoreType:     dataStoreType,
+			TargetCopySetting: flattenBackupPolicyKubernetesClusterTargetCopySettings(item.TargetDataStoreCopySettings),
 		})
 	}
 	return results
 }
+
+func flattenBackupPolicyKubernetesClusterTargetCopySettings(input *[]backuppolicies.TargetCopySetting) []TargetCopySetting {
+	results := make([]TargetCopySetting, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		setting := TargetCopySetting{}
+		switch v := item.CopyAfter.(type) {
+		case backuppolicies.CopyOnExpiryOption:
+			setting.CopyOption = "CopyOnExpiryOption"
+		case backuppolicies.CustomCopyOption:
+			setting.CopyOption = "CustomCopyOption"
+			setting.Duration = pointer.From(v.Duration)
+		case backuppolicies.ImmediateCopyOption:
+			setting.CopyOption = "ImmediateCopyOption"
+		default:
+			continue
+		}
+		results = append(results, setting)
+	}
+	return results
+}
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	resourceParse "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
 type BackupInstanceKubernatesClusterModel struct {
@@ -32,13 +33,19 @@ type BackupInstanceKubernatesClusterModel struct {
 }
 
 type BackupDatasourceParameters struct {
-	IncludedNamespaces          []string `tfschema:"included_namespaces"`
-	IncludedResourceTypes       []string `tfschema:"included_resource_types"`
-	ExcludedNamespaces          []string `tfschema:"excluded_namespaces"`
-	ExcludedResourceTypes       []string `tfschema:"excluded_resource_types"`
-	LabelSelectors              []string `tfschema:"label_selectors"`
-	VolumeSnapshotEnabled       bool     `tfschema:"volume_snapshot_enabled"`
-	ClusterScopeResourceEnabled bool     `tfschema:"cluster_scoped_resources_enabled"`
+	BackupHookReferences        []BackupHookReference `tfschema:"backup_hook_reference"`
+	IncludedNamespaces          []string              `tfschema:"included_namespaces"`
+	IncludedResourceTypes       []string              `tfschema:"included_resource_types"`
+	ExcludedNamespaces          []string              `tfschema:"excluded_namespaces"`
+	ExcludedResourceTypes       []string              `tfschema:"excluded_resource_types"`
+	LabelSelectors              []string              `tfschema:"label_selectors"`
+	VolumeSnapshotEnabled       bool                  `tfschema:"volume_snapshot_enabled"`
+	ClusterScopeResourceEnabled bool                  `tfschema:"cluster_scoped_resources_enabled"`
+}
+
+type BackupHookReference struct {
+	Name      string `tfschema:"name"`
+	Namespace string `tfschema:"namespace"`
 }
 
 type DataProtectionBackupInstanceKubernatesClusterResource struct{}
@@ -97,6 +104,27 @@ func (r DataProtectionBackupInstanceKubernatesClusterResource) Arguments() map[s
 			MaxItems: 1,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*pluginsdk.Schema{
+					"backup_hook_reference": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						ForceNew: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+								"namespace": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ForceNew:     true,
+									ValidateFunc: validation.StringIsNotEmpty,
+								},
+							},
+						},
+					},
 					"excluded_namespaces": {
 						Type:     pluginsdk.TypeList,
 						Optional: true,
@@ -331,6 +359,7 @@ func expandBackupDatasourceParameters(input []BackupDatasourceParameters) *[]bac
 	}
 	results := make([]backupinstances.BackupDatasourceParameters, 0)
 	results = append(results, backupinstances.KubernetesClusterBackupDatasourceParameters{
+		BackupHookReferences:         expandBackupHookReferences(input[0].BackupHookReferences),
 		ExcludedNamespaces:           pointer.To(input[0].ExcludedNamespaces),
 		ExcludedResourceTypes:        pointer.To(input[0].ExcludedResourceTypes),
 		IncludeClusterScopeResources: input[0].ClusterScopeResourceEnabled,
@@ -350,6 +379,7 @@ func flattenBackupDatasourceParameters(input []backupinstances.BackupDatasourceP
 
 	if item, ok := input[0].(backupinstances.KubernetesClusterBackupDatasourceParameters); ok {
 		results = append(results, BackupDatasourceParameters{
+			BackupHookReferences:        flattenBackupHookReferences(item.BackupHookReferences),
 			ExcludedNamespaces:          pointer.From(item.ExcludedNamespaces),
 			ExcludedResourceTypes:       pointer.From(item.ExcludedResourceTypes),
 			ClusterScopeResourceEnabled: item.IncludeClusterScopeResources,
@@ -361,3 +391,33 @@ func flattenBackupDatasourceParameters(input []backupinstances.BackupDatasourceP
 	}
 	return &results
 }
+
+func expandBackupHookReferences(input []BackupHookReference) *[]backupinstances.NamespacedNameResource {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]backupinstances.NamespacedNameResource, 0)
+	for _, item := range input {
+		results = append(results, backupinstances.NamespacedNameResource{
+			Name:      pointer.To(item.Name),
+			Namespace: pointer.To(item.Namespace),
+		})
+	}
+	return &results
+}
+
+func flattenBackupHookReferences(input *[]backupinstances.NamespacedNameResource) []BackupHookReference {
+	results := make([]BackupHookReference, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		results = append(results, BackupHookReference{
+			Name:      pointer.From(item.Name),
+			Namespace: pointer.From(item.Namespace),
+		})
+	}
+	return results
+}
@@ -11,19 +11,21 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/desktop"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/hostpool"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/scalingplan"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/scalingplanpersonalschedule"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/sessionhost"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/workspace"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
 type Client struct {
-	ApplicationGroupsClient *applicationgroup.ApplicationGroupClient
-	ApplicationsClient      *application.ApplicationClient
-	DesktopsClient          *desktop.DesktopClient
-	HostPoolsClient         *hostpool.HostPoolClient
-	SessionHostsClient      *sessionhost.SessionHostClient
-	ScalingPlansClient      *scalingplan.ScalingPlanClient
-	WorkspacesClient        *workspace.WorkspaceClient
+	ApplicationGroupsClient            *applicationgroup.ApplicationGroupClient
+	ApplicationsClient                 *application.ApplicationClient
+	DesktopsClient                     *desktop.DesktopClient
+	HostPoolsClient                    *hostpool.HostPoolClient
+	SessionHostsClient                 *sessionhost.SessionHostClient
+	ScalingPlansClient                 *scalingplan.ScalingPlanClient
+	ScalingPlanPersonalSchedulesClient *scalingplanpersonalschedule.ScalingPlanPersonalScheduleClient
+	WorkspacesClient                   *workspace.WorkspaceClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -63,6 +65,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(scalingPlansClient.Client, o.Authorizers.ResourceManager)
 
+	scalingPlanPersonalSchedulesClient, err := scalingplanpersonalschedule.NewScalingPlanPersonalScheduleClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ScalingPlanPersonalSchedule Client: %+v", err)
+	}
+	o.Configure(scalingPlanPersonalSchedulesClient.Client, o.Authorizers.ResourceManager)
+
 	workspacesClient, err := workspace.NewWorkspaceClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Workspaces Client: %+v", err)
@@ -70,12 +78,13 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	o.Configure(workspacesClient.Client, o.Authorizers.ResourceManager)
 
 	return &Client{
-		ApplicationGroupsClient: applicationGroupsClient,
-		ApplicationsClient:      applicationsClient,
-		DesktopsClient:          desktopsClient,
-		HostPoolsClient:         hostPoolsClient,
-		SessionHostsClient:      sessionHostsClient,
-		ScalingPlansClient:      scalingPlansClient,
-		WorkspacesClient:        workspacesClient,
+		ApplicationGroupsClient:            applicationGroupsClient,
+		ApplicationsClient:                 applicationsClient,
+		DesktopsClient:                     desktopsClient,
+		HostPoolsClient:                    hostPoolsClient,
+		SessionHostsClient:                 sessionHostsClient,
+		ScalingPlansClient:                 scalingPlansClient,
+		ScalingPlanPersonalSchedulesClient: scalingPlanPersonalSchedulesClient,
+		WorkspacesClient:                   workspacesClient,
 	}, nil
 }
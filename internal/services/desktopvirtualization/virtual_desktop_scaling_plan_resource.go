@@ -4,19 +4,23 @@
 package desktopvirtualization
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"regexp"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/hostpool"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/scalingplan"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/desktopvirtualization/2024-04-03/scalingplanpersonalschedule"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -46,6 +50,8 @@ func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(resourceVirtualDesktopScalingPlanCustomizeDiff),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -80,10 +86,32 @@ func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"host_pool_type": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ForceNew: true,
+				Default:  string(scalingplan.ScalingHostPoolTypePooled),
+				ValidateFunc: validation.StringInSlice([]string{
+					scalingPlanHostPoolTypePersonal,
+					string(scalingplan.ScalingHostPoolTypePooled),
+				}, false),
+			},
+
+			"personal_schedule": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MinItems:     1,
+				ExactlyOneOf: []string{"personal_schedule", "schedule"},
+				Elem: &pluginsdk.Resource{
+					Schema: scalingPlanPersonalScheduleSchema(),
+				},
+			},
+
 			"schedule": {
-				Type:     pluginsdk.TypeList,
-				Required: true,
-				MinItems: 1,
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MinItems:     1,
+				ExactlyOneOf: []string{"personal_schedule", "schedule"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"name": {
@@ -244,6 +272,144 @@ func resourceVirtualDesktopScalingPlan() *pluginsdk.Resource {
 	}
 }
 
+// scalingPlanHostPoolTypePersonal isn't defined within the SDK, however is supported by the API
+const scalingPlanHostPoolTypePersonal = "Personal"
+
+func scalingPlanPersonalScheduleSchema() map[string]*pluginsdk.Schema {
+	s := map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"days_of_week": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringInSlice(scalingplanpersonalschedule.PossibleValuesForDayOfWeek(), false),
+			},
+		},
+
+		"ramp_up_auto_start_hosts": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(scalingplanpersonalschedule.StartupBehaviorNone),
+			ValidateFunc: validation.StringInSlice(scalingplanpersonalschedule.PossibleValuesForStartupBehavior(), false),
+		},
+	}
+
+	// each phase of a personal schedule supports the same set of session handling options
+	for _, phase := range []string{"ramp_up", "peak", "ramp_down", "off_peak"} {
+		s[phase+"_start_time"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validateTime(),
+		}
+
+		s[phase+"_start_vm_on_connect_enabled"] = &pluginsdk.Schema{
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		}
+
+		s[phase+"_action_on_disconnect"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(scalingplanpersonalschedule.SessionHandlingOperationNone),
+			ValidateFunc: validation.StringInSlice(scalingplanpersonalschedule.PossibleValuesForSessionHandlingOperation(), false),
+		}
+
+		s[phase+"_minutes_to_wait_on_disconnect"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		}
+
+		s[phase+"_action_on_logoff"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(scalingplanpersonalschedule.SessionHandlingOperationNone),
+			ValidateFunc: validation.StringInSlice(scalingplanpersonalschedule.PossibleValuesForSessionHandlingOperation(), false),
+		}
+
+		s[phase+"_minutes_to_wait_on_logoff"] = &pluginsdk.Schema{
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Default:      0,
+			ValidateFunc: validation.IntAtLeast(0),
+		}
+	}
+
+	return s
+}
+
+func resourceVirtualDesktopScalingPlanCustomizeDiff(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+	hostPoolType := diff.Get("host_pool_type").(string)
+	schedules := diff.Get("schedule").([]interface{})
+	personalSchedules := diff.Get("personal_schedule").([]interface{})
+
+	if hostPoolType == scalingPlanHostPoolTypePersonal && len(schedules) > 0 {
+		return fmt.Errorf("`schedule` cannot be specified when `host_pool_type` is `%s`, use `personal_schedule` instead", scalingPlanHostPoolTypePersonal)
+	}
+	if hostPoolType == string(scalingplan.ScalingHostPoolTypePooled) && len(personalSchedules) > 0 {
+		return fmt.Errorf("`personal_schedule` can only be specified when `host_pool_type` is `%s`", scalingPlanHostPoolTypePersonal)
+	}
+
+	if err := validateScalingPlanSchedules("schedule", schedules); err != nil {
+		return err
+	}
+
+	return validateScalingPlanSchedules("personal_schedule", personalSchedules)
+}
+
+// validateScalingPlanSchedules ensures that the phases within each schedule are in order, and that each day of the
+// week is covered by at most one schedule, since the API rejects overlapping schedules
+func validateScalingPlanSchedules(key string, input []interface{}) error {
+	days := make(map[string]string)
+	for i, item := range input {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+		name := v["name"].(string)
+
+		if daysOfWeek, ok := v["days_of_week"].(*pluginsdk.Set); ok && daysOfWeek != nil {
+			for _, raw := range daysOfWeek.List() {
+				day := raw.(string)
+				if day == "" {
+					continue
+				}
+				if existing, ok := days[day]; ok {
+					return fmt.Errorf("`%s.%d`: the schedule %q overlaps with the schedule %q on %s, each day of the week can only be included in one schedule", key, i, name, existing, day)
+				}
+				days[day] = name
+			}
+		}
+
+		previousPhase := ""
+		previousMinutes := -1
+		for _, phase := range []string{"ramp_up_start_time", "peak_start_time", "ramp_down_start_time", "off_peak_start_time"} {
+			startTime := v[phase].(string)
+			if startTime == "" {
+				// the value may not be known until apply
+				continue
+			}
+			t := expandScalingPlanScheduleTime(startTime)
+			minutes := int(t.Hour*60 + t.Minute)
+			if minutes <= previousMinutes {
+				return fmt.Errorf("`%s.%d`: `%s` (%s) must be later than `%s` (%s)", key, i, phase, startTime, previousPhase, v[previousPhase].(string))
+			}
+			previousPhase = phase
+			previousMinutes = minutes
+		}
+	}
+
+	return nil
+}
+
 func validateTime() pluginsdk.SchemaValidateFunc {
 	return validation.StringMatch(regexp.MustCompile(`^([0-1]?[0-9]|2[0-3]):[0-5][0-9]$`), `The time must be in the format HH:MM.`)
 }
@@ -273,7 +439,7 @@ func resourceVirtualDesktopScalingPlanCreate(d *pluginsdk.ResourceData, meta int
 	location := location.Normalize(d.Get("location").(string))
 	t := d.Get("tags").(map[string]interface{})
 
-	hostPoolType := scalingplan.ScalingHostPoolTypePooled // Only one possible value for this
+	hostPoolType := scalingplan.ScalingHostPoolType(d.Get("host_pool_type").(string))
 	payload := scalingplan.ScalingPlan{
 		Name:     utils.String(d.Get("name").(string)),
 		Location: location,
@@ -295,6 +461,12 @@ func resourceVirtualDesktopScalingPlanCreate(d *pluginsdk.ResourceData, meta int
 
 	d.SetId(id.ID())
 
+	if hostPoolType == scalingPlanHostPoolTypePersonal {
+		if err := createOrUpdateScalingPlanPersonalSchedules(ctx, meta.(*clients.Client).DesktopVirtualization.ScalingPlanPersonalSchedulesClient, id, []interface{}{}, d.Get("personal_schedule").([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	return resourceVirtualDesktopScalingPlanRead(d, meta)
 }
 
@@ -324,10 +496,22 @@ func resourceVirtualDesktopScalingPlanUpdate(d *pluginsdk.ResourceData, meta int
 		},
 	}
 
+	if d.Get("host_pool_type").(string) == scalingPlanHostPoolTypePersonal {
+		// the schedules of a Personal Scaling Plan are managed as separate resources
+		payload.Properties.Schedules = nil
+	}
+
 	if _, err := client.Update(ctx, *id, payload); err != nil {
 		return fmt.Errorf("updating %s: %+v", *id, err)
 	}
 
+	if d.HasChange("personal_schedule") {
+		old, new := d.GetChange("personal_schedule")
+		if err := createOrUpdateScalingPlanPersonalSchedules(ctx, meta.(*clients.Client).DesktopVirtualization.ScalingPlanPersonalSchedulesClient, *id, old.([]interface{}), new.([]interface{})); err != nil {
+			return err
+		}
+	}
+
 	return resourceVirtualDesktopScalingPlanRead(d, meta)
 }
 
@@ -363,6 +547,25 @@ func resourceVirtualDesktopScalingPlanRead(d *pluginsdk.ResourceData, meta inter
 		d.Set("schedule", flattenScalingPlanSchedule(model.Properties.Schedules))
 		d.Set("host_pool", flattenScalingHostpoolReference(model.Properties.HostPoolReferences))
 
+		hostPoolType := string(scalingplan.ScalingHostPoolTypePooled)
+		if model.Properties.HostPoolType != nil {
+			hostPoolType = string(*model.Properties.HostPoolType)
+		}
+		d.Set("host_pool_type", hostPoolType)
+
+		personalSchedules := make([]interface{}, 0)
+		if hostPoolType == scalingPlanHostPoolTypePersonal {
+			planId := scalingplanpersonalschedule.NewScalingPlanID(id.SubscriptionId, id.ResourceGroupName, id.ScalingPlanName)
+			schedules, err := meta.(*clients.Client).DesktopVirtualization.ScalingPlanPersonalSchedulesClient.ListComplete(ctx, planId, scalingplanpersonalschedule.DefaultListOperationOptions())
+			if err != nil {
+				return fmt.Errorf("listing Personal Schedules for %s: %+v", *id, err)
+			}
+			personalSchedules = flattenScalingPlanPersonalSchedules(schedules.Items, d.Get("personal_schedule").([]interface{}))
+		}
+		if err := d.Set("personal_schedule", personalSchedules); err != nil {
+			return fmt.Errorf("setting `personal_schedule`: %+v", err)
+		}
+
 		if err := tags.FlattenAndSet(d, model.Tags); err != nil {
 			return err
 		}
@@ -449,6 +652,179 @@ func expandScalingPlanSchedule(input []interface{}) *[]scalingplan.ScalingSchedu
 	return &results
 }
 
+// createOrUpdateScalingPlanPersonalSchedules reconciles the Personal Schedules of a Scaling Plan, removing any
+// schedules which are no longer defined and then creating or updating the remaining schedules
+func createOrUpdateScalingPlanPersonalSchedules(ctx context.Context, client *scalingplanpersonalschedule.ScalingPlanPersonalScheduleClient, id scalingplan.ScalingPlanId, old []interface{}, new []interface{}) error {
+	expected := make(map[string]scalingplanpersonalschedule.ScalingPlanPersonalScheduleProperties)
+	for _, item := range new {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+		expected[v["name"].(string)] = expandScalingPlanPersonalSchedule(v)
+	}
+
+	for _, item := range old {
+		if item == nil {
+			continue
+		}
+		name := item.(map[string]interface{})["name"].(string)
+		if _, ok := expected[name]; ok {
+			continue
+		}
+
+		scheduleId := scalingplanpersonalschedule.NewPersonalScheduleID(id.SubscriptionId, id.ResourceGroupName, id.ScalingPlanName, name)
+		if _, err := client.Delete(ctx, scheduleId); err != nil {
+			return fmt.Errorf("deleting %s: %+v", scheduleId, err)
+		}
+	}
+
+	for name, props := range expected {
+		scheduleId := scalingplanpersonalschedule.NewPersonalScheduleID(id.SubscriptionId, id.ResourceGroupName, id.ScalingPlanName, name)
+		payload := scalingplanpersonalschedule.ScalingPlanPersonalSchedule{
+			Properties: props,
+		}
+		if _, err := client.Create(ctx, scheduleId, payload); err != nil {
+			return fmt.Errorf("creating/updating %s: %+v", scheduleId, err)
+		}
+	}
+
+	return nil
+}
+
+func expandScalingPlanPersonalSchedule(input map[string]interface{}) scalingplanpersonalschedule.ScalingPlanPersonalScheduleProperties {
+	daysOfWeek := make([]scalingplanpersonalschedule.DayOfWeek, 0)
+	for _, weekday := range input["days_of_week"].(*pluginsdk.Set).List() {
+		daysOfWeek = append(daysOfWeek, scalingplanpersonalschedule.DayOfWeek(weekday.(string)))
+	}
+
+	return scalingplanpersonalschedule.ScalingPlanPersonalScheduleProperties{
+		DaysOfWeek:                        &daysOfWeek,
+		RampUpAutoStartHosts:              pointer.To(scalingplanpersonalschedule.StartupBehavior(input["ramp_up_auto_start_hosts"].(string))),
+		RampUpStartTime:                   expandScalingPlanPersonalScheduleTime(input["ramp_up_start_time"].(string)),
+		RampUpStartVMOnConnect:            expandScalingPlanPersonalScheduleStartVMOnConnect(input["ramp_up_start_vm_on_connect_enabled"].(bool)),
+		RampUpActionOnDisconnect:          pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(input["ramp_up_action_on_disconnect"].(string))),
+		RampUpMinutesToWaitOnDisconnect:   pointer.To(int64(input["ramp_up_minutes_to_wait_on_disconnect"].(int))),
+		RampUpActionOnLogoff:              pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(input["ramp_up_action_on_logoff"].(string))),
+		RampUpMinutesToWaitOnLogoff:       pointer.To(int64(input["ramp_up_minutes_to_wait_on_logoff"].(int))),
+		PeakStartTime:                     expandScalingPlanPersonalScheduleTime(input["peak_start_time"].(string)),
+		PeakStartVMOnConnect:              expandScalingPlanPersonalScheduleStartVMOnConnect(input["peak_start_vm_on_connect_enabled"].(bool)),
+		PeakActionOnDisconnect:            pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(input["peak_action_on_disconnect"].(string))),
+		PeakMinutesToWaitOnDisconnect:     pointer.To(int64(input["peak_minutes_to_wait_on_disconnect"].(int))),
+		PeakActionOnLogoff:                pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(input["peak_action_on_logoff"].(string))),
+		PeakMinutesToWaitOnLogoff:         pointer.To(int64(input["peak_minutes_to_wait_on_logoff"].(int))),
+		RampDownStartTime:                 expandScalingPlanPersonalScheduleTime(input["ramp_down_start_time"].(string)),
+		RampDownStartVMOnConnect:          expandScalingPlanPersonalScheduleStartVMOnConnect(input["ramp_down_start_vm_on_connect_enabled"].(bool)),
+		RampDownActionOnDisconnect:        pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(input["ramp_down_action_on_disconnect"].(string))),
+		RampDownMinutesToWaitOnDisconnect: pointer.To(int64(input["ramp_down_minutes_to_wait_on_disconnect"].(int))),
+		RampDownActionOnLogoff:            pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(input["ramp_down_action_on_logoff"].(string))),
+		RampDownMinutesToWaitOnLogoff:     pointer.To(int64(input["ramp_down_minutes_to_wait_on_logoff"].(int))),
+		OffPeakStartTime:                  expandScalingPlanPersonalScheduleTime(input["off_peak_start_time"].(string)),
+		OffPeakStartVMOnConnect:           expandScalingPlanPersonalScheduleStartVMOnConnect(input["off_peak_start_vm_on_connect_enabled"].(bool)),
+		OffPeakActionOnDisconnect:         pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(input["off_peak_action_on_disconnect"].(string))),
+		OffPeakMinutesToWaitOnDisconnect:  pointer.To(int64(input["off_peak_minutes_to_wait_on_disconnect"].(int))),
+		OffPeakActionOnLogoff:             pointer.To(scalingplanpersonalschedule.SessionHandlingOperation(input["off_peak_action_on_logoff"].(string))),
+		OffPeakMinutesToWaitOnLogoff:      pointer.To(int64(input["off_peak_minutes_to_wait_on_logoff"].(int))),
+	}
+}
+
+func expandScalingPlanPersonalScheduleTime(input string) *scalingplanpersonalschedule.Time {
+	t := expandScalingPlanScheduleTime(input)
+	if t == nil {
+		return nil
+	}
+
+	return &scalingplanpersonalschedule.Time{
+		Hour:   t.Hour,
+		Minute: t.Minute,
+	}
+}
+
+func expandScalingPlanPersonalScheduleStartVMOnConnect(input bool) *scalingplanpersonalschedule.SetStartVMOnConnect {
+	if input {
+		return pointer.To(scalingplanpersonalschedule.SetStartVMOnConnectEnable)
+	}
+	return pointer.To(scalingplanpersonalschedule.SetStartVMOnConnectDisable)
+}
+
+func flattenScalingPlanPersonalSchedules(input []scalingplanpersonalschedule.ScalingPlanPersonalSchedule, existing []interface{}) []interface{} {
+	results := make([]interface{}, 0)
+
+	for _, item := range input {
+		// the name is returned in the format `{scalingPlanName}/{scheduleName}`
+		name := pointer.From(item.Name)
+		if item.Id != nil {
+			if id, err := scalingplanpersonalschedule.ParsePersonalScheduleIDInsensitively(*item.Id); err == nil {
+				name = id.PersonalScheduleName
+			}
+		}
+
+		props := item.Properties
+		daysOfWeek := make([]string, 0)
+		for _, weekday := range pointer.From(props.DaysOfWeek) {
+			daysOfWeek = append(daysOfWeek, string(weekday))
+		}
+
+		results = append(results, map[string]interface{}{
+			"name":                                    name,
+			"days_of_week":                            daysOfWeek,
+			"ramp_up_auto_start_hosts":                string(pointer.From(props.RampUpAutoStartHosts)),
+			"ramp_up_start_time":                      flattenScalingPlanPersonalScheduleTime(props.RampUpStartTime),
+			"ramp_up_start_vm_on_connect_enabled":     pointer.From(props.RampUpStartVMOnConnect) == scalingplanpersonalschedule.SetStartVMOnConnectEnable,
+			"ramp_up_action_on_disconnect":            string(pointer.From(props.RampUpActionOnDisconnect)),
+			"ramp_up_minutes_to_wait_on_disconnect":   int(pointer.From(props.RampUpMinutesToWaitOnDisconnect)),
+			"ramp_up_action_on_logoff":                string(pointer.From(props.RampUpActionOnLogoff)),
+			"ramp_up_minutes_to_wait_on_logoff":       int(pointer.From(props.RampUpMinutesToWaitOnLogoff)),
+			"peak_start_time":                         flattenScalingPlanPersonalScheduleTime(props.PeakStartTime),
+			"peak_start_vm_on_connect_enabled":        pointer.From(props.PeakStartVMOnConnect) == scalingplanpersonalschedule.SetStartVMOnConnectEnable,
+			"peak_action_on_disconnect":               string(pointer.From(props.PeakActionOnDisconnect)),
+			"peak_minutes_to_wait_on_disconnect":      int(pointer.From(props.PeakMinutesToWaitOnDisconnect)),
+			"peak_action_on_logoff":                   string(pointer.From(props.PeakActionOnLogoff)),
+			"peak_minutes_to_wait_on_logoff":          int(pointer.From(props.PeakMinutesToWaitOnLogoff)),
+			"ramp_down_start_time":                    flattenScalingPlanPersonalScheduleTime(props.RampDownStartTime),
+			"ramp_down_start_vm_on_connect_enabled":   pointer.From(props.RampDownStartVMOnConnect) == scalingplanpersonalschedule.SetStartVMOnConnectEnable,
+			"ramp_down_action_on_disconnect":          string(pointer.From(props.RampDownActionOnDisconnect)),
+			"ramp_down_minutes_to_wait_on_disconnect": int(pointer.From(props.RampDownMinutesToWaitOnDisconnect)),
+			"ramp_down_action_on_logoff":              string(pointer.From(props.RampDownActionOnLogoff)),
+			"ramp_down_minutes_to_wait_on_logoff":     int(pointer.From(props.RampDownMinutesToWaitOnLogoff)),
+			"off_peak_start_time":                     flattenScalingPlanPersonalScheduleTime(props.OffPeakStartTime),
+			"off_peak_start_vm_on_connect_enabled":    pointer.From(props.OffPeakStartVMOnConnect) == scalingplanpersonalschedule.SetStartVMOnConnectEnable,
+			"off_peak_action_on_disconnect":           string(pointer.From(props.OffPeakActionOnDisconnect)),
+			"off_peak_minutes_to_wait_on_disconnect":  int(pointer.From(props.OffPeakMinutesToWaitOnDisconnect)),
+			"off_peak_action_on_logoff":               string(pointer.From(props.OffPeakActionOnLogoff)),
+			"off_peak_minutes_to_wait_on_logoff":      int(pointer.From(props.OffPeakMinutesToWaitOnLogoff)),
+		})
+	}
+
+	// the API doesn't return the schedules in a consistent order, so they're sorted to match the configuration
+	order := make(map[string]int)
+	for i, item := range existing {
+		if item != nil {
+			order[item.(map[string]interface{})["name"].(string)] = i
+		}
+	}
+	sort.SliceStable(results, func(i, j int) bool {
+		oi, ok := order[results[i].(map[string]interface{})["name"].(string)]
+		if !ok {
+			oi = len(existing)
+		}
+		oj, ok := order[results[j].(map[string]interface{})["name"].(string)]
+		if !ok {
+			oj = len(existing)
+		}
+		return oi < oj
+	})
+
+	return results
+}
+
+func flattenScalingPlanPersonalScheduleTime(input *scalingplanpersonalschedule.Time) string {
+	if input == nil {
+		return ""
+	}
+	return fmt.Sprintf("%02d:%02d", input.Hour, input.Minute)
+}
+
 func expandScalingPlanScheduleTime(input string) *scalingplan.Time {
 	if len(input) == 0 {
 		return nil
@@ -6,6 +6,7 @@ package desktopvirtualization_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/google/uuid"
@@ -100,6 +101,65 @@ func TestAccVirtualDesktopScalingPlan_requiresImport(t *testing.T) {
 	})
 }
 
+func TestAccVirtualDesktopScalingPlan_personal(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_scaling_plan", "test")
+	r := VirtualDesktopScalingPlanResource{}
+	roleAssignmentId := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.personal(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("personal_schedule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.personalUpdated(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("personal_schedule.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.personal(data, roleAssignmentId),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("personal_schedule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccVirtualDesktopScalingPlan_overlappingSchedules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_scaling_plan", "test")
+	r := VirtualDesktopScalingPlanResource{}
+	roleAssignmentId := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.overlappingSchedules(data, roleAssignmentId),
+			ExpectError: regexp.MustCompile("overlaps with the schedule"),
+		},
+	})
+}
+
+func TestAccVirtualDesktopScalingPlan_unorderedPhases(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_desktop_scaling_plan", "test")
+	r := VirtualDesktopScalingPlanResource{}
+	roleAssignmentId := uuid.New().String()
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.unorderedPhases(data, roleAssignmentId),
+			ExpectError: regexp.MustCompile("must be later than"),
+		},
+	})
+}
+
 func (VirtualDesktopScalingPlanResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := scalingplan.ParseScalingPlanID(state.ID)
 	if err != nil {
@@ -315,3 +375,188 @@ resource "azurerm_virtual_desktop_scaling_plan" "import" {
 }
 `, r.basic(data, roleAssignmentId))
 }
+
+func (VirtualDesktopScalingPlanResource) personalTemplate(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+provider "azuread" {}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-vdesktop-%d"
+  location = "%s"
+}
+
+data "azuread_service_principal" "test" {
+  display_name = "Windows Virtual Desktop"
+}
+
+resource "azurerm_role_assignment" "test" {
+  name                             = "%s"
+  scope                            = azurerm_resource_group.test.id
+  role_definition_name             = "Desktop Virtualization Power On Off Contributor"
+  principal_id                     = data.azuread_service_principal.test.object_id
+  skip_service_principal_aad_check = true
+}
+
+resource "azurerm_virtual_desktop_host_pool" "test" {
+  name                             = "acctestHP%s"
+  location                         = azurerm_resource_group.test.location
+  resource_group_name              = azurerm_resource_group.test.name
+  type                             = "Personal"
+  personal_desktop_assignment_type = "Automatic"
+  load_balancer_type               = "Persistent"
+  start_vm_on_connect              = true
+}
+`, data.RandomInteger, data.Locations.Primary, roleAssignmentId, data.RandomString)
+}
+
+func (r VirtualDesktopScalingPlanResource) personal(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_scaling_plan" "test" {
+  name                = "scalingPlan%x"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  time_zone           = "GMT Standard Time"
+  host_pool_type      = "Personal"
+
+  personal_schedule {
+    name                                    = "Weekdays"
+    days_of_week                            = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+    ramp_up_start_time                      = "06:00"
+    ramp_up_auto_start_hosts                = "WithAssignedUser"
+    peak_start_time                         = "09:00"
+    ramp_down_start_time                    = "18:00"
+    ramp_down_action_on_disconnect          = "Deallocate"
+    ramp_down_minutes_to_wait_on_disconnect = 30
+    off_peak_start_time                     = "22:00"
+    off_peak_action_on_disconnect           = "Hibernate"
+    off_peak_minutes_to_wait_on_disconnect  = 15
+    off_peak_action_on_logoff               = "Deallocate"
+    off_peak_minutes_to_wait_on_logoff      = 15
+  }
+
+  host_pool {
+    hostpool_id          = azurerm_virtual_desktop_host_pool.test.id
+    scaling_plan_enabled = true
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.personalTemplate(data, roleAssignmentId), data.RandomString)
+}
+
+func (r VirtualDesktopScalingPlanResource) personalUpdated(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_scaling_plan" "test" {
+  name                = "scalingPlan%x"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  time_zone           = "GMT Standard Time"
+  host_pool_type      = "Personal"
+
+  personal_schedule {
+    name                                    = "Weekdays"
+    days_of_week                            = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+    ramp_up_start_time                      = "07:00"
+    ramp_up_auto_start_hosts                = "All"
+    ramp_up_start_vm_on_connect_enabled     = false
+    peak_start_time                         = "10:00"
+    ramp_down_start_time                    = "17:00"
+    ramp_down_action_on_disconnect          = "Hibernate"
+    ramp_down_minutes_to_wait_on_disconnect = 20
+    off_peak_start_time                     = "21:00"
+    off_peak_action_on_disconnect           = "Deallocate"
+    off_peak_minutes_to_wait_on_disconnect  = 10
+  }
+
+  personal_schedule {
+    name                               = "Weekends"
+    days_of_week                       = ["Saturday", "Sunday"]
+    ramp_up_start_time                 = "09:00"
+    peak_start_time                    = "10:00"
+    ramp_down_start_time               = "16:00"
+    off_peak_start_time                = "18:00"
+    off_peak_action_on_logoff          = "Deallocate"
+    off_peak_minutes_to_wait_on_logoff = 5
+  }
+
+  host_pool {
+    hostpool_id          = azurerm_virtual_desktop_host_pool.test.id
+    scaling_plan_enabled = true
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.personalTemplate(data, roleAssignmentId), data.RandomString)
+}
+
+func (r VirtualDesktopScalingPlanResource) overlappingSchedules(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_scaling_plan" "test" {
+  name                = "scalingPlan%x"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  time_zone           = "GMT Standard Time"
+  host_pool_type      = "Personal"
+
+  personal_schedule {
+    name                 = "Weekdays"
+    days_of_week         = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+    ramp_up_start_time   = "06:00"
+    peak_start_time      = "09:00"
+    ramp_down_start_time = "18:00"
+    off_peak_start_time  = "22:00"
+  }
+
+  personal_schedule {
+    name                 = "Fridays"
+    days_of_week         = ["Friday"]
+    ramp_up_start_time   = "06:00"
+    peak_start_time      = "09:00"
+    ramp_down_start_time = "15:00"
+    off_peak_start_time  = "17:00"
+  }
+}
+`, r.personalTemplate(data, roleAssignmentId), data.RandomString)
+}
+
+func (r VirtualDesktopScalingPlanResource) unorderedPhases(data acceptance.TestData, roleAssignmentId string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_desktop_scaling_plan" "test" {
+  name                = "scalingPlan%x"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  time_zone           = "GMT Standard Time"
+
+  schedule {
+    name                                 = "Weekdays"
+    days_of_week                         = ["Monday", "Tuesday", "Wednesday", "Thursday", "Friday"]
+    ramp_up_start_time                   = "09:00"
+    ramp_up_load_balancing_algorithm     = "BreadthFirst"
+    peak_start_time                      = "06:00"
+    peak_load_balancing_algorithm        = "BreadthFirst"
+    ramp_down_start_time                 = "18:00"
+    ramp_down_load_balancing_algorithm   = "BreadthFirst"
+    ramp_down_minimum_hosts_percent      = 10
+    ramp_down_force_logoff_users         = false
+    ramp_down_wait_time_minutes          = 45
+    ramp_down_notification_message       = "Please log off in the next 45 minutes..."
+    ramp_down_capacity_threshold_percent = 5
+    ramp_down_stop_hosts_when            = "ZeroSessions"
+    off_peak_start_time                  = "22:00"
+    off_peak_load_balancing_algorithm    = "BreadthFirst"
+  }
+}
+`, r.personalTemplate(data, roleAssignmentId), data.RandomString)
+}
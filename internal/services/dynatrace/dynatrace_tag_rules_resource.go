@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dynatrace/2023-04-27/monitors"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/dynatrace/2023-04-27/tagrules"
@@ -19,6 +20,8 @@ import (
 
 type TagRulesResource struct{}
 
+var _ sdk.ResourceWithUpdate = TagRulesResource{}
+
 type TagRulesResourceModel struct {
 	Name        string       `tfschema:"name"`
 	Monitor     string       `tfschema:"monitor_id"`
@@ -62,42 +65,36 @@ func (r TagRulesResource) Arguments() map[string]*schema.Schema {
 		"log_rule": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
-			ForceNew: true,
 			MaxItems: 1,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*schema.Schema{
 					"send_azure_active_directory_logs_enabled": {
 						Type:     pluginsdk.TypeBool,
 						Optional: true,
-						ForceNew: true,
 						Default:  false,
 					},
 
 					"send_activity_logs_enabled": {
 						Type:     pluginsdk.TypeBool,
 						Optional: true,
-						ForceNew: true,
 						Default:  false,
 					},
 
 					"send_subscription_logs_enabled": {
 						Type:     pluginsdk.TypeBool,
 						Optional: true,
-						ForceNew: true,
 						Default:  false,
 					},
 
 					"filtering_tag": {
 						Type:     pluginsdk.TypeList,
 						Required: true,
-						ForceNew: true,
 						MinItems: 1,
 						Elem: &pluginsdk.Resource{
 							Schema: map[string]*schema.Schema{
 								"action": {
 									Type:     pluginsdk.TypeString,
 									Required: true,
-									ForceNew: true,
 									ValidateFunc: validation.StringInSlice([]string{
 										"Include",
 										"Exclude",
@@ -107,14 +104,12 @@ func (r TagRulesResource) Arguments() map[string]*schema.Schema {
 								"name": {
 									Type:         pluginsdk.TypeString,
 									Required:     true,
-									ForceNew:     true,
 									ValidateFunc: validation.StringIsNotEmpty,
 								},
 
 								"value": {
 									Type:         pluginsdk.TypeString,
 									Required:     true,
-									ForceNew:     true,
 									ValidateFunc: validation.StringIsNotEmpty,
 								},
 							},
@@ -127,21 +122,18 @@ func (r TagRulesResource) Arguments() map[string]*schema.Schema {
 		"metric_rule": {
 			Type:     pluginsdk.TypeList,
 			Optional: true,
-			ForceNew: true,
 			MaxItems: 1,
 			Elem: &pluginsdk.Resource{
 				Schema: map[string]*schema.Schema{
 					"filtering_tag": {
 						Type:     pluginsdk.TypeList,
 						Required: true,
-						ForceNew: true,
 						MinItems: 1,
 						Elem: &pluginsdk.Resource{
 							Schema: map[string]*schema.Schema{
 								"action": {
 									Type:     pluginsdk.TypeString,
 									Required: true,
-									ForceNew: true,
 									ValidateFunc: validation.StringInSlice([]string{
 										"Include",
 										"Exclude",
@@ -151,14 +143,12 @@ func (r TagRulesResource) Arguments() map[string]*schema.Schema {
 								"name": {
 									Type:         pluginsdk.TypeString,
 									Required:     true,
-									ForceNew:     true,
 									ValidateFunc: validation.StringIsNotEmpty,
 								},
 
 								"value": {
 									Type:         pluginsdk.TypeString,
 									Required:     true,
-									ForceNew:     true,
 									ValidateFunc: validation.StringIsNotEmpty,
 								},
 							},
@@ -205,7 +195,8 @@ func (r TagRulesResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
 			}
 
-			if !response.WasNotFound(existing.HttpResponse) {
+			// the `default` Tag Rule is created alongside the Monitor, so it's adopted when it doesn't contain any rules yet
+			if !response.WasNotFound(existing.HttpResponse) && (id.TagRuleName != "default" || !isDefaultTagRule(existing.Model)) {
 				return metadata.ResourceRequiresImport(r.ResourceType(), id)
 			}
 
@@ -218,7 +209,7 @@ func (r TagRulesResource) Create() sdk.ResourceFunc {
 				Properties: tagRulesProps,
 			}
 
-			if _, err := client.CreateOrUpdate(ctx, id, tagRules); err != nil {
+			if err := client.CreateOrUpdateThenPoll(ctx, id, tagRules); err != nil {
 				return fmt.Errorf("creating %s: %+v", id, err)
 			}
 
@@ -265,6 +256,69 @@ func (r TagRulesResource) Read() sdk.ResourceFunc {
 	}
 }
 
+func (r TagRulesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Dynatrace.TagRulesClient
+			id, err := tagrules.ParseTagRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model TagRulesResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			// only the writable properties are copied from the existing Tag Rules, so the read-only provisioning
+			// state is never sent back to the API
+			payload := tagrules.TagRule{
+				Properties: tagrules.MonitoringTagRulesProperties{
+					LogRules:    existing.Model.Properties.LogRules,
+					MetricRules: existing.Model.Properties.MetricRules,
+				},
+			}
+
+			// omitting the rules leaves them unchanged, so removed rules are explicitly reset
+			if metadata.ResourceData.HasChange("log_rule") {
+				payload.Properties.LogRules = ExpandLogRule(model.LogRules)
+				if payload.Properties.LogRules == nil {
+					payload.Properties.LogRules = &tagrules.LogRules{
+						FilteringTags:        &[]tagrules.FilteringTag{},
+						SendAadLogs:          pointer.To(tagrules.SendAadLogsStatusDisabled),
+						SendActivityLogs:     pointer.To(tagrules.SendActivityLogsStatusDisabled),
+						SendSubscriptionLogs: pointer.To(tagrules.SendSubscriptionLogsStatusDisabled),
+					}
+				}
+			}
+
+			if metadata.ResourceData.HasChange("metric_rule") {
+				payload.Properties.MetricRules = ExpandMetricRules(model.MetricRules)
+				if payload.Properties.MetricRules == nil {
+					payload.Properties.MetricRules = &tagrules.MetricRules{
+						FilteringTags: &[]tagrules.FilteringTag{},
+					}
+				}
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r TagRulesResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -288,3 +342,24 @@ func (r TagRulesResource) Delete() sdk.ResourceFunc {
 func (r TagRulesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
 	return tagrules.ValidateTagRuleID
 }
+
+func isDefaultTagRule(input *tagrules.TagRule) bool {
+	if input == nil {
+		return false
+	}
+
+	if logRules := input.Properties.LogRules; logRules != nil {
+		if len(pointer.From(logRules.FilteringTags)) > 0 ||
+			pointer.From(logRules.SendAadLogs) == tagrules.SendAadLogsStatusEnabled ||
+			pointer.From(logRules.SendActivityLogs) == tagrules.SendActivityLogsStatusEnabled ||
+			pointer.From(logRules.SendSubscriptionLogs) == tagrules.SendSubscriptionLogsStatusEnabled {
+			return false
+		}
+	}
+
+	if metricRules := input.Properties.MetricRules; metricRules != nil && len(pointer.From(metricRules.FilteringTags)) > 0 {
+		return false
+	}
+
+	return true
+}
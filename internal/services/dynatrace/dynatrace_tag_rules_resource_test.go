@@ -66,6 +66,60 @@ func TestAccDynatraceTagRules_complete(t *testing.T) {
 	})
 }
 
+func TestAccDynatraceTagRules_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dynatrace_tag_rules", "test")
+	r := NewTagRulesResource()
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.multipleFilteringTags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("log_rule.0.filtering_tag.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccDynatraceTagRules_defaultAdopted(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_dynatrace_tag_rules", "test")
+	r := NewTagRulesResource()
+	r.preCheck(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.defaultRule(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccDynatraceTagRules_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_dynatrace_tag_rules", "test")
 	r := NewTagRulesResource()
@@ -157,6 +211,50 @@ resource "azurerm_dynatrace_tag_rules" "test" {
 `, MonitorsResource{}.basic(data), data.RandomInteger)
 }
 
+func (r TagRulesResource) multipleFilteringTags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_dynatrace_tag_rules" "test" {
+  name       = "acctesttagrules%d"
+  monitor_id = azurerm_dynatrace_monitor.test.id
+
+  log_rule {
+    filtering_tag {
+      name   = "Environment"
+      value  = "Prod"
+      action = "Include"
+    }
+    filtering_tag {
+      name   = "Environment"
+      value  = "Dev"
+      action = "Exclude"
+    }
+    send_activity_logs_enabled = true
+  }
+}
+`, MonitorsResource{}.basic(data), data.RandomInteger)
+}
+
+func (r TagRulesResource) defaultRule(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_dynatrace_tag_rules" "test" {
+  name       = "default"
+  monitor_id = azurerm_dynatrace_monitor.test.id
+
+  metric_rule {
+    filtering_tag {
+      name   = "Environment"
+      value  = "Prod"
+      action = "Include"
+    }
+  }
+}
+`, MonitorsResource{}.basic(data))
+}
+
 func (r TagRulesResource) requiresImport(data acceptance.TestData) string {
 	template := r.basic(data)
 	return fmt.Sprintf(`
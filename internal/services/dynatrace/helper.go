@@ -151,19 +151,20 @@ func FlattenLogRules(input *tagrules.LogRules) []LogRule {
 }
 
 func FlattenFilteringTags(input *[]tagrules.FilteringTag) []FilteringTag {
-	if input == nil || len(*input) == 0 {
-		return []FilteringTag{}
+	results := make([]FilteringTag, 0)
+	if input == nil {
+		return results
 	}
 
-	tags := pointer.From(input)[0]
-
-	return []FilteringTag{
-		{
-			Name:   pointer.From(tags.Name),
-			Value:  pointer.From(tags.Value),
-			Action: string(pointer.From(tags.Action)),
-		},
+	for _, tag := range *input {
+		results = append(results, FilteringTag{
+			Name:   pointer.From(tag.Name),
+			Value:  pointer.From(tag.Value),
+			Action: string(pointer.From(tag.Action)),
+		})
 	}
+
+	return results
 }
 
 func FlattenMetricRules(input *tagrules.MetricRules) []MetricRule {
@@ -232,14 +233,15 @@ func ExpandFilteringTag(input []FilteringTag) *[]tagrules.FilteringTag {
 	if len(input) == 0 {
 		return nil
 	}
-	v := input[0]
-	action := tagrules.TagAction(v.Action)
 
-	return &[]tagrules.FilteringTag{
-		{
-			Action: pointer.To(action),
+	results := make([]tagrules.FilteringTag, 0)
+	for _, v := range input {
+		results = append(results, tagrules.FilteringTag{
+			Action: pointer.To(tagrules.TagAction(v.Action)),
 			Name:   pointer.To(v.Name),
 			Value:  pointer.To(v.Value),
-		},
+		})
 	}
+
+	return &results
 }
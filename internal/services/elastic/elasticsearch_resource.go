@@ -307,6 +307,15 @@ func resourceElasticsearchUpdate(d *pluginsdk.ResourceData, meta interface{}) er
 		client := meta.(*clients.Client).Elastic.TagRuleClient
 		tagRuleId := rules.NewTagRuleID(id.SubscriptionId, id.ResourceGroupName, id.MonitorName, "default")
 		tagRule := expandTagRule(d.Get("logs").([]interface{}))
+		if tagRule == nil {
+			// omitting the log rules leaves them unchanged, so they're explicitly reset when `logs` is removed
+			tagRule = &rules.LogRules{
+				FilteringTags:        &[]rules.FilteringTag{},
+				SendAadLogs:          utils.Bool(false),
+				SendActivityLogs:     utils.Bool(false),
+				SendSubscriptionLogs: utils.Bool(false),
+			}
+		}
 		body := rules.MonitoringTagRules{
 			Properties: &rules.MonitoringTagRulesProperties{
 				LogRules: tagRule,
@@ -0,0 +1,361 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eventgrid
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/clients"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/eventgrid/2023-12-15-preview/namespaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var (
+	_ sdk.ResourceWithUpdate        = EventGridNamespaceClientResource{}
+	_ sdk.ResourceWithCustomizeDiff = EventGridNamespaceClientResource{}
+)
+
+type EventGridNamespaceClientResource struct{}
+
+type EventGridNamespaceClientResourceModel struct {
+	Name                            string                                 `tfschema:"name"`
+	NamespaceId                     string                                 `tfschema:"namespace_id"`
+	Attributes                      map[string]string                      `tfschema:"attributes"`
+	AuthenticationName              string                                 `tfschema:"authentication_name"`
+	ClientCertificateAuthentication []ClientCertificateAuthenticationModel `tfschema:"client_certificate_authentication"`
+	Description                     string                                 `tfschema:"description"`
+	Enabled                         bool                                   `tfschema:"enabled"`
+}
+
+type ClientCertificateAuthenticationModel struct {
+	AllowedThumbprints []string `tfschema:"allowed_thumbprints"`
+	ValidationScheme   string   `tfschema:"validation_scheme"`
+}
+
+func (r EventGridNamespaceClientResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9:_-]{3,50}$`),
+				"`name` must be between 3 and 50 characters long and can only contain letters, numbers, colons, underscores and hyphens",
+			),
+		},
+
+		"namespace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: namespaces.ValidateNamespaceID,
+		},
+
+		"attributes": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"authentication_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(3, 128),
+		},
+
+		"client_certificate_authentication": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"validation_scheme": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validation.StringInSlice(clients.PossibleValuesForClientCertificateValidationScheme(), false),
+					},
+
+					"allowed_thumbprints": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 2,
+						Elem: &pluginsdk.Schema{
+							Type:         pluginsdk.TypeString,
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+					},
+				},
+			},
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(1, 128),
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r EventGridNamespaceClientResource) ModelObject() interface{} {
+	return &EventGridNamespaceClientResourceModel{}
+}
+
+func (r EventGridNamespaceClientResource) ResourceType() string {
+	return "azurerm_eventgrid_namespace_client"
+}
+
+func (r EventGridNamespaceClientResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return clients.ValidateClientID
+}
+
+func (r EventGridNamespaceClientResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			var model EventGridNamespaceClientResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			namespaceId, err := namespaces.ParseNamespaceID(model.NamespaceId)
+			if err != nil {
+				return err
+			}
+
+			id := clients.NewClientID(namespaceId.SubscriptionId, namespaceId.ResourceGroupName, namespaceId.NamespaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := expandNamespaceClient(model)
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, *payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			var model EventGridNamespaceClientResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := clients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			payload := expandNamespaceClient(model)
+
+			if err := client.CreateOrUpdateThenPoll(ctx, *id, *payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			id, err := clients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(existing.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := EventGridNamespaceClientResourceModel{
+				Name:        id.ClientName,
+				NamespaceId: namespaces.NewNamespaceID(id.SubscriptionId, id.ResourceGroupName, id.NamespaceName).ID(),
+			}
+
+			if model := existing.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Attributes = flattenNamespaceClientAttributes(props.Attributes)
+					state.AuthenticationName = pointer.From(props.AuthenticationName)
+					state.ClientCertificateAuthentication = flattenClientCertificateAuthentication(props.ClientCertificateAuthentication)
+					state.Description = pointer.From(props.Description)
+					state.Enabled = pointer.From(props.State) == clients.ClientStateEnabled
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model EventGridNamespaceClientResourceModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			if len(model.ClientCertificateAuthentication) == 0 {
+				return nil
+			}
+
+			// the values can't be compared until they're known
+			if !metadata.ResourceDiff.NewValueKnown("client_certificate_authentication.0.validation_scheme") || !metadata.ResourceDiff.NewValueKnown("client_certificate_authentication.0.allowed_thumbprints") {
+				return nil
+			}
+
+			certificateAuthentication := model.ClientCertificateAuthentication[0]
+			if certificateAuthentication.ValidationScheme == string(clients.ClientCertificateValidationSchemeThumbprintMatch) {
+				if len(certificateAuthentication.AllowedThumbprints) == 0 {
+					return fmt.Errorf("`allowed_thumbprints` must be specified when `validation_scheme` is `%s`", clients.ClientCertificateValidationSchemeThumbprintMatch)
+				}
+			} else if len(certificateAuthentication.AllowedThumbprints) > 0 {
+				return fmt.Errorf("`allowed_thumbprints` can only be specified when `validation_scheme` is `%s`", clients.ClientCertificateValidationSchemeThumbprintMatch)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r EventGridNamespaceClientResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.EventGrid.ClientsClient
+
+			id, err := clients.ParseClientID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandNamespaceClient(input EventGridNamespaceClientResourceModel) *clients.Client {
+	state := clients.ClientStateDisabled
+	if input.Enabled {
+		state = clients.ClientStateEnabled
+	}
+
+	props := &clients.ClientProperties{
+		Attributes: expandNamespaceClientAttributes(input.Attributes),
+		State:      pointer.To(state),
+	}
+
+	if input.Description != "" {
+		props.Description = pointer.To(input.Description)
+	}
+
+	if input.AuthenticationName != "" {
+		props.AuthenticationName = pointer.To(input.AuthenticationName)
+	}
+
+	if len(input.ClientCertificateAuthentication) > 0 {
+		certificateAuthentication := input.ClientCertificateAuthentication[0]
+		props.ClientCertificateAuthentication = &clients.ClientCertificateAuthentication{
+			ValidationScheme: pointer.To(clients.ClientCertificateValidationScheme(certificateAuthentication.ValidationScheme)),
+		}
+		if len(certificateAuthentication.AllowedThumbprints) > 0 {
+			props.ClientCertificateAuthentication.AllowedThumbprints = pointer.To(certificateAuthentication.AllowedThumbprints)
+		}
+	}
+
+	return &clients.Client{
+		Properties: props,
+	}
+}
+
+func expandNamespaceClientAttributes(input map[string]string) *map[string]interface{} {
+	if len(input) == 0 {
+		return nil
+	}
+
+	attributes := make(map[string]interface{})
+	for k, v := range input {
+		attributes[k] = v
+	}
+	return &attributes
+}
+
+func flattenNamespaceClientAttributes(input *map[string]interface{}) map[string]string {
+	output := make(map[string]string)
+	if input == nil {
+		return output
+	}
+
+	// attributes can also be integers or arrays of strings when set outside of Terraform, which are surfaced as strings
+	for k, v := range *input {
+		output[k] = fmt.Sprint(v)
+	}
+	return output
+}
+
+func flattenClientCertificateAuthentication(input *clients.ClientCertificateAuthentication) []ClientCertificateAuthenticationModel {
+	output := make([]ClientCertificateAuthenticationModel, 0)
+	if input == nil {
+		return output
+	}
+
+	return append(output, ClientCertificateAuthenticationModel{
+		AllowedThumbprints: pointer.From(input.AllowedThumbprints),
+		ValidationScheme:   string(pointer.From(input.ValidationScheme)),
+	})
+}
@@ -0,0 +1,267 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/azurefirewalls"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+// resourceFirewallPacketCapture triggers a packet capture on an Azure Firewall when created - the capture itself isn't
+// a resource in Azure, so this resource behaves as an action: the ID is only a handle for the request held in the state,
+// it can't be imported (since there's nothing to look up) and destroying it only removes it from the state.
+func resourceFirewallPacketCapture() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourceFirewallPacketCaptureCreate,
+		Read:   resourceFirewallPacketCaptureRead,
+		Delete: resourceFirewallPacketCaptureDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceIdThen(func(id string) error {
+			_, err := parse.FirewallPacketCaptureID(id)
+			return err
+		}, func(_ context.Context, d *pluginsdk.ResourceData, _ interface{}) ([]*pluginsdk.ResourceData, error) {
+			return nil, fmt.Errorf("`azurerm_firewall_packet_capture` can't be imported - a Packet Capture isn't a resource within Azure and can't be retrieved from the API, instead trigger a new capture by creating this resource")
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(60 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"firewall_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: azurefirewalls.ValidateAzureFirewallID,
+			},
+
+			"file_name": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"storage_container_sas_url": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.IsURLWithHTTPS,
+			},
+
+			"duration_in_seconds": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      60,
+				ValidateFunc: validation.IntBetween(30, 1800),
+			},
+
+			"number_of_packets_to_capture": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1000,
+				ValidateFunc: validation.IntBetween(100, 90000),
+			},
+
+			"protocol": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      string(azurefirewalls.AzureFirewallNetworkRuleProtocolAny),
+				ValidateFunc: validation.StringInSlice(azurefirewalls.PossibleValuesForAzureFirewallNetworkRuleProtocol(), false),
+			},
+
+			"flags": {
+				Type:     pluginsdk.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Schema{
+					Type:         pluginsdk.TypeString,
+					ValidateFunc: validation.StringInSlice(azurefirewalls.PossibleValuesForAzureFirewallPacketCaptureFlagsType(), false),
+				},
+			},
+
+			"filter": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				ForceNew: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"sources": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"destinations": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validation.StringIsNotEmpty,
+							},
+						},
+
+						"destination_ports": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							ForceNew: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: validate.PortOrPortRangeWithin(1, 65535),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceFirewallPacketCaptureCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.AzureFirewalls
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	firewallId, err := azurefirewalls.ParseAzureFirewallID(d.Get("firewall_id").(string))
+	if err != nil {
+		return err
+	}
+
+	id := parse.NewFirewallPacketCaptureID(firewallId.SubscriptionId, firewallId.ResourceGroupName, firewallId.AzureFirewallName, d.Get("name").(string))
+
+	existing, err := client.Get(ctx, *firewallId)
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("%s was not found", *firewallId)
+		}
+		return fmt.Errorf("retrieving %s: %+v", *firewallId, err)
+	}
+
+	parameters := azurefirewalls.FirewallPacketCaptureParameters{
+		DurationInSeconds:        pointer.To(int64(d.Get("duration_in_seconds").(int))),
+		FileName:                 pointer.To(d.Get("file_name").(string)),
+		Filters:                  expandFirewallPacketCaptureFilters(d.Get("filter").([]interface{})),
+		Flags:                    expandFirewallPacketCaptureFlags(d.Get("flags").(*pluginsdk.Set).List()),
+		NumberOfPacketsToCapture: pointer.To(int64(d.Get("number_of_packets_to_capture").(int))),
+		Protocol:                 pointer.To(azurefirewalls.AzureFirewallNetworkRuleProtocol(d.Get("protocol").(string))),
+		SasURL:                   pointer.To(d.Get("storage_container_sas_url").(string)),
+	}
+
+	locks.ByName(id.AzureFirewallName, AzureFirewallResourceName)
+	defer locks.UnlockByName(id.AzureFirewallName, AzureFirewallResourceName)
+
+	result, err := client.PacketCapture(ctx, *firewallId, parameters)
+	if err != nil {
+		return fmt.Errorf("starting %s: %+v", id, err)
+	}
+	if err := result.Poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to complete: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceFirewallPacketCaptureRead(d, meta)
+}
+
+func resourceFirewallPacketCaptureRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.AzureFirewalls
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.FirewallPacketCaptureID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	firewallId := azurefirewalls.NewAzureFirewallID(id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName)
+
+	// the packet capture can't be retrieved from the API, so only the presence of the Firewall is checked
+	resp, err := client.Get(ctx, firewallId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing %s from state", firewallId, id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", firewallId, err)
+	}
+
+	d.Set("name", id.PacketCaptureName)
+	d.Set("firewall_id", firewallId.ID())
+
+	return nil
+}
+
+func resourceFirewallPacketCaptureDelete(d *pluginsdk.ResourceData, _ interface{}) error {
+	id, err := parse.FirewallPacketCaptureID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	// there's nothing to delete in Azure, the captured packets remain in the Storage Container
+	log.Printf("[DEBUG] removing %s from state", id)
+
+	return nil
+}
+
+func expandFirewallPacketCaptureFilters(input []interface{}) *[]azurefirewalls.AzureFirewallPacketCaptureRule {
+	results := make([]azurefirewalls.AzureFirewallPacketCaptureRule, 0)
+	for _, item := range input {
+		if item == nil {
+			continue
+		}
+
+		v := item.(map[string]interface{})
+		results = append(results, azurefirewalls.AzureFirewallPacketCaptureRule{
+			DestinationPorts: utils.ExpandStringSlice(v["destination_ports"].([]interface{})),
+			Destinations:     utils.ExpandStringSlice(v["destinations"].([]interface{})),
+			Sources:          utils.ExpandStringSlice(v["sources"].([]interface{})),
+		})
+	}
+
+	return &results
+}
+
+func expandFirewallPacketCaptureFlags(input []interface{}) *[]azurefirewalls.AzureFirewallPacketCaptureFlags {
+	results := make([]azurefirewalls.AzureFirewallPacketCaptureFlags, 0)
+	for _, item := range input {
+		results = append(results, azurefirewalls.AzureFirewallPacketCaptureFlags{
+			Type: pointer.To(azurefirewalls.AzureFirewallPacketCaptureFlagsType(item.(string))),
+		})
+	}
+
+	return &results
+}
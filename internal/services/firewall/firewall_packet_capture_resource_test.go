@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package firewall_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/azurefirewalls"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/firewall/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type FirewallPacketCaptureResource struct{}
+
+func TestAccFirewallPacketCapture_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_firewall_packet_capture", "test")
+	r := FirewallPacketCaptureResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+	})
+}
+
+func (FirewallPacketCaptureResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.FirewallPacketCaptureID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	firewallId := azurefirewalls.NewAzureFirewallID(id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName)
+	resp, err := clients.Network.AzureFirewalls.Get(ctx, firewallId)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", firewallId, err)
+	}
+
+	return utils.Bool(resp.Model != nil), nil
+}
+
+func (FirewallPacketCaptureResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "packet-captures"
+  storage_account_id    = azurerm_storage_account.test.id
+  container_access_type = "private"
+}
+
+data "azurerm_storage_account_blob_container_sas" "test" {
+  connection_string = azurerm_storage_account.test.primary_connection_string
+  container_name    = azurerm_storage_container.test.name
+  https_only        = true
+
+  start  = "2024-01-01"
+  expiry = "2030-01-01"
+
+  permissions {
+    read   = true
+    add    = true
+    create = true
+    write  = true
+    delete = false
+    list   = true
+  }
+}
+
+resource "azurerm_firewall_packet_capture" "test" {
+  name                         = "acctest-capture-%d"
+  firewall_id                  = azurerm_firewall.test.id
+  file_name                    = "capture"
+  storage_container_sas_url    = "${azurerm_storage_account.test.primary_blob_endpoint}${azurerm_storage_container.test.name}${data.azurerm_storage_account_blob_container_sas.test.sas}"
+  duration_in_seconds          = 30
+  number_of_packets_to_capture = 500
+  protocol                     = "TCP"
+  flags                        = ["syn", "fin"]
+
+  filter {
+    sources           = ["10.0.0.0/16"]
+    destinations      = ["10.0.1.0/24"]
+    destination_ports = ["443"]
+  }
+}
+`, FirewallResource{}.basic(data), data.RandomString, data.RandomInteger)
+}
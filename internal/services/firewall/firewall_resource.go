@@ -4,6 +4,7 @@
 package firewall
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -51,6 +52,10 @@ func resourceFirewall() *pluginsdk.Resource {
 			Delete: pluginsdk.DefaultTimeout(90 * time.Minute),
 		},
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+			return validateFirewallAutoscaleConfiguration(d.Get("autoscale_configuration").([]interface{}))
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -221,6 +226,28 @@ func resourceFirewall() *pluginsdk.Resource {
 				},
 			},
 
+			"autoscale_configuration": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"min_capacity": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(2, 50),
+							AtLeastOneOf: []string{"autoscale_configuration.0.min_capacity", "autoscale_configuration.0.max_capacity"},
+						},
+						"max_capacity": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							ValidateFunc: validation.IntBetween(2, 50),
+							AtLeastOneOf: []string{"autoscale_configuration.0.min_capacity", "autoscale_configuration.0.max_capacity"},
+						},
+					},
+				},
+			},
+
 			"zones": commonschema.ZonesMultipleOptionalForceNew(),
 
 			"tags": commonschema.Tags(),
@@ -266,13 +293,19 @@ func resourceFirewallCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 	parameters := azurefirewalls.AzureFirewall{
 		Location: &location,
 		Properties: &azurefirewalls.AzureFirewallPropertiesFormat{
-			IPConfigurations:     ipConfigs,
-			ThreatIntelMode:      pointer.To(azurefirewalls.AzureFirewallThreatIntelMode(d.Get("threat_intel_mode").(string))),
-			AdditionalProperties: pointer.To(make(map[string]string)),
+			IPConfigurations:       ipConfigs,
+			ThreatIntelMode:        pointer.To(azurefirewalls.AzureFirewallThreatIntelMode(d.Get("threat_intel_mode").(string))),
+			AdditionalProperties:   pointer.To(make(map[string]string)),
+			AutoscaleConfiguration: expandFirewallAutoscaleConfiguration(d.Get("autoscale_configuration").([]interface{})),
 		},
 		Tags: tags.Expand(t),
 	}
 
+	// removing the block has to explicitly reset the capacities, omitting it would leave the previous values in place
+	if !d.IsNewResource() && d.HasChange("autoscale_configuration") && parameters.Properties.AutoscaleConfiguration == nil {
+		parameters.Properties.AutoscaleConfiguration = &azurefirewalls.AzureFirewallAutoscaleConfiguration{}
+	}
+
 	zones := zones.ExpandUntyped(d.Get("zones").(*schema.Set).List())
 	if len(zones) > 0 {
 		parameters.Zones = &zones
@@ -464,6 +497,10 @@ func resourceFirewallRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			if err := d.Set("virtual_hub", flattenFirewallVirtualHubSetting(props)); err != nil {
 				return fmt.Errorf("setting `virtual_hub`: %+v", err)
 			}
+
+			if err := d.Set("autoscale_configuration", flattenFirewallAutoscaleConfiguration(props.AutoscaleConfiguration)); err != nil {
+				return fmt.Errorf("setting `autoscale_configuration`: %+v", err)
+			}
 		}
 
 		return tags.FlattenAndSet(d, model.Tags)
@@ -811,6 +848,53 @@ func flattenFirewallVirtualHubSetting(props *azurefirewalls.AzureFirewallPropert
 	}
 }
 
+func expandFirewallAutoscaleConfiguration(input []interface{}) *azurefirewalls.AzureFirewallAutoscaleConfiguration {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	result := azurefirewalls.AzureFirewallAutoscaleConfiguration{}
+
+	if minCapacity := v["min_capacity"].(int); minCapacity != 0 {
+		result.MinCapacity = pointer.To(int64(minCapacity))
+	}
+
+	if maxCapacity := v["max_capacity"].(int); maxCapacity != 0 {
+		result.MaxCapacity = pointer.To(int64(maxCapacity))
+	}
+
+	return &result
+}
+
+func validateFirewallAutoscaleConfiguration(input []interface{}) error {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	v := input[0].(map[string]interface{})
+	minCapacity := v["min_capacity"].(int)
+	maxCapacity := v["max_capacity"].(int)
+	if minCapacity != 0 && maxCapacity != 0 && minCapacity > maxCapacity {
+		return fmt.Errorf("`autoscale_configuration.0.min_capacity` (%d) must not be greater than `autoscale_configuration.0.max_capacity` (%d)", minCapacity, maxCapacity)
+	}
+
+	return nil
+}
+
+func flattenFirewallAutoscaleConfiguration(input *azurefirewalls.AzureFirewallAutoscaleConfiguration) []interface{} {
+	if input == nil || (input.MinCapacity == nil && input.MaxCapacity == nil) {
+		return []interface{}{}
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"min_capacity": int(pointer.From(input.MinCapacity)),
+			"max_capacity": int(pointer.From(input.MaxCapacity)),
+		},
+	}
+}
+
 func validateFirewallIPConfigurationSettings(configs []interface{}) error {
 	if len(configs) == 0 {
 		return nil
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type FirewallPacketCaptureId struct {
+	SubscriptionId    string
+	ResourceGroup     string
+	AzureFirewallName string
+	PacketCaptureName string
+}
+
+func NewFirewallPacketCaptureID(subscriptionId, resourceGroup, azureFirewallName, packetCaptureName string) FirewallPacketCaptureId {
+	return FirewallPacketCaptureId{
+		SubscriptionId:    subscriptionId,
+		ResourceGroup:     resourceGroup,
+		AzureFirewallName: azureFirewallName,
+		PacketCaptureName: packetCaptureName,
+	}
+}
+
+func (id FirewallPacketCaptureId) String() string {
+	segments := []string{
+		fmt.Sprintf("Packet Capture Name %q", id.PacketCaptureName),
+		fmt.Sprintf("Azure Firewall Name %q", id.AzureFirewallName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Firewall Packet Capture", segmentsStr)
+}
+
+func (id FirewallPacketCaptureId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Network/azureFirewalls/%s/packetCaptures/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.AzureFirewallName, id.PacketCaptureName)
+}
+
+// FirewallPacketCaptureID parses a FirewallPacketCapture ID into an FirewallPacketCaptureId struct
+func FirewallPacketCaptureID(input string) (*FirewallPacketCaptureId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an FirewallPacketCapture ID: %+v", input, err)
+	}
+
+	resourceId := FirewallPacketCaptureId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.AzureFirewallName, err = id.PopSegment("azureFirewalls"); err != nil {
+		return nil, err
+	}
+	if resourceId.PacketCaptureName, err = id.PopSegment("packetCaptures"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
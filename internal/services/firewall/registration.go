@@ -44,6 +44,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_firewall_policy_rule_collection_group": resourceFirewallPolicyRuleCollectionGroup(),
 		"azurerm_firewall_nat_rule_collection":          resourceFirewallNatRuleCollection(),
 		"azurerm_firewall_network_rule_collection":      resourceFirewallNetworkRuleCollection(),
+		"azurerm_firewall_packet_capture":               resourceFirewallPacketCapture(),
 		"azurerm_firewall":                              resourceFirewall(),
 	}
 }
@@ -10,11 +10,13 @@ import (
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hdinsight/2021-06-01/applications"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hdinsight/2021-06-01/clusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/hdinsight/2021-06-01/extensions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hdinsight/2021-06-01/scriptactions"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hdinsight/custompollers"
@@ -28,6 +30,7 @@ func hdinsightClusterUpdate(clusterKind string, readFunc pluginsdk.ReadFunc) plu
 		client := meta.(*clients.Client).HDInsight.Clusters
 		extensionsClient := meta.(*clients.Client).HDInsight.Extensions
 		applicationsClient := meta.(*clients.Client).HDInsight.Applications
+		scriptActionsClient := meta.(*clients.Client).HDInsight.ScriptActions
 		ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 		defer cancel()
 
@@ -74,6 +77,22 @@ func hdinsightClusterUpdate(clusterKind string, readFunc pluginsdk.ReadFunc) plu
 			}
 		}
 
+		if rolesRaw := d.Get("roles").([]interface{}); len(rolesRaw) > 0 && rolesRaw[0] != nil {
+			roles := rolesRaw[0].(map[string]interface{})
+			for _, role := range hdInsightRolesWithScriptActions {
+				key := fmt.Sprintf("roles.0.%s.0.script_actions", role.schemaName)
+				if _, ok := roles[role.schemaName]; !ok || !d.HasChange(key) {
+					continue
+				}
+
+				log.Printf("[DEBUG] Updating the Script Actions for the %q role of the HDInsight %q Cluster", role.name, clusterKind)
+				oldScriptActions, newScriptActions := d.GetChange(key)
+				if err := updateHDInsightRoleScriptActions(ctx, client, scriptActionsClient, *id, role.name, oldScriptActions.([]interface{}), newScriptActions.([]interface{})); err != nil {
+					return fmt.Errorf("updating `%s` for %s %s: %+v", key, clusterKind, id, err)
+				}
+			}
+		}
+
 		// The API can add an edge node but can't remove them without force newing the pluginsdk. We'll check for adding here
 		// and can come back to removing if that functionality gets added. https://feedback.azure.com/forums/217335-hdinsight/suggestions/5663773-start-stop-cluster-hdinsight?page=3&per_page=20
 		if clusterKind == "Hadoop" {
@@ -286,6 +305,74 @@ func flattenHDInsightRoles(d *pluginsdk.ResourceData, input *clusters.ComputePro
 	}
 }
 
+var hdInsightRolesWithScriptActions = []struct {
+	schemaName string
+	name       string
+}{
+	{schemaName: "head_node", name: "headnode"},
+	{schemaName: "worker_node", name: "workernode"},
+	{schemaName: "zookeeper_node", name: "zookeepernode"},
+	{schemaName: "kafka_management_node", name: "kafkamanagementnode"},
+}
+
+// updateHDInsightRoleScriptActions updates the Script Actions of a role in-place. Script Actions which have been removed
+// or changed are removed from the persisted Script Actions of the cluster, and those which have been added or changed
+// are then executed on the role and persisted, so that they're also run on any nodes added when scaling.
+func updateHDInsightRoleScriptActions(ctx context.Context, client *clusters.ClustersClient, scriptActionsClient *scriptactions.ScriptActionsClient, id commonids.HDInsightClusterId, roleName string, oldInput []interface{}, newInput []interface{}) error {
+	existing := make(map[string]clusters.ScriptAction)
+	for _, v := range pointer.From(ExpandHDInsightsRolesScriptActions(oldInput)) {
+		existing[v.Name] = v
+	}
+
+	desired := make(map[string]clusters.ScriptAction)
+	for _, v := range pointer.From(ExpandHDInsightsRolesScriptActions(newInput)) {
+		desired[v.Name] = v
+	}
+
+	for name, scriptAction := range existing {
+		if v, ok := desired[name]; ok && v == scriptAction {
+			continue
+		}
+
+		scriptActionId := scriptactions.NewScriptActionID(id.SubscriptionId, id.ResourceGroupName, id.ClusterName, name)
+		if resp, err := scriptActionsClient.Delete(ctx, scriptActionId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+			return fmt.Errorf("removing %s: %+v", scriptActionId, err)
+		}
+	}
+
+	// the Script Actions are executed in the order they're defined
+	runtimeScriptActions := make([]clusters.RuntimeScriptAction, 0)
+	for _, v := range pointer.From(ExpandHDInsightsRolesScriptActions(newInput)) {
+		if scriptAction, ok := existing[v.Name]; ok && v == scriptAction {
+			continue
+		}
+
+		runtimeScriptAction := clusters.RuntimeScriptAction{
+			Name:  v.Name,
+			Uri:   v.Uri,
+			Roles: []string{roleName},
+		}
+		if v.Parameters != "" {
+			runtimeScriptAction.Parameters = pointer.To(v.Parameters)
+		}
+		runtimeScriptActions = append(runtimeScriptActions, runtimeScriptAction)
+	}
+
+	if len(runtimeScriptActions) == 0 {
+		return nil
+	}
+
+	payload := clusters.ExecuteScriptActionParameters{
+		PersistOnSuccess: true,
+		ScriptActions:    &runtimeScriptActions,
+	}
+	if err := client.ExecuteScriptActionsThenPoll(ctx, id, payload); err != nil {
+		return fmt.Errorf("executing Script Actions: %+v", err)
+	}
+
+	return nil
+}
+
 func createHDInsightEdgeNodes(ctx context.Context, client *applications.ApplicationsClient, applicationId applications.ApplicationId, input map[string]interface{}) error {
 	installScriptActions := expandHDInsightApplicationEdgeNodeInstallScriptActions(input["install_script_action"].([]interface{}))
 
@@ -0,0 +1,280 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/licenses"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ArcLicenseResourceModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	Location          string            `tfschema:"location"`
+	State             string            `tfschema:"state"`
+	Target            string            `tfschema:"target"`
+	Edition           string            `tfschema:"edition"`
+	CoreType          string            `tfschema:"core_type"`
+	Processors        int64             `tfschema:"processors"`
+	Tags              map[string]string `tfschema:"tags"`
+	AssignedLicenses  int64             `tfschema:"assigned_licenses"`
+	ImmutableId       string            `tfschema:"immutable_id"`
+}
+
+type ArcLicenseResource struct{}
+
+var _ sdk.ResourceWithUpdate = ArcLicenseResource{}
+
+func (r ArcLicenseResource) ResourceType() string {
+	return "azurerm_arc_license"
+}
+
+func (r ArcLicenseResource) ModelObject() interface{} {
+	return &ArcLicenseResourceModel{}
+}
+
+func (r ArcLicenseResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return licenses.ValidateLicenseID
+}
+
+func (r ArcLicenseResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"location": commonschema.Location(),
+
+		"state": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseState(), false),
+		},
+
+		"target": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseTarget(), false),
+		},
+
+		"edition": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseEdition(), false),
+		},
+
+		"core_type": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringInSlice(licenses.PossibleValuesForLicenseCoreType(), false),
+		},
+
+		"processors": {
+			Type:         pluginsdk.TypeInt,
+			Required:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r ArcLicenseResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"assigned_licenses": {
+			Type:     pluginsdk.TypeInt,
+			Computed: true,
+		},
+
+		"immutable_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ArcLicenseResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			subscriptionId := metadata.Client.Account.SubscriptionId
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.Licenses
+
+			var model ArcLicenseResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := licenses.NewLicenseID(subscriptionId, model.ResourceGroupName, model.Name)
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// Extended Security Updates is the only type of license which can be provisioned through Azure Arc
+			payload := licenses.License{
+				Location: location.Normalize(model.Location),
+				Properties: &licenses.LicenseProperties{
+					LicenseType: pointer.To(licenses.LicenseTypeESU),
+					LicenseDetails: &licenses.LicenseDetails{
+						Edition:    pointer.To(licenses.LicenseEdition(model.Edition)),
+						Processors: pointer.To(model.Processors),
+						State:      pointer.To(licenses.LicenseState(model.State)),
+						Target:     pointer.To(licenses.LicenseTarget(model.Target)),
+						Type:       pointer.To(licenses.LicenseCoreType(model.CoreType)),
+					},
+				},
+				Tags: pointer.To(model.Tags),
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcLicenseResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.Licenses
+
+			id, err := licenses.ParseLicenseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ArcLicenseResourceModel{
+				Name:              id.LicenseName,
+				ResourceGroupName: id.ResourceGroupName,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					if details := props.LicenseDetails; details != nil {
+						state.AssignedLicenses = pointer.From(details.AssignedLicenses)
+						state.CoreType = string(pointer.From(details.Type))
+						state.Edition = string(pointer.From(details.Edition))
+						state.ImmutableId = pointer.From(details.ImmutableId)
+						state.Processors = pointer.From(details.Processors)
+						state.State = string(pointer.From(details.State))
+						state.Target = string(pointer.From(details.Target))
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcLicenseResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.Licenses
+
+			id, err := licenses.ParseLicenseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ArcLicenseResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := licenses.LicenseUpdate{
+				Properties: &licenses.LicenseUpdateProperties{
+					LicenseDetails: &licenses.LicenseUpdatePropertiesLicenseDetails{},
+				},
+			}
+			details := payload.Properties.LicenseDetails
+
+			if metadata.ResourceData.HasChange("state") {
+				details.State = pointer.To(licenses.LicenseState(model.State))
+			}
+
+			if metadata.ResourceData.HasChange("target") {
+				details.Target = pointer.To(licenses.LicenseTarget(model.Target))
+			}
+
+			if metadata.ResourceData.HasChange("edition") {
+				details.Edition = pointer.To(licenses.LicenseEdition(model.Edition))
+			}
+
+			if metadata.ResourceData.HasChange("core_type") {
+				details.Type = pointer.To(licenses.LicenseCoreType(model.CoreType))
+			}
+
+			if metadata.ResourceData.HasChange("processors") {
+				details.Processors = pointer.To(model.Processors)
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcLicenseResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.Licenses
+
+			id, err := licenses.ParseLicenseID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
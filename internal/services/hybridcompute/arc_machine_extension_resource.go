@@ -22,17 +22,18 @@ import (
 )
 
 type MachineExtensionModel struct {
-	Name                   string            `tfschema:"name"`
-	HybridComputeMachineId string            `tfschema:"arc_machine_id"`
-	EnableAutomaticUpgrade bool              `tfschema:"automatic_upgrade_enabled"`
-	ForceUpdateTag         string            `tfschema:"force_update_tag"`
-	Location               string            `tfschema:"location"`
-	ProtectedSettings      string            `tfschema:"protected_settings"`
-	Publisher              string            `tfschema:"publisher"`
-	Settings               string            `tfschema:"settings"`
-	Tags                   map[string]string `tfschema:"tags"`
-	Type                   string            `tfschema:"type"`
-	TypeHandlerVersion     string            `tfschema:"type_handler_version"`
+	Name                    string            `tfschema:"name"`
+	HybridComputeMachineId  string            `tfschema:"arc_machine_id"`
+	AutoUpgradeMinorVersion bool              `tfschema:"auto_upgrade_minor_version_enabled"`
+	EnableAutomaticUpgrade  bool              `tfschema:"automatic_upgrade_enabled"`
+	ForceUpdateTag          string            `tfschema:"force_update_tag"`
+	Location                string            `tfschema:"location"`
+	ProtectedSettings       string            `tfschema:"protected_settings"`
+	Publisher               string            `tfschema:"publisher"`
+	Settings                string            `tfschema:"settings"`
+	Tags                    map[string]string `tfschema:"tags"`
+	Type                    string            `tfschema:"type"`
+	TypeHandlerVersion      string            `tfschema:"type_handler_version"`
 }
 
 type ArcMachineExtensionResource struct{}
@@ -72,6 +73,11 @@ func (r ArcMachineExtensionResource) Arguments() map[string]*pluginsdk.Schema {
 			ValidateFunc: machines.ValidateMachineID,
 		},
 
+		"auto_upgrade_minor_version_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+		},
+
 		"automatic_upgrade_enabled": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
@@ -166,7 +172,8 @@ func (r ArcMachineExtensionResource) Create() sdk.ResourceFunc {
 			properties := &machineextensions.MachineExtension{
 				Location: location.Normalize(model.Location),
 				Properties: &machineextensions.MachineExtensionProperties{
-					EnableAutomaticUpgrade: &model.EnableAutomaticUpgrade,
+					AutoUpgradeMinorVersion: &model.AutoUpgradeMinorVersion,
+					EnableAutomaticUpgrade:  &model.EnableAutomaticUpgrade,
 				},
 				Tags: &model.Tags,
 			}
@@ -241,6 +248,10 @@ func (r ArcMachineExtensionResource) Update() sdk.ResourceFunc {
 				return fmt.Errorf("retrieving %s: properties was nil", id)
 			}
 
+			if metadata.ResourceData.HasChange("auto_upgrade_minor_version_enabled") {
+				properties.Properties.AutoUpgradeMinorVersion = &model.AutoUpgradeMinorVersion
+			}
+
 			if metadata.ResourceData.HasChange("automatic_upgrade_enabled") {
 				properties.Properties.EnableAutomaticUpgrade = &model.EnableAutomaticUpgrade
 			}
@@ -343,6 +354,10 @@ func (r ArcMachineExtensionResource) Read() sdk.ResourceFunc {
 				state.Location = location.Normalize(model.Location)
 
 				if properties := model.Properties; properties != nil {
+					if properties.AutoUpgradeMinorVersion != nil {
+						state.AutoUpgradeMinorVersion = *properties.AutoUpgradeMinorVersion
+					}
+
 					if properties.EnableAutomaticUpgrade != nil {
 						state.EnableAutomaticUpgrade = *properties.EnableAutomaticUpgrade
 					}
@@ -157,15 +157,16 @@ func (r ArcMachineExtensionResource) complete(data acceptance.TestData, template
 			%s
 
 resource "azurerm_arc_machine_extension" "test" {
-  name                      = "acctest-hcme-%d"
-  arc_machine_id            = data.azurerm_arc_machine.test.id
-  location                  = "%s"
-  automatic_upgrade_enabled = false
-  publisher                 = "Microsoft.Azure.Extensions"
-  settings                  = jsonencode({ "timestamp" : 123456789 })
-  protected_settings        = jsonencode({ "commandToExecute" : "echo 'Hello World!'" })
-  type                      = "CustomScript"
-  type_handler_version      = "2.1"
+  name                               = "acctest-hcme-%d"
+  arc_machine_id                     = data.azurerm_arc_machine.test.id
+  location                           = "%s"
+  auto_upgrade_minor_version_enabled = true
+  automatic_upgrade_enabled          = false
+  publisher                          = "Microsoft.Azure.Extensions"
+  settings                           = jsonencode({ "timestamp" : 123456789 })
+  protected_settings                 = jsonencode({ "commandToExecute" : "echo 'Hello World!'" })
+  type                               = "CustomScript"
+  type_handler_version               = "2.1"
 
   tags = {
     Environment = "Production"
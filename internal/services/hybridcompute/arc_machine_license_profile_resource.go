@@ -0,0 +1,262 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/licenseprofiles"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/licenses"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/machines"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ArcMachineLicenseProfileResourceModel struct {
+	ArcMachineId                     string `tfschema:"arc_machine_id"`
+	EsuLicenseId                     string `tfschema:"esu_license_id"`
+	SoftwareAssuranceCustomerEnabled bool   `tfschema:"software_assurance_customer_enabled"`
+	EsuEligibility                   string `tfschema:"esu_eligibility"`
+	EsuKeyState                      string `tfschema:"esu_key_state"`
+	EsuServerType                    string `tfschema:"esu_server_type"`
+}
+
+type ArcMachineLicenseProfileResource struct{}
+
+var _ sdk.ResourceWithUpdate = ArcMachineLicenseProfileResource{}
+
+func (r ArcMachineLicenseProfileResource) ResourceType() string {
+	return "azurerm_arc_machine_license_profile"
+}
+
+func (r ArcMachineLicenseProfileResource) ModelObject() interface{} {
+	return &ArcMachineLicenseProfileResourceModel{}
+}
+
+func (r ArcMachineLicenseProfileResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.ArcMachineLicenseProfileID
+}
+
+func (r ArcMachineLicenseProfileResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"arc_machine_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: machines.ValidateMachineID,
+		},
+
+		"esu_license_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: licenses.ValidateLicenseID,
+			AtLeastOneOf: []string{"esu_license_id", "software_assurance_customer_enabled"},
+		},
+
+		"software_assurance_customer_enabled": {
+			Type:         pluginsdk.TypeBool,
+			Optional:     true,
+			AtLeastOneOf: []string{"esu_license_id", "software_assurance_customer_enabled"},
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"esu_eligibility": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"esu_key_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"esu_server_type": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.LicenseProfiles
+			machinesClient := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.Machines
+
+			var model ArcMachineLicenseProfileResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			machineId, err := machines.ParseMachineID(model.ArcMachineId)
+			if err != nil {
+				return err
+			}
+
+			// an Arc Machine has a single License Profile, which is always named `default`
+			id := parse.NewArcMachineLicenseProfileID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName, "default")
+			profileMachineId := licenseprofiles.NewMachineID(machineId.SubscriptionId, machineId.ResourceGroupName, machineId.MachineName)
+
+			existing, err := client.Get(ctx, profileMachineId)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			machine, err := machinesClient.Get(ctx, *machineId, machines.DefaultGetOperationOptions())
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *machineId, err)
+			}
+			if machine.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *machineId)
+			}
+
+			payload := licenseprofiles.LicenseProfile{
+				Location: machine.Model.Location,
+				Properties: &licenseprofiles.LicenseProfileProperties{
+					SoftwareAssurance: &licenseprofiles.LicenseProfilePropertiesSoftwareAssurance{
+						SoftwareAssuranceCustomer: pointer.To(model.SoftwareAssuranceCustomerEnabled),
+					},
+				},
+			}
+
+			if model.EsuLicenseId != "" {
+				payload.Properties.EsuProfile = &licenseprofiles.LicenseProfileArmEsuProperties{
+					AssignedLicense: pointer.To(model.EsuLicenseId),
+				}
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, profileMachineId, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.LicenseProfiles
+
+			id, err := parse.ArcMachineLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, licenseprofiles.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName))
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := ArcMachineLicenseProfileResourceModel{
+				ArcMachineId: machines.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					if esu := props.EsuProfile; esu != nil {
+						if esu.AssignedLicense != nil {
+							licenseId, err := licenses.ParseLicenseIDInsensitively(*esu.AssignedLicense)
+							if err != nil {
+								return err
+							}
+							state.EsuLicenseId = licenseId.ID()
+						}
+						state.EsuEligibility = string(pointer.From(esu.EsuEligibility))
+						state.EsuKeyState = string(pointer.From(esu.EsuKeyState))
+						state.EsuServerType = string(pointer.From(esu.ServerType))
+					}
+
+					if sa := props.SoftwareAssurance; sa != nil {
+						state.SoftwareAssuranceCustomerEnabled = pointer.From(sa.SoftwareAssuranceCustomer)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.LicenseProfiles
+
+			id, err := parse.ArcMachineLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model ArcMachineLicenseProfileResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := licenseprofiles.LicenseProfileUpdate{
+				Properties: &licenseprofiles.LicenseProfileUpdateProperties{},
+			}
+
+			if metadata.ResourceData.HasChange("esu_license_id") {
+				// an empty value unassigns the ESU License from the Arc Machine
+				payload.Properties.EsuProfile = &licenseprofiles.EsuProfileUpdateProperties{
+					AssignedLicense: pointer.To(model.EsuLicenseId),
+				}
+			}
+
+			if metadata.ResourceData.HasChange("software_assurance_customer_enabled") {
+				payload.Properties.SoftwareAssurance = &licenseprofiles.LicenseProfileUpdatePropertiesSoftwareAssurance{
+					SoftwareAssuranceCustomer: pointer.To(model.SoftwareAssuranceCustomerEnabled),
+				}
+			}
+
+			if err := client.UpdateThenPoll(ctx, licenseprofiles.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName), payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ArcMachineLicenseProfileResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.HybridCompute.HybridComputeClient_v2024_07_10.LicenseProfiles
+
+			id, err := parse.ArcMachineLicenseProfileID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, licenseprofiles.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName)); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package hybridcompute_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/hybridcompute/2024-07-10/licenseprofiles"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/hybridcompute/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ArcMachineLicenseProfileResource struct{}
+
+func TestAccArcMachineLicenseProfile_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license_profile", "test")
+	r := ArcMachineLicenseProfileResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccArcMachineLicenseProfile_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license_profile", "test")
+	r := ArcMachineLicenseProfileResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccArcMachineLicenseProfile_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_arc_machine_license_profile", "test")
+	r := ArcMachineLicenseProfileResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.esuLicense(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r ArcMachineLicenseProfileResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.ArcMachineLicenseProfileID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.HybridCompute.HybridComputeClient_v2024_07_10.LicenseProfiles.Get(ctx, licenseprofiles.NewMachineID(id.SubscriptionId, id.ResourceGroup, id.MachineName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ArcMachineLicenseProfileResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license_profile" "test" {
+  arc_machine_id                      = azurerm_arc_machine.test.id
+  software_assurance_customer_enabled = true
+}
+`, ArcMachineResource{}.basic(data))
+}
+
+func (r ArcMachineLicenseProfileResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_machine_license_profile" "import" {
+  arc_machine_id                      = azurerm_arc_machine_license_profile.test.arc_machine_id
+  software_assurance_customer_enabled = azurerm_arc_machine_license_profile.test.software_assurance_customer_enabled
+}
+`, r.basic(data))
+}
+
+func (r ArcMachineLicenseProfileResource) esuLicense(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_arc_license" "test" {
+  name                = "acctest-esu-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+  state               = "Deactivated"
+  target              = "Windows Server 2012"
+  edition             = "Standard"
+  core_type           = "pCore"
+  processors          = 16
+}
+
+resource "azurerm_arc_machine_license_profile" "test" {
+  arc_machine_id                      = azurerm_arc_machine.test.id
+  esu_license_id                      = azurerm_arc_license.test.id
+  software_assurance_customer_enabled = false
+}
+`, ArcMachineResource{}.basic(data), data.RandomInteger)
+}
@@ -0,0 +1,79 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type ArcMachineLicenseProfileId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	MachineName        string
+	LicenseProfileName string
+}
+
+func NewArcMachineLicenseProfileID(subscriptionId, resourceGroup, machineName, licenseProfileName string) ArcMachineLicenseProfileId {
+	return ArcMachineLicenseProfileId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		MachineName:        machineName,
+		LicenseProfileName: licenseProfileName,
+	}
+}
+
+func (id ArcMachineLicenseProfileId) String() string {
+	segments := []string{
+		fmt.Sprintf("License Profile Name %q", id.LicenseProfileName),
+		fmt.Sprintf("Machine Name %q", id.MachineName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Arc Machine License Profile", segmentsStr)
+}
+
+func (id ArcMachineLicenseProfileId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.HybridCompute/machines/%s/licenseProfiles/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.MachineName, id.LicenseProfileName)
+}
+
+// ArcMachineLicenseProfileID parses a ArcMachineLicenseProfile ID into an ArcMachineLicenseProfileId struct
+func ArcMachineLicenseProfileID(input string) (*ArcMachineLicenseProfileId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an ArcMachineLicenseProfile ID: %+v", input, err)
+	}
+
+	resourceId := ArcMachineLicenseProfileId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.MachineName, err = id.PopSegment("machines"); err != nil {
+		return nil, err
+	}
+	if resourceId.LicenseProfileName, err = id.PopSegment("licenseProfiles"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -52,7 +52,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		ArcMachineResource{},
+		ArcLicenseResource{},
 		ArcMachineExtensionResource{},
+		ArcMachineLicenseProfileResource{},
 		ArcPrivateLinkScopeResource{},
 	}
 }
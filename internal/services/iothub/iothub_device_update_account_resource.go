@@ -8,13 +8,16 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceupdate/2022-10-01/deviceupdates"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/deviceupdate/2023-07-01/deviceupdates"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/iothub/validate"
+	keyVaultValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/keyvault/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
@@ -24,13 +27,19 @@ type IotHubDeviceUpdateAccountResource struct{}
 var _ sdk.ResourceWithUpdate = IotHubDeviceUpdateAccountResource{}
 
 type IotHubDeviceUpdateAccountModel struct {
-	Name                       string            `tfschema:"name"`
-	ResourceGroupName          string            `tfschema:"resource_group_name"`
-	Location                   string            `tfschema:"location"`
-	HostName                   string            `tfschema:"host_name"`
-	PublicNetworkAccessEnabled bool              `tfschema:"public_network_access_enabled"`
-	Sku                        deviceupdates.SKU `tfschema:"sku"`
-	Tags                       map[string]string `tfschema:"tags"`
+	Name                       string                                             `tfschema:"name"`
+	ResourceGroupName          string                                             `tfschema:"resource_group_name"`
+	Location                   string                                             `tfschema:"location"`
+	CustomerManagedKey         []IotHubDeviceUpdateAccountCustomerManagedKeyModel `tfschema:"customer_managed_key"`
+	HostName                   string                                             `tfschema:"host_name"`
+	PublicNetworkAccessEnabled bool                                               `tfschema:"public_network_access_enabled"`
+	Sku                        deviceupdates.SKU                                  `tfschema:"sku"`
+	Tags                       map[string]string                                  `tfschema:"tags"`
+}
+
+type IotHubDeviceUpdateAccountCustomerManagedKeyModel struct {
+	KeyVaultKeyId          string `tfschema:"key_vault_key_id"`
+	UserAssignedIdentityId string `tfschema:"user_assigned_identity_id"`
 }
 
 func (r IotHubDeviceUpdateAccountResource) Arguments() map[string]*pluginsdk.Schema {
@@ -48,6 +57,30 @@ func (r IotHubDeviceUpdateAccountResource) Arguments() map[string]*pluginsdk.Sch
 
 		"identity": commonschema.SystemAssignedUserAssignedIdentityOptional(),
 
+		"customer_managed_key": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			ForceNew: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"key_vault_key_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: keyVaultValidate.NestedItemIdWithOptionalVersion,
+					},
+
+					"user_assigned_identity_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ForceNew:     true,
+						ValidateFunc: commonids.ValidateUserAssignedIdentityID,
+					},
+				},
+			},
+		},
+
 		"public_network_access_enabled": {
 			Type:     pluginsdk.TypeBool,
 			Optional: true,
@@ -125,6 +158,7 @@ func (r IotHubDeviceUpdateAccountResource) Create() sdk.ResourceFunc {
 				Location: location.Normalize(model.Location),
 				Identity: identityValue,
 				Properties: &deviceupdates.AccountProperties{
+					Encryption:          expandIotHubDeviceUpdateAccountCustomerManagedKey(model.CustomerManagedKey),
 					PublicNetworkAccess: &publicNetworkAccess,
 					Sku:                 &model.Sku,
 				},
@@ -197,6 +231,8 @@ func (r IotHubDeviceUpdateAccountResource) Read() sdk.ResourceFunc {
 					sku = *properties.Sku
 				}
 				state.Sku = sku
+
+				state.CustomerManagedKey = flattenIotHubDeviceUpdateAccountCustomerManagedKey(properties.Encryption)
 			}
 
 			if model.Tags != nil {
@@ -286,3 +322,32 @@ func (r IotHubDeviceUpdateAccountResource) Delete() sdk.ResourceFunc {
 		},
 	}
 }
+
+func expandIotHubDeviceUpdateAccountCustomerManagedKey(input []IotHubDeviceUpdateAccountCustomerManagedKeyModel) *deviceupdates.Encryption {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &deviceupdates.Encryption{
+		KeyVaultKeyUri:       pointer.To(input[0].KeyVaultKeyId),
+		UserAssignedIdentity: pointer.To(input[0].UserAssignedIdentityId),
+	}
+}
+
+func flattenIotHubDeviceUpdateAccountCustomerManagedKey(input *deviceupdates.Encryption) []IotHubDeviceUpdateAccountCustomerManagedKeyModel {
+	if input == nil || input.KeyVaultKeyUri == nil {
+		return []IotHubDeviceUpdateAccountCustomerManagedKeyModel{}
+	}
+
+	userAssignedIdentityId := pointer.From(input.UserAssignedIdentity)
+	if parsed, err := commonids.ParseUserAssignedIdentityIDInsensitively(userAssignedIdentityId); err == nil {
+		userAssignedIdentityId = parsed.ID()
+	}
+
+	return []IotHubDeviceUpdateAccountCustomerManagedKeyModel{
+		{
+			KeyVaultKeyId:          pointer.From(input.KeyVaultKeyUri),
+			UserAssignedIdentityId: userAssignedIdentityId,
+		},
+	}
+}
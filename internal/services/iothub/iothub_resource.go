@@ -669,17 +669,24 @@ func resourceIotHubCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
+	useSeparateRoutingResources := meta.(*clients.Client).Features.IoTHub.UseSeparateRoutingResources
+	if useSeparateRoutingResources {
+		if err := validateIoTHubInlineRoutingNotConfigured(d); err != nil {
+			return err
+		}
+	}
+
 	routingProperties := devices.RoutingProperties{}
 
-	if _, ok := d.GetOk("route"); ok {
+	if _, ok := d.GetOk("route"); ok && !useSeparateRoutingResources {
 		routingProperties.Routes = expandIoTHubRoutes(d)
 	}
 
-	if _, ok := d.GetOk("enrichment"); ok {
+	if _, ok := d.GetOk("enrichment"); ok && !useSeparateRoutingResources {
 		routingProperties.Enrichments = expandIoTHubEnrichments(d)
 	}
 
-	if _, ok := d.GetOk("fallback_route"); ok {
+	if _, ok := d.GetOk("fallback_route"); ok && !useSeparateRoutingResources {
 		routingProperties.FallbackRoute = expandIoTHubFallbackRoute(d)
 	} else {
 		routingProperties.FallbackRoute = &devices.FallbackRouteProperties{
@@ -818,21 +825,30 @@ func resourceIotHubUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		iothub.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
 
-	if d.HasChange("route") {
+	// when message routing is managed using the separate routing resources, the routing of the existing IoT Hub is
+	// sent back as-is so that it isn't overwritten
+	useSeparateRoutingResources := meta.(*clients.Client).Features.IoTHub.UseSeparateRoutingResources
+	if useSeparateRoutingResources {
+		if err := validateIoTHubInlineRoutingNotConfigured(d); err != nil {
+			return err
+		}
+	}
+
+	if d.HasChange("route") && !useSeparateRoutingResources {
 		if prop.Routing == nil {
 			prop.Routing = &devices.RoutingProperties{}
 		}
 		prop.Routing.Routes = expandIoTHubRoutes(d)
 	}
 
-	if d.HasChange("enrichment") {
+	if d.HasChange("enrichment") && !useSeparateRoutingResources {
 		if prop.Routing == nil {
 			prop.Routing = &devices.RoutingProperties{}
 		}
 		prop.Routing.Enrichments = expandIoTHubEnrichments(d)
 	}
 
-	if d.HasChange("fallback_route") {
+	if d.HasChange("fallback_route") && !useSeparateRoutingResources {
 		if prop.Routing == nil {
 			prop.Routing = &devices.RoutingProperties{}
 		}
@@ -1036,19 +1052,21 @@ func resourceIotHubRead(d *pluginsdk.ResourceData, meta interface{}) error {
 			return fmt.Errorf("setting `endpoint` in IoTHub %q: %+v", id.Name, err)
 		}
 
-		routes := flattenIoTHubRoute(properties.Routing)
-		if err := d.Set("route", routes); err != nil {
-			return fmt.Errorf("setting `route` in IoTHub %q: %+v", id.Name, err)
-		}
+		if !meta.(*clients.Client).Features.IoTHub.UseSeparateRoutingResources {
+			routes := flattenIoTHubRoute(properties.Routing)
+			if err := d.Set("route", routes); err != nil {
+				return fmt.Errorf("setting `route` in IoTHub %q: %+v", id.Name, err)
+			}
 
-		enrichments := flattenIoTHubEnrichment(properties.Routing)
-		if err := d.Set("enrichment", enrichments); err != nil {
-			return fmt.Errorf("setting `enrichment` in IoTHub %q: %+v", id.Name, err)
-		}
+			enrichments := flattenIoTHubEnrichment(properties.Routing)
+			if err := d.Set("enrichment", enrichments); err != nil {
+				return fmt.Errorf("setting `enrichment` in IoTHub %q: %+v", id.Name, err)
+			}
 
-		fallbackRoute := flattenIoTHubFallbackRoute(properties.Routing)
-		if err := d.Set("fallback_route", fallbackRoute); err != nil {
-			return fmt.Errorf("setting `fallbackRoute` in IoTHub %q: %+v", id.Name, err)
+			fallbackRoute := flattenIoTHubFallbackRoute(properties.Routing)
+			if err := d.Set("fallback_route", fallbackRoute); err != nil {
+				return fmt.Errorf("setting `fallbackRoute` in IoTHub %q: %+v", id.Name, err)
+			}
 		}
 
 		networkRuleSet := flattenNetworkRuleSetProperties(properties.NetworkRuleSets)
@@ -1124,6 +1142,20 @@ func resourceIotHubDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// validateIoTHubInlineRoutingNotConfigured ensures that the inline routing blocks aren't used when the provider is
+// configured to manage message routing using the `azurerm_iothub_route`, `azurerm_iothub_fallback_route` and
+// `azurerm_iothub_enrichment` resources
+func validateIoTHubInlineRoutingNotConfigured(d *pluginsdk.ResourceData) error {
+	config := d.GetRawConfig().AsValueMap()
+	for _, key := range []string{"route", "enrichment", "fallback_route"} {
+		if v, ok := config[key]; ok && !v.IsNull() && v.IsKnown() && v.LengthInt() > 0 {
+			return fmt.Errorf("`%s` cannot be specified when `use_separate_routing_resources` is enabled in the `iothub` features block", key)
+		}
+	}
+
+	return nil
+}
+
 func expandIoTHubRoutes(d *pluginsdk.ResourceData) *[]devices.RouteProperties {
 	routeList := d.Get("route").([]interface{})
 
@@ -73,6 +73,29 @@ func TestAccIotHubRoute_update(t *testing.T) {
 	})
 }
 
+func TestAccIotHubRoute_separateRoutingResources(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_iothub_route", "test")
+	r := IotHubRouteResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.separateRoutingResources(data, "testing"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			// updating the IoT Hub mustn't remove the route
+			Config: r.separateRoutingResources(data, "updated"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t IotHubRouteResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := parse.RouteID(state.ID)
 	if err != nil {
@@ -245,3 +268,82 @@ resource "azurerm_iothub_route" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary, data.RandomString)
 }
+
+func (IotHubRouteResource) separateRoutingResources(data acceptance.TestData, purpose string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    iothub {
+      use_separate_routing_resources = true
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-iothub-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_storage_container" "test" {
+  name                  = "test%[1]d"
+  storage_account_name  = azurerm_storage_account.test.name
+  container_access_type = "private"
+}
+
+resource "azurerm_iothub" "test" {
+  name                = "acctestIoTHub%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  sku {
+    name     = "S1"
+    capacity = "1"
+  }
+
+  tags = {
+    purpose = "%[4]s"
+  }
+}
+
+resource "azurerm_iothub_endpoint_storage_container" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_id           = azurerm_iothub.test.id
+  name                = "acctest"
+
+  connection_string          = azurerm_storage_account.test.primary_blob_connection_string
+  batch_frequency_in_seconds = 60
+  max_chunk_size_in_bytes    = 10485760
+  container_name             = azurerm_storage_container.test.name
+  encoding                   = "Avro"
+  file_name_format           = "{iothub}/{partition}_{YYYY}_{MM}_{DD}_{HH}_{mm}"
+}
+
+resource "azurerm_iothub_route" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+  name                = "acctest"
+
+  source         = "DeviceMessages"
+  condition      = "true"
+  endpoint_names = [azurerm_iothub_endpoint_storage_container.test.name]
+  enabled        = true
+}
+
+resource "azurerm_iothub_fallback_route" "test" {
+  resource_group_name = azurerm_resource_group.test.name
+  iothub_name         = azurerm_iothub.test.name
+
+  condition      = "true"
+  endpoint_names = [azurerm_iothub_endpoint_storage_container.test.name]
+  enabled        = true
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, purpose)
+}
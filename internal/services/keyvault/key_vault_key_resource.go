@@ -203,6 +203,43 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 				},
 			},
 
+			"exportable": {
+				Type:         pluginsdk.TypeBool,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      false,
+				RequiredWith: []string{"release_policy"},
+			},
+
+			"release_policy": {
+				Type:     pluginsdk.TypeList,
+				Optional: true,
+				MaxItems: 1,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"policy": {
+							Type:             pluginsdk.TypeString,
+							Required:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+						},
+
+						"content_type": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "application/json; charset=utf-8",
+							ValidateFunc: validation.StringIsNotEmpty,
+						},
+
+						"immutable": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
+
 			// Computed
 			"version": {
 				Type:     pluginsdk.TypeString,
@@ -272,6 +309,20 @@ func resourceKeyVaultKey() *pluginsdk.Resource {
 
 				return false
 			}),
+			// a release policy can't be removed from a key, nor can an immutable release policy be changed
+			pluginsdk.ForceNewIfChange("release_policy", func(ctx context.Context, old, new, meta interface{}) bool {
+				oldPolicies := old.([]interface{})
+				if len(oldPolicies) == 0 || oldPolicies[0] == nil {
+					return false
+				}
+
+				newPolicies := new.([]interface{})
+				if len(newPolicies) == 0 || newPolicies[0] == nil {
+					return true
+				}
+
+				return oldPolicies[0].(map[string]interface{})["immutable"].(bool)
+			}),
 		),
 	}
 }
@@ -315,8 +366,10 @@ func resourceKeyVaultKeyCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		Kty:    keyvault.JSONWebKeyType(keyType),
 		KeyOps: keyOptions,
 		KeyAttributes: &keyvault.KeyAttributes{
-			Enabled: utils.Bool(true),
+			Enabled:    utils.Bool(true),
+			Exportable: pointer.To(d.Get("exportable").(bool)),
 		},
+		ReleasePolicy: expandKeyVaultKeyReleasePolicy(d.Get("release_policy").([]interface{})),
 
 		Tags: tags.Expand(t),
 	}
@@ -440,6 +493,10 @@ func resourceKeyVaultKeyUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 		Tags: tags.Expand(t),
 	}
 
+	if d.HasChange("release_policy") {
+		parameters.ReleasePolicy = expandKeyVaultKeyReleasePolicy(d.Get("release_policy").([]interface{}))
+	}
+
 	if v, ok := d.GetOk("not_before_date"); ok {
 		notBeforeDate, _ := time.Parse(time.RFC3339, v.(string)) // validated by schema
 		notBeforeUnixTime := date.UnixTime(notBeforeDate)
@@ -549,6 +606,16 @@ func resourceKeyVaultKeyRead(d *pluginsdk.ResourceData, meta interface{}) error
 		if v := attributes.Expires; v != nil {
 			d.Set("expiration_date", time.Time(*v).Format(time.RFC3339))
 		}
+
+		d.Set("exportable", pointer.From(attributes.Exportable))
+	}
+
+	releasePolicy, err := flattenKeyVaultKeyReleasePolicy(resp.ReleasePolicy)
+	if err != nil {
+		return err
+	}
+	if err := d.Set("release_policy", releasePolicy); err != nil {
+		return fmt.Errorf("setting `release_policy`: %+v", err)
 	}
 
 	// Computed
@@ -774,6 +841,20 @@ func expandKeyVaultKeyRotationPolicy(v []interface{}) keyvault.KeyRotationPolicy
 	}
 }
 
+func expandKeyVaultKeyReleasePolicy(input []interface{}) *keyvault.KeyReleasePolicy {
+	if len(input) == 0 || input[0] == nil {
+		return nil
+	}
+
+	policy := input[0].(map[string]interface{})
+
+	return &keyvault.KeyReleasePolicy{
+		ContentType:   pointer.To(policy["content_type"].(string)),
+		Immutable:     pointer.To(policy["immutable"].(bool)),
+		EncodedPolicy: pointer.To(base64.RawURLEncoding.EncodeToString([]byte(policy["policy"].(string)))),
+	}
+}
+
 func flattenKeyVaultKeyOptions(input *[]string) []interface{} {
 	results := make([]interface{}, 0, len(*input))
 
@@ -823,6 +904,26 @@ func flattenKeyVaultKeyRotationPolicy(input keyvault.KeyRotationPolicy) []interf
 	return []interface{}{policy}
 }
 
+func flattenKeyVaultKeyReleasePolicy(input *keyvault.KeyReleasePolicy) ([]interface{}, error) {
+	if input == nil || input.EncodedPolicy == nil {
+		return []interface{}{}, nil
+	}
+
+	// the policy is returned base64url encoded, however padding isn't consistently included
+	policy, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(*input.EncodedPolicy, "="))
+	if err != nil {
+		return nil, fmt.Errorf("decoding `release_policy.0.policy`: %+v", err)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"content_type": pointer.From(input.ContentType),
+			"immutable":    pointer.From(input.Immutable),
+			"policy":       string(policy),
+		},
+	}, nil
+}
+
 // Credit to Hashicorp modified from https://github.com/hashicorp/terraform-provider-tls/blob/v3.1.0/internal/provider/util.go#L79-L105
 func readPublicKey(d *pluginsdk.ResourceData, pubKey interface{}) error {
 	pubKeyBytes, err := x509.MarshalPKIXPublicKey(pubKey)
@@ -137,6 +137,29 @@ func TestAccKeyVaultKey_complete(t *testing.T) {
 	})
 }
 
+func TestAccKeyVaultKey_releasePolicy(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
+	r := KeyVaultKeyResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.releasePolicy(data, "sevsnpvm"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("exportable").HasValue("true"),
+			),
+		},
+		data.ImportStep("key_size", "key_vault_id"),
+		{
+			Config: r.releasePolicy(data, "sgx"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("key_size", "key_vault_id"),
+	})
+}
+
 func TestAccKeyVaultKey_updateExpirationDate(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_key", "test")
 	r := KeyVaultKeyResource{}
@@ -652,6 +675,50 @@ resource "azurerm_key_vault_key" "test" {
 `, r.templatePremium(data), data.RandomString)
 }
 
+func (r KeyVaultKeyResource) releasePolicy(data acceptance.TestData, attestationType string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_key" "test" {
+  name         = "key-%s"
+  key_vault_id = azurerm_key_vault.test.id
+  key_type     = "RSA-HSM"
+  key_size     = 2048
+  exportable   = true
+
+  key_opts = [
+    "decrypt",
+    "encrypt",
+    "sign",
+    "unwrapKey",
+    "verify",
+    "wrapKey",
+  ]
+
+  release_policy {
+    policy = jsonencode({
+      version = "1.0.0"
+      anyOf = [
+        {
+          authority = "https://sharedeus.eus.attest.azure.net"
+          allOf = [
+            {
+              claim  = "x-ms-attestation-type"
+              equals = "%s"
+            }
+          ]
+        }
+      ]
+    })
+  }
+}
+`, r.templatePremium(data), data.RandomString, attestationType)
+}
+
 func (r KeyVaultKeyResource) complete(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
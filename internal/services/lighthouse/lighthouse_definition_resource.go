@@ -4,8 +4,10 @@
 package lighthouse
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -138,7 +140,7 @@ func resourceLighthouseDefinition() *pluginsdk.Resource {
 										Type:         pluginsdk.TypeString,
 										Optional:     true,
 										Default:      "PT8H",
-										ValidateFunc: azValidate.ISO8601Duration,
+										ValidateFunc: azValidate.ISO8601DurationBetween("PT30M", "PT8H"),
 									},
 
 									"approver": {
@@ -214,7 +216,81 @@ func resourceLighthouseDefinition() *pluginsdk.Resource {
 				},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+				return validateLighthouseDefinitionAuthorizations(diff.Get("authorization").(*pluginsdk.Set).List(), diff.Get("eligible_authorization").(*pluginsdk.Set).List())
+			},
+		),
+	}
+}
+
+const (
+	lighthouseOwnerRoleDefinitionId                   = "8e3af657-a8ff-443c-a75c-2fe8c4bcb635"
+	lighthouseUserAccessAdministratorRoleDefinitionId = "18d7d88d-d35e-4fb5-a5c3-7773c20a72d9"
+)
+
+// validateLighthouseDefinitionAuthorizations checks the role definitions against the restrictions Azure Lighthouse
+// places on delegation, which the API otherwise only surfaces once the definition is being created
+func validateLighthouseDefinitionAuthorizations(authorizations []interface{}, eligibleAuthorizations []interface{}) error {
+	for _, item := range authorizations {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+		roleDefinitionId := v["role_definition_id"].(string)
+		delegatedRoleDefinitionIds := v["delegated_role_definition_ids"].(*pluginsdk.Set).List()
+
+		// an empty `role_definition_id` is unknown at plan time
+		if roleDefinitionId == "" {
+			continue
+		}
+
+		if strings.EqualFold(roleDefinitionId, lighthouseOwnerRoleDefinitionId) {
+			return fmt.Errorf("the Owner role (%q) can't be delegated using Azure Lighthouse", roleDefinitionId)
+		}
+
+		if !strings.EqualFold(roleDefinitionId, lighthouseUserAccessAdministratorRoleDefinitionId) {
+			if len(delegatedRoleDefinitionIds) > 0 {
+				return fmt.Errorf("`delegated_role_definition_ids` can only be specified when `role_definition_id` is the User Access Administrator role (%q)", lighthouseUserAccessAdministratorRoleDefinitionId)
+			}
+			continue
+		}
+
+		for _, delegatedRoleDefinitionId := range delegatedRoleDefinitionIds {
+			if strings.EqualFold(delegatedRoleDefinitionId.(string), lighthouseOwnerRoleDefinitionId) || strings.EqualFold(delegatedRoleDefinitionId.(string), lighthouseUserAccessAdministratorRoleDefinitionId) {
+				return fmt.Errorf("the role %q can't be assigned by the User Access Administrator role using `delegated_role_definition_ids`", delegatedRoleDefinitionId.(string))
+			}
+		}
+	}
+
+	for _, item := range eligibleAuthorizations {
+		if item == nil {
+			continue
+		}
+		v := item.(map[string]interface{})
+		principalId := v["principal_id"].(string)
+		roleDefinitionId := v["role_definition_id"].(string)
+
+		if strings.EqualFold(roleDefinitionId, lighthouseOwnerRoleDefinitionId) || strings.EqualFold(roleDefinitionId, lighthouseUserAccessAdministratorRoleDefinitionId) {
+			return fmt.Errorf("the role %q can't be used for an `eligible_authorization`", roleDefinitionId)
+		}
+
+		policies := v["just_in_time_access_policy"].([]interface{})
+		if len(policies) == 0 || policies[0] == nil {
+			continue
+		}
+		policy := policies[0].(map[string]interface{})
+
+		for _, approver := range policy["approver"].(*pluginsdk.Set).List() {
+			// an empty `principal_id` is unknown at plan time
+			if approverPrincipalId := approver.(map[string]interface{})["principal_id"].(string); approverPrincipalId != "" && strings.EqualFold(approverPrincipalId, principalId) {
+				return fmt.Errorf("the principal %q of an `eligible_authorization` can't approve its own activation requests", principalId)
+			}
+		}
 	}
+
+	return nil
 }
 
 func resourceLighthouseDefinitionCreateUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
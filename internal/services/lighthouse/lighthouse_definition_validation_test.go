@@ -0,0 +1,93 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lighthouse
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+func TestValidateLighthouseDefinitionAuthorizations(t *testing.T) {
+	const (
+		contributor = "b24988ac-6180-42a0-ab88-20f7382dd24c"
+		principalId = "11111111-1111-1111-1111-111111111111"
+	)
+
+	authorization := func(roleDefinitionId string, delegatedRoleDefinitionIds ...interface{}) interface{} {
+		return map[string]interface{}{
+			"principal_id":                  principalId,
+			"role_definition_id":            roleDefinitionId,
+			"delegated_role_definition_ids": pluginsdk.NewSet(pluginsdk.HashString, delegatedRoleDefinitionIds),
+		}
+	}
+	eligibleAuthorization := func(roleDefinitionId string, approverPrincipalId string) interface{} {
+		approvers := pluginsdk.NewSet(func(v interface{}) int {
+			return pluginsdk.HashString(v.(map[string]interface{})["principal_id"])
+		}, nil)
+		if approverPrincipalId != "" {
+			approvers.Add(map[string]interface{}{
+				"principal_id": approverPrincipalId,
+			})
+		}
+
+		return map[string]interface{}{
+			"principal_id":       principalId,
+			"role_definition_id": roleDefinitionId,
+			"just_in_time_access_policy": []interface{}{
+				map[string]interface{}{
+					"approver": approvers,
+				},
+			},
+		}
+	}
+
+	cases := []struct {
+		Name                   string
+		Authorizations         []interface{}
+		EligibleAuthorizations []interface{}
+		Errors                 bool
+	}{
+		{
+			Name:                   "valid",
+			Authorizations:         []interface{}{authorization(contributor), authorization(lighthouseUserAccessAdministratorRoleDefinitionId, contributor)},
+			EligibleAuthorizations: []interface{}{eligibleAuthorization(contributor, "22222222-2222-2222-2222-222222222222")},
+			Errors:                 false,
+		},
+		{
+			Name:           "owner",
+			Authorizations: []interface{}{authorization(lighthouseOwnerRoleDefinitionId)},
+			Errors:         true,
+		},
+		{
+			Name:           "delegated roles without user access administrator",
+			Authorizations: []interface{}{authorization(contributor, contributor)},
+			Errors:         true,
+		},
+		{
+			Name:           "delegating owner",
+			Authorizations: []interface{}{authorization(lighthouseUserAccessAdministratorRoleDefinitionId, lighthouseOwnerRoleDefinitionId)},
+			Errors:         true,
+		},
+		{
+			Name:                   "eligible user access administrator",
+			EligibleAuthorizations: []interface{}{eligibleAuthorization(lighthouseUserAccessAdministratorRoleDefinitionId, "")},
+			Errors:                 true,
+		},
+		{
+			Name:                   "self approval",
+			EligibleAuthorizations: []interface{}{eligibleAuthorization(contributor, principalId)},
+			Errors:                 true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.Name, func(t *testing.T) {
+			err := validateLighthouseDefinitionAuthorizations(tc.Authorizations, tc.EligibleAuthorizations)
+			if (err != nil) != tc.Errors {
+				t.Fatalf("expected an error (%t) but got: %+v", tc.Errors, err)
+			}
+		})
+	}
+}
@@ -32,6 +32,7 @@ type BackendAddressPoolAddressModel struct {
 	VirtualNetworkId        string                      `tfschema:"virtual_network_id"`
 	IPAddress               string                      `tfschema:"ip_address"`
 	FrontendIPConfiguration string                      `tfschema:"backend_address_ip_configuration_id"`
+	AdminState              string                      `tfschema:"admin_state"`
 	PortMapping             []inboundNATRulePortMapping `tfschema:"inbound_nat_rule_port_mapping"`
 }
 
@@ -100,6 +101,13 @@ func (r BackendAddressPoolAddressResource) Arguments() map[string]*pluginsdk.Sch
 			ValidateFunc:  loadbalancers.ValidateFrontendIPConfigurationID,
 			Description:   "For global load balancer, user needs to specify the `backend_address_ip_configuration_id` of the added regional load balancers",
 		},
+
+		"admin_state": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Default:      string(loadbalancers.LoadBalancerBackendAddressAdminStateNone),
+			ValidateFunc: validation.StringInSlice(loadbalancers.PossibleValuesForLoadBalancerBackendAddressAdminState(), false),
+		},
 	}
 }
 
@@ -204,8 +212,10 @@ func (r BackendAddressPoolAddressResource) Create() sdk.ResourceFunc {
 					})
 				} else {
 					address := loadbalancers.LoadBalancerBackendAddress{
-						Properties: &loadbalancers.LoadBalancerBackendAddressPropertiesFormat{},
-						Name:       pointer.To(model.Name),
+						Properties: &loadbalancers.LoadBalancerBackendAddressPropertiesFormat{
+							AdminState: pointer.To(loadbalancers.LoadBalancerBackendAddressAdminState(model.AdminState)),
+						},
+						Name: pointer.To(model.Name),
 					}
 					if model.IPAddress != "" {
 						address.Properties.IPAddress = pointer.To(model.IPAddress)
@@ -287,6 +297,7 @@ func (r BackendAddressPoolAddressResource) Read() sdk.ResourceFunc {
 			model := BackendAddressPoolAddressModel{
 				Name:                 id.AddressName,
 				BackendAddressPoolId: poolId.ID(),
+				AdminState:           string(loadbalancers.LoadBalancerBackendAddressAdminStateNone),
 			}
 
 			if props := backendAddress.Properties; props != nil {
@@ -302,6 +313,10 @@ func (r BackendAddressPoolAddressResource) Read() sdk.ResourceFunc {
 					if props.VirtualNetwork != nil && props.VirtualNetwork.Id != nil {
 						model.VirtualNetworkId = *props.VirtualNetwork.Id
 					}
+
+					if props.AdminState != nil {
+						model.AdminState = string(*props.AdminState)
+					}
 				}
 				var inboundNATRulePortMappingList []inboundNATRulePortMapping
 				if rules := props.InboundNatRulesPortMapping; rules != nil {
@@ -473,6 +488,7 @@ func (r BackendAddressPoolAddressResource) Update() sdk.ResourceFunc {
 						VirtualNetwork: &loadbalancers.SubResource{
 							Id: pointer.To(model.VirtualNetworkId),
 						},
+						AdminState: pointer.To(loadbalancers.LoadBalancerBackendAddressAdminState(model.AdminState)),
 					},
 					Name: pointer.To(id.AddressName),
 				}
@@ -0,0 +1,295 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logic
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type LogicAppStandardWorkflowResource struct{}
+
+type LogicAppStandardWorkflowModel struct {
+	Name          string `tfschema:"name"`
+	LogicAppId    string `tfschema:"logic_app_id"`
+	Source        string `tfschema:"source"`
+	SourceContent string `tfschema:"source_content"`
+	ContentSHA256 string `tfschema:"content_sha256"`
+}
+
+var _ sdk.ResourceWithUpdate = LogicAppStandardWorkflowResource{}
+
+func (r LogicAppStandardWorkflowResource) ModelObject() interface{} {
+	return &LogicAppStandardWorkflowModel{}
+}
+
+func (r LogicAppStandardWorkflowResource) ResourceType() string {
+	return "azurerm_logic_app_standard_workflow"
+}
+
+func (r LogicAppStandardWorkflowResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.LogicAppStandardWorkflowID
+}
+
+func (r LogicAppStandardWorkflowResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9_-]{0,42}$`),
+				"`name` must be between 1 and 43 characters, start with a letter or number and may only contain letters, numbers, underscores and hyphens",
+			),
+		},
+
+		"logic_app_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateLogicAppId,
+		},
+
+		"source": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"source", "source_content"},
+		},
+
+		"source_content": {
+			Type:             pluginsdk.TypeString,
+			Optional:         true,
+			ValidateFunc:     validation.StringIsJSON,
+			DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+			ExactlyOneOf:     []string{"source", "source_content"},
+		},
+
+		"content_sha256": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringMatch(regexp.MustCompile(`^[a-fA-F0-9]{64}$`), "`content_sha256` must be a hex encoded SHA256 hash"),
+		},
+	}
+}
+
+func (r LogicAppStandardWorkflowResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r LogicAppStandardWorkflowResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			var config LogicAppStandardWorkflowModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			logicAppId, err := commonids.ParseLogicAppId(config.LogicAppId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewLogicAppStandardWorkflowID(logicAppId.SubscriptionId, logicAppId.ResourceGroupName, logicAppId.SiteName, config.Name)
+
+			locks.ByID(logicAppId.ID())
+			defer locks.UnlockByID(logicAppId.ID())
+
+			vfsClient, err := helpers.NewKuduVFSClientForSite(ctx, client, *logicAppId)
+			if err != nil {
+				return fmt.Errorf("building file system client for %s: %+v", id, err)
+			}
+
+			existing, err := vfsClient.GetFile(ctx, logicAppStandardWorkflowFilePath(id))
+			if err != nil {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if existing != nil {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			content, err := expandLogicAppStandardWorkflowContent(config, !metadata.ResourceData.GetRawConfig().AsValueMap()["content_sha256"].IsNull())
+			if err != nil {
+				return err
+			}
+
+			if err := vfsClient.PutFile(ctx, logicAppStandardWorkflowFilePath(id), content); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r LogicAppStandardWorkflowResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.LogicAppStandardWorkflowID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			logicAppId := commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+
+			vfsClient, err := helpers.NewKuduVFSClientForSite(ctx, client, logicAppId)
+			if err != nil {
+				return fmt.Errorf("building file system client for %s: %+v", id, err)
+			}
+
+			existing, err := vfsClient.GetFile(ctx, logicAppStandardWorkflowFilePath(*id))
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := LogicAppStandardWorkflowModel{
+				Name:          id.WorkflowName,
+				LogicAppId:    logicAppId.ID(),
+				ContentSHA256: logicAppStandardWorkflowContentHash(*existing),
+			}
+
+			// `source` is a local file path which can't be read back, so the deployed content is only compared via
+			// `content_sha256` - any changes made outside of Terraform will show up as a diff on that field
+			if v, ok := metadata.ResourceData.GetOk("source"); ok {
+				state.Source = v.(string)
+			} else {
+				state.SourceContent = string(*existing)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r LogicAppStandardWorkflowResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.LogicAppStandardWorkflowID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config LogicAppStandardWorkflowModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if !metadata.ResourceData.HasChanges("source", "source_content", "content_sha256") {
+				return nil
+			}
+
+			logicAppId := commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+
+			locks.ByID(logicAppId.ID())
+			defer locks.UnlockByID(logicAppId.ID())
+
+			vfsClient, err := helpers.NewKuduVFSClientForSite(ctx, client, logicAppId)
+			if err != nil {
+				return fmt.Errorf("building file system client for %s: %+v", id, err)
+			}
+
+			content, err := expandLogicAppStandardWorkflowContent(config, !metadata.ResourceData.GetRawConfig().AsValueMap()["content_sha256"].IsNull())
+			if err != nil {
+				return err
+			}
+
+			if err := vfsClient.PutFile(ctx, logicAppStandardWorkflowFilePath(*id), content); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r LogicAppStandardWorkflowResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.AppService.WebAppsClient
+
+			id, err := parse.LogicAppStandardWorkflowID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			logicAppId := commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroup, id.SiteName)
+
+			locks.ByID(logicAppId.ID())
+			defer locks.UnlockByID(logicAppId.ID())
+
+			vfsClient, err := helpers.NewKuduVFSClientForSite(ctx, client, logicAppId)
+			if err != nil {
+				return fmt.Errorf("building file system client for %s: %+v", id, err)
+			}
+
+			if err := vfsClient.DeleteDirectory(ctx, id.WorkflowName); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// logicAppStandardWorkflowFilePath returns the path, relative to `site/wwwroot`, of the definition file which the
+// Logic App Standard runtime loads for the specified Workflow.
+func logicAppStandardWorkflowFilePath(id parse.LogicAppStandardWorkflowId) string {
+	return fmt.Sprintf("%s/workflow.json", id.WorkflowName)
+}
+
+func logicAppStandardWorkflowContentHash(input []byte) string {
+	hash := sha256.Sum256(input)
+	return hex.EncodeToString(hash[:])
+}
+
+func expandLogicAppStandardWorkflowContent(input LogicAppStandardWorkflowModel, validateHash bool) ([]byte, error) {
+	content := []byte(input.SourceContent)
+	if input.Source != "" {
+		fileContent, err := os.ReadFile(input.Source)
+		if err != nil {
+			return nil, fmt.Errorf("reading `source` file %q: %+v", input.Source, err)
+		}
+		content = fileContent
+	}
+
+	// when a hash has been specified we validate it matches the content being deployed, so that a stale
+	// `content_sha256` can't mask changes to the file on disk
+	if validateHash {
+		if actual := logicAppStandardWorkflowContentHash(content); !strings.EqualFold(actual, input.ContentSHA256) {
+			return nil, fmt.Errorf("the SHA256 hash of the workflow content (%s) does not match `content_sha256` (%s)", actual, input.ContentSHA256)
+		}
+	}
+
+	return content, nil
+}
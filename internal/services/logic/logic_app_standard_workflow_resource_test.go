@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logic_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/appservice/helpers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/logic/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type LogicAppStandardWorkflowResource struct{}
+
+func TestAccLogicAppStandardWorkflow_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_workflow", "test")
+	r := LogicAppStandardWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("content_sha256").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccLogicAppStandardWorkflow_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_workflow", "test")
+	r := LogicAppStandardWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccLogicAppStandardWorkflow_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_logic_app_standard_workflow", "test")
+	r := LogicAppStandardWorkflowResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.stateless(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r LogicAppStandardWorkflowResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.LogicAppStandardWorkflowID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	vfsClient, err := helpers.NewKuduVFSClientForSite(ctx, client.AppService.WebAppsClient, commonids.NewAppServiceID(id.SubscriptionId, id.ResourceGroup, id.SiteName))
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := vfsClient.GetFile(ctx, fmt.Sprintf("%s/workflow.json", id.WorkflowName))
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(existing != nil), nil
+}
+
+func (r LogicAppStandardWorkflowResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_workflow" "test" {
+  name         = "acctest-workflow"
+  logic_app_id = azurerm_logic_app_standard.test.id
+  source_content = jsonencode({
+    definition = {
+      "$schema"      = "https://schema.management.azure.com/providers/Microsoft.Logic/schemas/2016-06-01/workflowdefinition.json#"
+      actions        = {}
+      contentVersion = "1.0.0.0"
+      outputs        = {}
+      triggers       = {}
+    }
+    kind = "Stateful"
+  })
+}
+`, r.template(data))
+}
+
+func (r LogicAppStandardWorkflowResource) stateless(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_workflow" "test" {
+  name         = "acctest-workflow"
+  logic_app_id = azurerm_logic_app_standard.test.id
+  source_content = jsonencode({
+    definition = {
+      "$schema"      = "https://schema.management.azure.com/providers/Microsoft.Logic/schemas/2016-06-01/workflowdefinition.json#"
+      actions        = {}
+      contentVersion = "1.0.0.0"
+      outputs        = {}
+      triggers       = {}
+    }
+    kind = "Stateless"
+  })
+}
+`, r.template(data))
+}
+
+func (r LogicAppStandardWorkflowResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_logic_app_standard_workflow" "import" {
+  name           = azurerm_logic_app_standard_workflow.test.name
+  logic_app_id   = azurerm_logic_app_standard_workflow.test.logic_app_id
+  source_content = azurerm_logic_app_standard_workflow.test.source_content
+}
+`, r.basic(data))
+}
+
+func (r LogicAppStandardWorkflowResource) template(data acceptance.TestData) string {
+	return LogicAppStandardResource{}.basic(data)
+}
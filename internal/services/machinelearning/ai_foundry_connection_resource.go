@@ -0,0 +1,331 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/v2workspaceconnectionresource"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AIFoundryConnection struct{}
+
+type AIFoundryConnectionModel struct {
+	Name        string            `tfschema:"name"`
+	WorkspaceId string            `tfschema:"workspace_id"`
+	Category    string            `tfschema:"category"`
+	Target      string            `tfschema:"target"`
+	AuthType    string            `tfschema:"auth_type"`
+	ApiKey      string            `tfschema:"api_key"`
+	AccountKey  string            `tfschema:"account_key"`
+	Metadata    map[string]string `tfschema:"metadata"`
+}
+
+var _ sdk.ResourceWithUpdate = AIFoundryConnection{}
+
+var _ sdk.ResourceWithCustomizeDiff = AIFoundryConnection{}
+
+func (r AIFoundryConnection) ModelObject() interface{} {
+	return &AIFoundryConnectionModel{}
+}
+
+func (r AIFoundryConnection) ResourceType() string {
+	return "azurerm_ai_foundry_connection"
+}
+
+func (r AIFoundryConnection) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return v2workspaceconnectionresource.ValidateConnectionID
+}
+
+func (r AIFoundryConnection) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-zA-Z0-9][a-zA-Z0-9_-]{2,32}$"),
+				"AI Foundry Connection name must be 3 - 33 characters long, start with a letter or number and contain only letters, numbers, underscores and hyphens.",
+			),
+		},
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"category": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(v2workspaceconnectionresource.ConnectionCategoryAIServices),
+				string(v2workspaceconnectionresource.ConnectionCategoryAzureBlob),
+				string(v2workspaceconnectionresource.ConnectionCategoryAzureOpenAI),
+				string(v2workspaceconnectionresource.ConnectionCategoryCognitiveSearch),
+				string(v2workspaceconnectionresource.ConnectionCategoryCognitiveService),
+			}, false),
+		},
+
+		"target": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"auth_type": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(v2workspaceconnectionresource.ConnectionAuthTypeAAD),
+				string(v2workspaceconnectionresource.ConnectionAuthTypeAccountKey),
+				string(v2workspaceconnectionresource.ConnectionAuthTypeApiKey),
+			}, false),
+		},
+
+		"api_key": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"account_key": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Sensitive:    true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"metadata": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r AIFoundryConnection) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r AIFoundryConnection) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			authType := metadata.ResourceDiff.Get("auth_type").(string)
+			apiKey := metadata.ResourceDiff.Get("api_key").(string)
+			accountKey := metadata.ResourceDiff.Get("account_key").(string)
+
+			switch v2workspaceconnectionresource.ConnectionAuthType(authType) {
+			case v2workspaceconnectionresource.ConnectionAuthTypeApiKey:
+				if apiKey == "" {
+					return fmt.Errorf("`api_key` must be specified when `auth_type` is `ApiKey`")
+				}
+				if accountKey != "" {
+					return fmt.Errorf("`account_key` cannot be specified when `auth_type` is `ApiKey`")
+				}
+			case v2workspaceconnectionresource.ConnectionAuthTypeAccountKey:
+				if accountKey == "" {
+					return fmt.Errorf("`account_key` must be specified when `auth_type` is `AccountKey`")
+				}
+				if apiKey != "" {
+					return fmt.Errorf("`api_key` cannot be specified when `auth_type` is `AccountKey`")
+				}
+			case v2workspaceconnectionresource.ConnectionAuthTypeAAD:
+				if apiKey != "" || accountKey != "" {
+					return fmt.Errorf("`api_key` and `account_key` cannot be specified when `auth_type` is `AAD`")
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AIFoundryConnection) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.WorkspaceConnections
+
+			var model AIFoundryConnectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := v2workspaceconnectionresource.NewConnectionID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.WorkspaceConnectionsGet(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			payload := v2workspaceconnectionresource.WorkspaceConnectionPropertiesV2BasicResource{
+				Properties: expandAIFoundryConnectionProperties(model),
+			}
+
+			if _, err := client.WorkspaceConnectionsCreate(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AIFoundryConnection) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.WorkspaceConnections
+
+			id, err := v2workspaceconnectionresource.ParseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.WorkspaceConnectionsGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := AIFoundryConnectionModel{
+				Name:        id.ConnectionName,
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+				// the API doesn't return the credentials, so these are persisted from the configuration
+				ApiKey:     metadata.ResourceData.Get("api_key").(string),
+				AccountKey: metadata.ResourceData.Get("account_key").(string),
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				props := model.Properties.WorkspaceConnectionPropertiesV2()
+				state.AuthType = string(props.AuthType)
+				state.Category = string(pointer.From(props.Category))
+				state.Target = pointer.From(props.Target)
+				state.Metadata = pointer.From(props.Metadata)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AIFoundryConnection) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.WorkspaceConnections
+
+			id, err := v2workspaceconnectionresource.ParseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AIFoundryConnectionModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// the credentials aren't returned by the API, so the full payload is sent on every update
+			payload := v2workspaceconnectionresource.WorkspaceConnectionPropertiesV2BasicResource{
+				Properties: expandAIFoundryConnectionProperties(model),
+			}
+
+			if _, err := client.WorkspaceConnectionsCreate(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AIFoundryConnection) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.WorkspaceConnections
+
+			id, err := v2workspaceconnectionresource.ParseConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.WorkspaceConnectionsDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandAIFoundryConnectionProperties(input AIFoundryConnectionModel) v2workspaceconnectionresource.WorkspaceConnectionPropertiesV2 {
+	category := pointer.To(v2workspaceconnectionresource.ConnectionCategory(input.Category))
+	target := pointer.To(input.Target)
+
+	var metadata *map[string]string
+	if len(input.Metadata) > 0 {
+		metadata = pointer.To(input.Metadata)
+	}
+
+	switch v2workspaceconnectionresource.ConnectionAuthType(input.AuthType) {
+	case v2workspaceconnectionresource.ConnectionAuthTypeApiKey:
+		return v2workspaceconnectionresource.ApiKeyAuthWorkspaceConnectionProperties{
+			AuthType: v2workspaceconnectionresource.ConnectionAuthTypeApiKey,
+			Category: category,
+			Target:   target,
+			Metadata: metadata,
+			Credentials: &v2workspaceconnectionresource.WorkspaceConnectionApiKey{
+				Key: pointer.To(input.ApiKey),
+			},
+		}
+	case v2workspaceconnectionresource.ConnectionAuthTypeAccountKey:
+		return v2workspaceconnectionresource.AccountKeyAuthTypeWorkspaceConnectionProperties{
+			AuthType: v2workspaceconnectionresource.ConnectionAuthTypeAccountKey,
+			Category: category,
+			Target:   target,
+			Metadata: metadata,
+			Credentials: &v2workspaceconnectionresource.WorkspaceConnectionAccountKey{
+				Key: pointer.To(input.AccountKey),
+			},
+		}
+	}
+
+	return v2workspaceconnectionresource.AADAuthTypeWorkspaceConnectionProperties{
+		AuthType: v2workspaceconnectionresource.ConnectionAuthTypeAAD,
+		Category: category,
+		Target:   target,
+		Metadata: metadata,
+	}
+}
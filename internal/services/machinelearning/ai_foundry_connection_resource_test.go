@@ -0,0 +1,183 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/v2workspaceconnectionresource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AIFoundryConnection struct{}
+
+func TestAccAIFoundryConnection_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnection{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAIFoundryConnection_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnection{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAIFoundryConnection_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnection{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.apiKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("api_key"),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAIFoundryConnection_storageAccountKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_connection", "test")
+	r := AIFoundryConnection{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.storageAccountKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("account_key"),
+	})
+}
+
+func (AIFoundryConnection) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := v2workspaceconnectionresource.ParseConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MachineLearning.WorkspaceConnections.WorkspaceConnectionsGet(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r AIFoundryConnection) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_connection" "test" {
+  name         = "acctestaic-%[2]d"
+  workspace_id = azurerm_ai_foundry.test.id
+  category     = "AIServices"
+  target       = azurerm_ai_services.test.endpoint
+  auth_type    = "AAD"
+
+  metadata = {
+    ApiType    = "Azure"
+    ResourceId = azurerm_ai_services.test.id
+  }
+}
+`, AIFoundry{}.basic(data), data.RandomInteger)
+}
+
+func (r AIFoundryConnection) apiKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_connection" "test" {
+  name         = "acctestaic-%[2]d"
+  workspace_id = azurerm_ai_foundry.test.id
+  category     = "AIServices"
+  target       = azurerm_ai_services.test.endpoint
+  auth_type    = "ApiKey"
+  api_key      = azurerm_ai_services.test.primary_access_key
+
+  metadata = {
+    ApiType    = "Azure"
+    ResourceId = azurerm_ai_services.test.id
+    Location   = azurerm_ai_services.test.location
+  }
+}
+`, AIFoundry{}.basic(data), data.RandomInteger)
+}
+
+func (r AIFoundryConnection) storageAccountKey(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_connection" "test" {
+  name         = "acctestaic-%[2]d"
+  workspace_id = azurerm_ai_foundry.test.id
+  category     = "AzureBlob"
+  target       = azurerm_storage_account.test.primary_blob_endpoint
+  auth_type    = "AccountKey"
+  account_key  = azurerm_storage_account.test.primary_access_key
+
+  metadata = {
+    AccountName   = azurerm_storage_account.test.name
+    ContainerName = "default"
+  }
+}
+`, AIFoundry{}.basic(data), data.RandomInteger)
+}
+
+func (AIFoundryConnection) requiresImport(data acceptance.TestData) string {
+	template := AIFoundryConnection{}.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_connection" "import" {
+  name         = azurerm_ai_foundry_connection.test.name
+  workspace_id = azurerm_ai_foundry_connection.test.workspace_id
+  category     = azurerm_ai_foundry_connection.test.category
+  target       = azurerm_ai_foundry_connection.test.target
+  auth_type    = azurerm_ai_foundry_connection.test.auth_type
+  metadata     = azurerm_ai_foundry_connection.test.metadata
+}
+`, template)
+}
@@ -0,0 +1,282 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/serverlessendpoint"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AIFoundryServerlessEndpoint struct{}
+
+type AIFoundryServerlessEndpointModel struct {
+	Name                      string            `tfschema:"name"`
+	Location                  string            `tfschema:"location"`
+	WorkspaceId               string            `tfschema:"workspace_id"`
+	ModelId                   string            `tfschema:"model_id"`
+	ContentSafetyEnabled      bool              `tfschema:"content_safety_enabled"`
+	Tags                      map[string]string `tfschema:"tags"`
+	InferenceUri              string            `tfschema:"inference_uri"`
+	MarketplaceSubscriptionId string            `tfschema:"marketplace_subscription_id"`
+	PrimaryKey                string            `tfschema:"primary_key"`
+	SecondaryKey              string            `tfschema:"secondary_key"`
+}
+
+var _ sdk.ResourceWithUpdate = AIFoundryServerlessEndpoint{}
+
+func (r AIFoundryServerlessEndpoint) ModelObject() interface{} {
+	return &AIFoundryServerlessEndpointModel{}
+}
+
+func (r AIFoundryServerlessEndpoint) ResourceType() string {
+	return "azurerm_ai_foundry_serverless_endpoint"
+}
+
+func (r AIFoundryServerlessEndpoint) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return serverlessendpoint.ValidateServerlessEndpointID
+}
+
+func (r AIFoundryServerlessEndpoint) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile("^[a-zA-Z][a-zA-Z0-9-]{2,51}$"),
+				"AI Foundry Serverless Endpoint name must be 3 - 52 characters long, start with a letter and contain only letters, numbers and hyphens.",
+			),
+		},
+
+		"location": commonschema.Location(),
+
+		"workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"model_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"content_safety_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			ForceNew: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r AIFoundryServerlessEndpoint) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"inference_uri": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"marketplace_subscription_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"primary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+
+		"secondary_key": {
+			Type:      pluginsdk.TypeString,
+			Computed:  true,
+			Sensitive: true,
+		},
+	}
+}
+
+func (r AIFoundryServerlessEndpoint) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.ServerlessEndpoints
+
+			var model AIFoundryServerlessEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.WorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := serverlessendpoint.NewServerlessEndpointID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return tf.ImportAsExistsError(r.ResourceType(), id.ID())
+			}
+
+			contentSafetyStatus := serverlessendpoint.ContentSafetyStatusDisabled
+			if model.ContentSafetyEnabled {
+				contentSafetyStatus = serverlessendpoint.ContentSafetyStatusEnabled
+			}
+
+			payload := serverlessendpoint.ServerlessEndpointTrackedResource{
+				Location: location.Normalize(model.Location),
+				Tags:     pointer.To(model.Tags),
+				Sku: &serverlessendpoint.Sku{
+					Name: "Consumption",
+				},
+				Properties: serverlessendpoint.ServerlessEndpoint{
+					AuthMode: serverlessendpoint.ServerlessInferenceEndpointAuthModeKey,
+					ContentSafety: &serverlessendpoint.ContentSafety{
+						ContentSafetyStatus: contentSafetyStatus,
+					},
+					ModelSettings: &serverlessendpoint.ModelSettings{
+						ModelId: pointer.To(model.ModelId),
+					},
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AIFoundryServerlessEndpoint) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.ServerlessEndpoints
+
+			id, err := serverlessendpoint.ParseServerlessEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := AIFoundryServerlessEndpointModel{
+				Name:        id.ServerlessEndpointName,
+				WorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Tags = pointer.From(model.Tags)
+
+				props := model.Properties
+				if settings := props.ModelSettings; settings != nil {
+					state.ModelId = pointer.From(settings.ModelId)
+				}
+				if contentSafety := props.ContentSafety; contentSafety != nil {
+					state.ContentSafetyEnabled = contentSafety.ContentSafetyStatus == serverlessendpoint.ContentSafetyStatusEnabled
+				}
+				if endpoint := props.InferenceEndpoint; endpoint != nil {
+					state.InferenceUri = endpoint.Uri
+				}
+				state.MarketplaceSubscriptionId = pointer.From(props.MarketplaceSubscriptionId)
+			}
+
+			keys, err := client.ListKeys(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing keys for %s: %+v", *id, err)
+			}
+			if model := keys.Model; model != nil {
+				state.PrimaryKey = pointer.From(model.PrimaryKey)
+				state.SecondaryKey = pointer.From(model.SecondaryKey)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AIFoundryServerlessEndpoint) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.ServerlessEndpoints
+
+			id, err := serverlessendpoint.ParseServerlessEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AIFoundryServerlessEndpointModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			payload := serverlessendpoint.PartialMinimalTrackedResourceWithSkuAndIdentity{}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(model.Tags)
+			}
+
+			if err := client.UpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AIFoundryServerlessEndpoint) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MachineLearning.ServerlessEndpoints
+
+			id, err := serverlessendpoint.ParseServerlessEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,131 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package machinelearning_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/serverlessendpoint"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AIFoundryServerlessEndpoint struct{}
+
+func TestAccAIFoundryServerlessEndpoint_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_serverless_endpoint", "test")
+	r := AIFoundryServerlessEndpoint{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("inference_uri").IsNotEmpty(),
+				check.That(data.ResourceName).Key("primary_key").IsNotEmpty(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccAIFoundryServerlessEndpoint_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_serverless_endpoint", "test")
+	r := AIFoundryServerlessEndpoint{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccAIFoundryServerlessEndpoint_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_ai_foundry_serverless_endpoint", "test")
+	r := AIFoundryServerlessEndpoint{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.tags(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (AIFoundryServerlessEndpoint) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := serverlessendpoint.ParseServerlessEndpointID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.MachineLearning.ServerlessEndpoints.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r AIFoundryServerlessEndpoint) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_serverless_endpoint" "test" {
+  name         = "acctestse-%[2]d"
+  location     = azurerm_ai_foundry_project.test.location
+  workspace_id = azurerm_ai_foundry_project.test.id
+  model_id     = "azureml://registries/azureml/models/Phi-3.5-mini-instruct"
+}
+`, AIFoundryProject{}.basic(data), data.RandomInteger)
+}
+
+func (r AIFoundryServerlessEndpoint) tags(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_serverless_endpoint" "test" {
+  name         = "acctestse-%[2]d"
+  location     = azurerm_ai_foundry_project.test.location
+  workspace_id = azurerm_ai_foundry_project.test.id
+  model_id     = "azureml://registries/azureml/models/Phi-3.5-mini-instruct"
+
+  tags = {
+    env = "test"
+  }
+}
+`, AIFoundryProject{}.basic(data), data.RandomInteger)
+}
+
+func (AIFoundryServerlessEndpoint) requiresImport(data acceptance.TestData) string {
+	template := AIFoundryServerlessEndpoint{}.basic(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_ai_foundry_serverless_endpoint" "import" {
+  name         = azurerm_ai_foundry_serverless_endpoint.test.name
+  location     = azurerm_ai_foundry_serverless_endpoint.test.location
+  workspace_id = azurerm_ai_foundry_serverless_endpoint.test.workspace_id
+  model_id     = azurerm_ai_foundry_serverless_endpoint.test.model_id
+}
+`, template)
+}
@@ -9,6 +9,8 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/datastore"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/machinelearningcomputes"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/managednetwork"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/serverlessendpoint"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/v2workspaceconnectionresource"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
@@ -18,6 +20,8 @@ type Client struct {
 	MachineLearningComputes *machinelearningcomputes.MachineLearningComputesClient
 	Workspaces              *workspaces.WorkspacesClient
 	ManagedNetwork          *managednetwork.ManagedNetworkClient
+	ServerlessEndpoints     *serverlessendpoint.ServerlessEndpointClient
+	WorkspaceConnections    *v2workspaceconnectionresource.V2WorkspaceConnectionResourceClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -45,10 +49,24 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(managedNetworkClient.Client, o.Authorizers.ResourceManager)
 
+	serverlessEndpointsClient, err := serverlessendpoint.NewServerlessEndpointClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ServerlessEndpoints client: %+v", err)
+	}
+	o.Configure(serverlessEndpointsClient.Client, o.Authorizers.ResourceManager)
+
+	workspaceConnectionsClient, err := v2workspaceconnectionresource.NewV2WorkspaceConnectionResourceClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building WorkspaceConnections client: %+v", err)
+	}
+	o.Configure(workspaceConnectionsClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		MachineLearningComputes: computesClient,
 		Datastore:               datastoreClient,
 		Workspaces:              workspacesClient,
 		ManagedNetwork:          managedNetworkClient,
+		ServerlessEndpoints:     serverlessEndpointsClient,
+		WorkspaceConnections:    workspaceConnectionsClient,
 	}, nil
 }
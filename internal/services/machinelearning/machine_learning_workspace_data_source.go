@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
@@ -38,6 +39,36 @@ func dataSourceMachineLearningWorkspace() *pluginsdk.Resource {
 
 			"identity": commonschema.SystemAssignedUserAssignedIdentityComputed(),
 
+			"managed_network": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"isolation_mode": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
+			"serverless_compute": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"subnet_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+						"public_ip_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": commonschema.TagsDataSource(),
 		},
 	}
@@ -72,5 +103,22 @@ func dataSourceMachineLearningWorkspaceRead(d *pluginsdk.ResourceData, meta inte
 		return fmt.Errorf("setting `identity`: %+v", err)
 	}
 
+	managedNetwork := make([]interface{}, 0)
+	serverlessCompute := make([]interface{}, 0)
+	if props := resp.Model.Properties; props != nil {
+		if v := props.ManagedNetwork; v != nil {
+			managedNetwork = append(managedNetwork, map[string]interface{}{
+				"isolation_mode": string(pointer.From(v.IsolationMode)),
+			})
+		}
+		serverlessCompute = pointer.From(flattenMachineLearningWorkspaceServerlessCompute(props.ServerlessComputeSettings))
+	}
+	if err := d.Set("managed_network", managedNetwork); err != nil {
+		return fmt.Errorf("setting `managed_network`: %+v", err)
+	}
+	if err := d.Set("serverless_compute", serverlessCompute); err != nil {
+		return fmt.Errorf("setting `serverless_compute`: %+v", err)
+	}
+
 	return tags.FlattenAndSet(d, resp.Model.Tags)
 }
@@ -25,6 +25,7 @@ func TestAccMachineLearningWorkspaceDataSource_basic(t *testing.T) {
 				check.That(data.ResourceName).Key("identity.0.type").HasValue("SystemAssigned"),
 				check.That(data.ResourceName).Key("identity.0.principal_id").Exists(),
 				check.That(data.ResourceName).Key("identity.0.tenant_id").Exists(),
+				check.That(data.ResourceName).Key("managed_network.#").HasValue("1"),
 			),
 		},
 	})
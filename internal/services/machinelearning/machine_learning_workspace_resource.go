@@ -4,6 +4,7 @@
 package machinelearning
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"time"
@@ -17,7 +18,9 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	components "github.com/hashicorp/go-azure-sdk/resource-manager/applicationinsights/2020-02-02/componentsapis"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/containerregistry/2023-11-01-preview/registries"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/managednetwork"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/machinelearningservices/2024-04-01/workspaces"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/machinelearning/validate"
@@ -46,6 +49,10 @@ func resourceMachineLearningWorkspace() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			resourceMachineLearningWorkspaceValidateManagedNetwork,
+		),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -195,6 +202,12 @@ func resourceMachineLearningWorkspace() *pluginsdk.Resource {
 							Computed:     true,
 							ValidateFunc: validation.StringInSlice(workspaces.PossibleValuesForIsolationMode(), false),
 						},
+
+						"provision_on_creation_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
 					},
 				},
 			},
@@ -258,8 +271,39 @@ func resourceMachineLearningWorkspace() *pluginsdk.Resource {
 	}
 }
 
+// resourceMachineLearningWorkspaceValidateManagedNetwork ensures that the Managed Network is only provisioned during
+// creation when an `isolation_mode` which uses a Managed Network has been specified
+func resourceMachineLearningWorkspaceValidateManagedNetwork(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	managedNetwork := diff.GetRawConfig().GetAttr("managed_network")
+	if !managedNetwork.IsKnown() || managedNetwork.IsNull() || managedNetwork.LengthInt() == 0 {
+		return nil
+	}
+
+	config := managedNetwork.Index(cty.NumberIntVal(0))
+	if !config.IsKnown() || config.IsNull() {
+		return nil
+	}
+
+	provisionOnCreation := config.GetAttr("provision_on_creation_enabled")
+	if !provisionOnCreation.IsKnown() || provisionOnCreation.IsNull() || provisionOnCreation.False() {
+		return nil
+	}
+
+	isolationMode := config.GetAttr("isolation_mode")
+	if !isolationMode.IsKnown() {
+		return nil
+	}
+
+	if isolationMode.IsNull() || isolationMode.AsString() == string(workspaces.IsolationModeDisabled) {
+		return fmt.Errorf("`provision_on_creation_enabled` can only be set when `isolation_mode` is set to `%s` or `%s`", workspaces.IsolationModeAllowInternetOutbound, workspaces.IsolationModeAllowOnlyApprovedOutbound)
+	}
+
+	return nil
+}
+
 func resourceMachineLearningWorkspaceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).MachineLearning.Workspaces
+	managedNetworkClient := meta.(*clients.Client).MachineLearning.ManagedNetwork
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -356,11 +400,23 @@ func resourceMachineLearningWorkspaceCreate(d *pluginsdk.ResourceData, meta inte
 		workspace.Properties.FeatureStoreSettings = featureStore
 	}
 
+	provisionManagedNetwork := d.Get("managed_network.0.provision_on_creation_enabled").(bool)
+
 	if err := client.CreateOrUpdateThenPoll(ctx, id, workspace); err != nil {
 		return fmt.Errorf("creating %s: %+v", id, err)
 	}
 
 	d.SetId(id.ID())
+
+	// the Managed Network is otherwise only provisioned once the first compute is created, after which
+	// any outbound rules are applied - provisioning this upfront allows these to take effect immediately
+	if provisionManagedNetwork {
+		managedNetworkId := managednetwork.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName)
+		if err := managedNetworkClient.ProvisionsProvisionManagedNetworkThenPoll(ctx, managedNetworkId, managednetwork.ManagedNetworkProvisionOptions{}); err != nil {
+			return fmt.Errorf("provisioning the Managed Network for %s: %+v", id, err)
+		}
+	}
+
 	return resourceMachineLearningWorkspaceRead(d, meta)
 }
 
@@ -546,7 +602,7 @@ func resourceMachineLearningWorkspaceRead(d *pluginsdk.ResourceData, meta interf
 			d.Set("public_network_access_enabled", *props.PublicNetworkAccess == workspaces.PublicNetworkAccessEnabled)
 			d.Set("v1_legacy_mode_enabled", props.V1LegacyMode)
 			d.Set("workspace_id", props.WorkspaceId)
-			d.Set("managed_network", flattenMachineLearningWorkspaceManagedNetwork(props.ManagedNetwork))
+			d.Set("managed_network", flattenMachineLearningWorkspaceManagedNetwork(props.ManagedNetwork, d.Get("managed_network.0.provision_on_creation_enabled").(bool)))
 			d.Set("serverless_compute", flattenMachineLearningWorkspaceServerlessCompute(props.ServerlessComputeSettings))
 
 			kvId, err := commonids.ParseKeyVaultIDInsensitively(*props.KeyVault)
@@ -779,12 +835,15 @@ func expandMachineLearningWorkspaceManagedNetwork(i []interface{}) *workspaces.M
 	}
 }
 
-func flattenMachineLearningWorkspaceManagedNetwork(i *workspaces.ManagedNetworkSettings) *[]interface{} {
+func flattenMachineLearningWorkspaceManagedNetwork(i *workspaces.ManagedNetworkSettings, provisionOnCreationEnabled bool) *[]interface{} {
 	if i == nil {
 		return &[]interface{}{}
 	}
 
-	out := map[string]interface{}{}
+	out := map[string]interface{}{
+		// this isn't returned by the API
+		"provision_on_creation_enabled": provisionOnCreationEnabled,
+	}
 
 	if i.IsolationMode != nil {
 		out["isolation_mode"] = *i.IsolationMode
@@ -375,6 +375,22 @@ func TestAccMachineLearningWorkspace_serverlessCompute(t *testing.T) {
 	})
 }
 
+func TestAccMachineLearningWorkspace_managedNetworkProvisionOnCreation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace", "test")
+	r := WorkspaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedNetworkProvisionOnCreation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("managed_network.0.isolation_mode").HasValue("AllowOnlyApprovedOutbound"),
+			),
+		},
+		data.ImportStep("managed_network.0.provision_on_creation_enabled"),
+	})
+}
+
 func TestAccMachineLearningWorkspace_serverlessCompute_withoutSubnet(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_machine_learning_workspace", "test")
 	r := WorkspaceResource{}
@@ -1254,6 +1270,35 @@ resource "azurerm_machine_learning_workspace" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r WorkspaceResource) managedNetworkProvisionOnCreation(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_machine_learning_workspace" "test" {
+  name                    = "acctest-MLW-%[2]d"
+  location                = azurerm_resource_group.test.location
+  resource_group_name     = azurerm_resource_group.test.name
+  application_insights_id = azurerm_application_insights.test.id
+  key_vault_id            = azurerm_key_vault.test.id
+  storage_account_id      = azurerm_storage_account.test.id
+
+  managed_network {
+    isolation_mode                = "AllowOnlyApprovedOutbound"
+    provision_on_creation_enabled = true
+  }
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, template, data.RandomInteger)
+}
+
 func (r WorkspaceResource) serverlessComputeWithoutSubnet(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -57,7 +57,9 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AIFoundry{},
+		AIFoundryConnection{},
 		AIFoundryProject{},
+		AIFoundryServerlessEndpoint{},
 		MachineLearningDataStoreBlobStorage{},
 		MachineLearningDataStoreDataLakeGen2{},
 		MachineLearningDataStoreFileShare{},
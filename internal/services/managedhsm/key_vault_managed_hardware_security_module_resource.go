@@ -8,6 +8,7 @@ import (
 	"crypto/sha256"
 	"encoding/base64"
 	"encoding/json"
+	"encoding/pem"
 	"fmt"
 	"io"
 	"log"
@@ -170,6 +171,22 @@ func resourceKeyVaultManagedHardwareSecurityModule() *pluginsdk.Resource {
 				Sensitive: true,
 			},
 
+			"security_domain_restore_data": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringIsNotEmpty,
+				ConflictsWith: []string{
+					"security_domain_key_vault_certificate_ids",
+					"security_domain_quorum",
+				},
+			},
+
+			"security_domain_exchange_key": {
+				Type:     pluginsdk.TypeString,
+				Computed: true,
+			},
+
 			// https://github.com/Azure/azure-rest-api-specs/issues/13365
 			"tags": commonschema.Tags(),
 		},
@@ -313,11 +330,21 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleUpdate(d *pluginsdk.Resourc
 		d.Set("security_domain_encrypted_data", encData)
 	}
 
+	// security domain upload to restore this module from an existing security domain
+	if d.HasChange("security_domain_restore_data") {
+		if restoreData := d.Get("security_domain_restore_data").(string); restoreData != "" {
+			if err := securityDomainUpload(ctx, kvClient.DataPlaneSecurityDomainsClient, *resp.Model.Properties.HsmUri, restoreData); err != nil {
+				return fmt.Errorf("uploading security domain for %s: %+v", id, err)
+			}
+		}
+	}
+
 	return nil
 }
 
 func resourceArmKeyVaultManagedHardwareSecurityModuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
 	hsmClient := meta.(*clients.Client).ManagedHSMs.ManagedHsmClient
+	sdClient := meta.(*clients.Client).ManagedHSMs.DataPlaneSecurityDomainsClient
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -363,6 +390,17 @@ func resourceArmKeyVaultManagedHardwareSecurityModuleRead(d *pluginsdk.ResourceD
 			if err := d.Set("network_acls", flattenMHSMNetworkAcls(props.NetworkAcls)); err != nil {
 				return fmt.Errorf("setting `network_acls`: %+v", err)
 			}
+
+			// the exchange key is only available whilst the module is pending activation - since the data plane may
+			// not be reachable from where Terraform is running (e.g. when public network access is disabled) this is best-effort
+			exchangeKey := ""
+			if sd := props.SecurityDomainProperties; sd != nil && pointer.From(sd.ActivationStatus) == managedhsms.ActivationStatusNotActivated && props.HsmUri != nil {
+				exchangeKey, err = securityDomainExchangeKey(ctx, sdClient, *props.HsmUri)
+				if err != nil {
+					log.Printf("[DEBUG] unable to retrieve the security domain exchange key for %s: %+v", *id, err)
+				}
+			}
+			d.Set("security_domain_exchange_key", exchangeKey)
 		}
 
 		skuName := ""
@@ -539,7 +577,44 @@ func securityDomainDownload(ctx context.Context, sdClient *kv74.HSMSecurityDomai
 	return encData.Value, err
 }
 
+func securityDomainExchangeKey(ctx context.Context, sdClient *kv74.HSMSecurityDomainClient, vaultBaseUrl string) (string, error) {
+	resp, err := sdClient.TransferKeyMethod(ctx, vaultBaseUrl)
+	if err != nil {
+		return "", fmt.Errorf("retrieving transfer key for %s: %+v", vaultBaseUrl, err)
+	}
+
+	if resp.TransferKey == nil || resp.TransferKey.X5c == nil || len(*resp.TransferKey.X5c) == 0 {
+		return "", fmt.Errorf("retrieving transfer key for %s: `transfer_key.x5c` was nil", vaultBaseUrl)
+	}
+
+	der, err := base64.StdEncoding.DecodeString((*resp.TransferKey.X5c)[0])
+	if err != nil {
+		return "", fmt.Errorf("decoding transfer key certificate for %s: %+v", vaultBaseUrl, err)
+	}
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})), nil
+}
+
+func securityDomainUpload(ctx context.Context, sdClient *kv74.HSMSecurityDomainClient, vaultBaseUrl string, restoreData string) error {
+	if _, err := sdClient.Upload(ctx, vaultBaseUrl, kv74.SecurityDomainObject{Value: pointer.To(restoreData)}); err != nil {
+		return fmt.Errorf("uploading for %s: %v", vaultBaseUrl, err)
+	}
+
+	pollerType := custompollers.NewHSMUploadPoller(sdClient, vaultBaseUrl)
+	poller := pollers.NewPoller(pollerType, 10*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("waiting for security domain to upload: %+v", err)
+	}
+
+	return nil
+}
+
 func keyVaultHSMCustomizeDiff(_ context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	// the security domain has to be wrapped with the exchange key of this specific module, which is only known once it's been created
+	if d.Id() == "" && d.Get("security_domain_restore_data").(string) != "" {
+		return fmt.Errorf("`security_domain_restore_data` can only be specified once the Managed HSM has been created, since it must be prepared using `security_domain_exchange_key`")
+	}
+
 	if oldVal, newVal := d.GetChange("security_domain_key_vault_certificate_ids"); len(oldVal.([]interface{})) != 0 && len(newVal.([]interface{})) == 0 {
 		if err := d.ForceNew("security_domain_key_vault_certificate_ids"); err != nil {
 			return err
@@ -6,6 +6,7 @@ package managedhsm_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/managedhsms"
@@ -31,6 +32,7 @@ func TestAccKeyVaultManagedHardwareSecurityModule(t *testing.T) {
 			"update":   testAccKeyVaultManagedHardwareSecurityModule_updateAndRequiresImport,
 			"complete": testAccKeyVaultManagedHardwareSecurityModule_complete,
 			"download": testAccKeyVaultManagedHardwareSecurityModule_download,
+			"restore":  testAccKeyVaultManagedHardwareSecurityModule_restoreRequiresExisting,
 		},
 		"roleAssignments": {
 			"builtInRole": testAccKeyVaultManagedHardwareSecurityModuleRoleAssignment_builtInRole,
@@ -97,6 +99,18 @@ func testAccKeyVaultManagedHardwareSecurityModule_download(t *testing.T) {
 	})
 }
 
+func testAccKeyVaultManagedHardwareSecurityModule_restoreRequiresExisting(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module", "test")
+	r := KeyVaultManagedHardwareSecurityModuleResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.restore(data),
+			ExpectError: regexp.MustCompile("`security_domain_restore_data` can only be specified once the Managed HSM has been created"),
+		},
+	})
+}
+
 func testAccKeyVaultManagedHardwareSecurityModule_updateAndRequiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_key_vault_managed_hardware_security_module", "test")
 	r := KeyVaultManagedHardwareSecurityModuleResource{}
@@ -170,6 +184,28 @@ resource "azurerm_key_vault_managed_hardware_security_module" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r KeyVaultManagedHardwareSecurityModuleResource) restore(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%s
+
+resource "azurerm_key_vault_managed_hardware_security_module" "test" {
+  name                         = "kvHsm%d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  sku_name                     = "Standard_B1"
+  tenant_id                    = data.azurerm_client_config.current.tenant_id
+  admin_object_ids             = [data.azurerm_client_config.current.object_id]
+  purge_protection_enabled     = false
+  security_domain_restore_data = "{}"
+}
+`, template, data.RandomInteger)
+}
+
 func (r KeyVaultManagedHardwareSecurityModuleResource) basicUpdate(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
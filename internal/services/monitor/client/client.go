@@ -4,6 +4,7 @@
 package client
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/alertsmanagement/2019-06-01/smartdetectoralertrules"
@@ -25,6 +26,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-11/datacollectionrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-03-15-preview/scheduledqueryrules"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/insights/2023-04-03/azuremonitorworkspaces"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients/graph"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 )
 
@@ -55,6 +57,8 @@ type Client struct {
 	ScheduledQueryRulesClient            *scheduledqueryrules2018.ScheduledQueryRulesClient
 	ScheduledQueryRulesV2Client          *scheduledqueryrules.ScheduledQueryRulesClient
 	WorkspacesClient                     *azuremonitorworkspaces.AzureMonitorWorkspacesClient
+
+	o *common.ClientOptions
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -192,5 +196,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ScheduledQueryRulesClient:            ScheduledQueryRulesClient,
 		ScheduledQueryRulesV2Client:          ScheduledQueryRulesV2Client,
 		WorkspacesClient:                     WorkspacesClient,
+
+		o: o,
 	}, nil
 }
+
+// ApplicationObjectID looks up the Object ID of the Application Registration with the specified Application (Client) ID
+// using Microsoft Graph, which is required when configuring Secure Webhooks
+func (c *Client) ApplicationObjectID(ctx context.Context, applicationId string) (*string, error) {
+	authorizer, err := c.o.Authorizers.AuthorizerFunc(c.o.Environment.MicrosoftGraph)
+	if err != nil {
+		return nil, fmt.Errorf("building Microsoft Graph authorizer: %+v", err)
+	}
+
+	return graph.ApplicationObjectID(ctx, authorizer, c.o.Environment, applicationId)
+}
@@ -4,6 +4,7 @@
 package monitor
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"time"
@@ -18,6 +19,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	monitorClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/migration"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/monitor/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tags"
@@ -218,7 +220,14 @@ func resourceMonitorActionGroup() *pluginsdk.Resource {
 								Schema: map[string]*pluginsdk.Schema{
 									"object_id": {
 										Type:         pluginsdk.TypeString,
-										Required:     true,
+										Optional:     true,
+										Computed:     true,
+										ValidateFunc: validation.IsUUID,
+									},
+
+									"application_id": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
 										ValidateFunc: validation.IsUUID,
 									},
 
@@ -484,6 +493,11 @@ func resourceMonitorActionGroupCreateUpdate(d *pluginsdk.ResourceData, meta inte
 		return err
 	}
 
+	expandedWebhookReceiver, err := expandMonitorActionGroupWebHookReceiver(ctx, meta.(*clients.Client).Monitor, tenantId, webhookReceiversRaw)
+	if err != nil {
+		return err
+	}
+
 	t := d.Get("tags").(map[string]interface{})
 
 	parameters := actiongroupsapis.ActionGroupResource{
@@ -495,7 +509,7 @@ func resourceMonitorActionGroupCreateUpdate(d *pluginsdk.ResourceData, meta inte
 			AzureAppPushReceivers:      expandMonitorActionGroupAzureAppPushReceiver(azureAppPushReceiversRaw),
 			ItsmReceivers:              expandedItsmReceiver,
 			SmsReceivers:               expandMonitorActionGroupSmsReceiver(smsReceiversRaw),
-			WebhookReceivers:           expandMonitorActionGroupWebHookReceiver(tenantId, webhookReceiversRaw),
+			WebhookReceivers:           expandedWebhookReceiver,
 			AutomationRunbookReceivers: expandMonitorActionGroupAutomationRunbookReceiver(automationRunbookReceiversRaw),
 			VoiceReceivers:             expandMonitorActionGroupVoiceReceiver(voiceReceiversRaw),
 			LogicAppReceivers:          expandMonitorActionGroupLogicAppReceiver(logicAppReceiversRaw),
@@ -560,7 +574,10 @@ func resourceMonitorActionGroupRead(d *pluginsdk.ResourceData, meta interface{})
 				return fmt.Errorf("setting `sms_receiver`: %+v", err)
 			}
 
-			if err = d.Set("webhook_receiver", flattenMonitorActionGroupWebHookReceiver(props.WebhookReceivers)); err != nil {
+			webhookReceivers := flattenMonitorActionGroupWebHookReceiver(props.WebhookReceivers)
+			// `application_id` isn't returned by the API, so is retained from the existing state
+			setMonitorActionGroupWebHookReceiverApplicationIds(webhookReceivers, d.Get("webhook_receiver").([]interface{}))
+			if err = d.Set("webhook_receiver", webhookReceivers); err != nil {
 				return fmt.Errorf("setting `webhook_receiver`: %+v", err)
 			}
 
@@ -685,7 +702,7 @@ func expandMonitorActionGroupSmsReceiver(v []interface{}) *[]actiongroupsapis.Sm
 	return &receivers
 }
 
-func expandMonitorActionGroupWebHookReceiver(tenantId string, v []interface{}) *[]actiongroupsapis.WebhookReceiver {
+func expandMonitorActionGroupWebHookReceiver(ctx context.Context, client *monitorClient.Client, tenantId string, v []interface{}) (*[]actiongroupsapis.WebhookReceiver, error) {
 	receivers := make([]actiongroupsapis.WebhookReceiver, 0)
 	for _, receiverValue := range v {
 		val := receiverValue.(map[string]interface{})
@@ -696,8 +713,21 @@ func expandMonitorActionGroupWebHookReceiver(tenantId string, v []interface{}) *
 		}
 		if v, ok := val["aad_auth"].([]interface{}); ok && len(v) > 0 {
 			secureWebhook := v[0].(map[string]interface{})
+
+			objectId := secureWebhook["object_id"].(string)
+			if applicationId := secureWebhook["application_id"].(string); applicationId != "" {
+				resolvedObjectId, err := client.ApplicationObjectID(ctx, applicationId)
+				if err != nil {
+					return nil, fmt.Errorf("resolving the Object ID of the Application %q for the `webhook_receiver` %q: %+v", applicationId, receiver.Name, err)
+				}
+				objectId = *resolvedObjectId
+			}
+			if objectId == "" {
+				return nil, fmt.Errorf("one of `object_id` or `application_id` must be specified within the `aad_auth` block of the `webhook_receiver` %q", receiver.Name)
+			}
+
 			receiver.UseAadAuth = utils.Bool(true)
-			receiver.ObjectId = utils.String(secureWebhook["object_id"].(string))
+			receiver.ObjectId = utils.String(objectId)
 			receiver.IdentifierUri = utils.String(secureWebhook["identifier_uri"].(string))
 			if v := secureWebhook["tenant_id"].(string); v != "" {
 				receiver.TenantId = utils.String(v)
@@ -707,7 +737,7 @@ func expandMonitorActionGroupWebHookReceiver(tenantId string, v []interface{}) *
 		}
 		receivers = append(receivers, receiver)
 	}
-	return &receivers
+	return &receivers, nil
 }
 
 func expandMonitorActionGroupAutomationRunbookReceiver(v []interface{}) *[]actiongroupsapis.AutomationRunbookReceiver {
@@ -905,6 +935,26 @@ func flattenMonitorActionGroupWebHookReceiver(receivers *[]actiongroupsapis.Webh
 	return result
 }
 
+func setMonitorActionGroupWebHookReceiverApplicationIds(receivers []interface{}, existing []interface{}) {
+	applicationIds := make(map[string]string)
+	for _, raw := range existing {
+		v, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if aadAuth, ok := v["aad_auth"].([]interface{}); ok && len(aadAuth) > 0 && aadAuth[0] != nil {
+			applicationIds[v["name"].(string)] = aadAuth[0].(map[string]interface{})["application_id"].(string)
+		}
+	}
+
+	for _, raw := range receivers {
+		v := raw.(map[string]interface{})
+		if aadAuth := v["aad_auth"].([]interface{}); len(aadAuth) > 0 {
+			aadAuth[0].(map[string]interface{})["application_id"] = applicationIds[v["name"].(string)]
+		}
+	}
+}
+
 func flattenMonitorActionGroupSecureWebHookReceiver(receiver actiongroupsapis.WebhookReceiver) []interface{} {
 	if receiver.UseAadAuth == nil || !*receiver.UseAadAuth {
 		return []interface{}{}
@@ -613,8 +613,11 @@ func expandAzureRmMonitorAutoScaleSettingProfile(input []interface{}) ([]autosca
 }
 
 func expandAzureRmMonitorAutoScaleSettingPredictive(input []interface{}) *autoscalesettings.PredictiveAutoscalePolicy {
+	// predictive autoscale is disabled explicitly when the block is omitted, so that removing it takes effect
 	if len(input) == 0 || input[0] == nil {
-		return nil
+		return &autoscalesettings.PredictiveAutoscalePolicy{
+			ScaleMode: autoscalesettings.PredictiveAutoscalePolicyScaleModeDisabled,
+		}
 	}
 
 	raw := input[0].(map[string]interface{})
@@ -639,7 +642,6 @@ func expandAzureRmMonitorAutoScaleSettingRule(input []interface{}) []autoscalese
 		triggerRaw := triggersRaw[0].(map[string]interface{})
 		metricTrigger := autoscalesettings.MetricTrigger{
 			MetricName:        triggerRaw["metric_name"].(string),
-			MetricNamespace:   utils.String(triggerRaw["metric_namespace"].(string)),
 			MetricResourceUri: triggerRaw["metric_resource_id"].(string),
 			TimeGrain:         triggerRaw["time_grain"].(string),
 			Statistic:         autoscalesettings.MetricStatisticType(triggerRaw["statistic"].(string)),
@@ -651,6 +653,10 @@ func expandAzureRmMonitorAutoScaleSettingRule(input []interface{}) []autoscalese
 			DividePerInstance: utils.Bool(triggerRaw["divide_by_instance_count"].(bool)),
 		}
 
+		if v := triggerRaw["metric_namespace"].(string); v != "" {
+			metricTrigger.MetricNamespace = utils.String(v)
+		}
+
 		actionsRaw := ruleRaw["scale_action"].([]interface{})
 		actionRaw := actionsRaw[0].(map[string]interface{})
 		scaleAction := autoscalesettings.ScaleAction{
@@ -118,6 +118,14 @@ func TestAccMonitorAutoScaleSetting_predictiveUpdated(t *testing.T) {
 			),
 		},
 		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("predictive.#").HasValue("0"),
+			),
+		},
+		data.ImportStep(),
 	})
 }
 
@@ -19,8 +19,11 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/geobackuppolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobagents"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobcredentials"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobexecutions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobprivateendpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobs"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobsteps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobtargetexecutions"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobtargetgroups"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/longtermretentionpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/outboundfirewallrules"
@@ -56,8 +59,11 @@ type Client struct {
 	GeoBackupPoliciesClient                            *geobackuppolicies.GeoBackupPoliciesClient
 	JobAgentsClient                                    *jobagents.JobAgentsClient
 	JobCredentialsClient                               *jobcredentials.JobCredentialsClient
+	JobExecutionsClient                                *jobexecutions.JobExecutionsClient
+	JobPrivateEndpointsClient                          *jobprivateendpoints.JobPrivateEndpointsClient
 	JobsClient                                         *jobs.JobsClient
 	JobStepsClient                                     *jobsteps.JobStepsClient
+	JobTargetExecutionsClient                          *jobtargetexecutions.JobTargetExecutionsClient
 	JobTargetGroupsClient                              *jobtargetgroups.JobTargetGroupsClient
 	LongTermRetentionPoliciesClient                    *longtermretentionpolicies.LongTermRetentionPoliciesClient
 	OutboundFirewallRulesClient                        *outboundfirewallrules.OutboundFirewallRulesClient
@@ -154,6 +160,18 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(jobCredentialsClient.Client, o.Authorizers.ResourceManager)
 
+	jobExecutionsClient, err := jobexecutions.NewJobExecutionsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Job Executions Client: %+v", err)
+	}
+	o.Configure(jobExecutionsClient.Client, o.Authorizers.ResourceManager)
+
+	jobPrivateEndpointsClient, err := jobprivateendpoints.NewJobPrivateEndpointsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Job Private Endpoints Client: %+v", err)
+	}
+	o.Configure(jobPrivateEndpointsClient.Client, o.Authorizers.ResourceManager)
+
 	jobsClient, err := jobs.NewJobsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Jobs Client: %+v", err)
@@ -166,6 +184,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(jobStepsClient.Client, o.Authorizers.ResourceManager)
 
+	jobTargetExecutionsClient, err := jobtargetexecutions.NewJobTargetExecutionsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Job Target Executions Client: %+v", err)
+	}
+	o.Configure(jobTargetExecutionsClient.Client, o.Authorizers.ResourceManager)
+
 	jobTargetGroupsClient, err := jobtargetgroups.NewJobTargetGroupsClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building Job Target Groups Client: %+v", err)
@@ -296,6 +320,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		FirewallRulesClient:                                firewallRulesClient,
 		JobAgentsClient:                                    jobAgentsClient,
 		JobCredentialsClient:                               jobCredentialsClient,
+		JobPrivateEndpointsClient:                          jobPrivateEndpointsClient,
 		OutboundFirewallRulesClient:                        outboundFirewallRulesClient,
 		ServerDNSAliasClient:                               serverDNSAliasClient,
 		ServerDevOpsAuditSettingsClient:                    serverDevOpsAuditSettingsClient,
@@ -316,8 +341,10 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		DatabaseSecurityAlertPoliciesClient:    databaseSecurityAlertPoliciesClient,
 		ElasticPoolsClient:                     elasticPoolsClient,
 		GeoBackupPoliciesClient:                geoBackupPoliciesClient,
+		JobExecutionsClient:                    jobExecutionsClient,
 		JobsClient:                             jobsClient,
 		JobStepsClient:                         jobStepsClient,
+		JobTargetExecutionsClient:              jobTargetExecutionsClient,
 		JobTargetGroupsClient:                  jobTargetGroupsClient,
 		LongTermRetentionPoliciesClient:        longTermRetentionPoliciesClient,
 		ReplicationLinksClient:                 replicationLinksClient,
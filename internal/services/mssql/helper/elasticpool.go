@@ -486,3 +486,23 @@ func doVCoreSKUValidation(s sku) error {
 
 	return nil
 }
+
+// MSSQLElasticPoolDefaultPerDatabaseSettings returns the per-database minimum and maximum capacity used when
+// `auto_per_database_settings` is enabled, which allows each database to scale from zero up to the largest
+// capacity the SKU supports for a single database.
+func MSSQLElasticPoolDefaultPerDatabaseSettings(name string, capacity int) (float64, float64) {
+	var maxCapacity int
+	switch strings.ToLower(name) {
+	case "basicpool":
+		maxCapacity = 5
+	case "standardpool":
+		maxCapacity = min(capacity, 3000)
+	case "premiumpool":
+		maxCapacity = min(capacity, 4000)
+	default:
+		// vCore based SKUs allow a single database to use up to 80 vCores
+		maxCapacity = min(capacity, 80)
+	}
+
+	return 0, float64(maxCapacity)
+}
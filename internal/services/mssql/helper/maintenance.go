@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helper
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2023-04-01/publicmaintenanceconfigurations"
+)
+
+// DefaultMaintenanceConfigurationName is the Public Maintenance Configuration which is available in every region
+const DefaultMaintenanceConfigurationName = "SQL_Default"
+
+// ValidateMaintenanceConfigurationForLocation checks that the named Public Maintenance Configuration is available
+// in the specified location for the specified scope, since the API otherwise only rejects it once the resource is
+// being provisioned.
+func ValidateMaintenanceConfigurationForLocation(ctx context.Context, client *publicmaintenanceconfigurations.PublicMaintenanceConfigurationsClient, subscriptionId, name, loc string, scope publicmaintenanceconfigurations.MaintenanceScope) error {
+	if strings.EqualFold(name, DefaultMaintenanceConfigurationName) {
+		return nil
+	}
+
+	resp, err := client.List(ctx, commonids.NewSubscriptionID(subscriptionId))
+	if err != nil {
+		return fmt.Errorf("listing Public Maintenance Configurations: %+v", err)
+	}
+
+	configs := make([]publicmaintenanceconfigurations.MaintenanceConfiguration, 0)
+	if resp.Model != nil && resp.Model.Value != nil {
+		configs = *resp.Model.Value
+	}
+
+	return validateMaintenanceConfigurationForLocation(configs, name, loc, scope)
+}
+
+func validateMaintenanceConfigurationForLocation(configs []publicmaintenanceconfigurations.MaintenanceConfiguration, name, loc string, scope publicmaintenanceconfigurations.MaintenanceScope) error {
+	loc = location.Normalize(loc)
+
+	available := []string{DefaultMaintenanceConfigurationName}
+	for _, config := range configs {
+		if location.Normalize(pointer.From(config.Location)) != loc {
+			continue
+		}
+		if config.Properties == nil || pointer.From(config.Properties.MaintenanceScope) != scope {
+			continue
+		}
+
+		configName := pointer.From(config.Name)
+		if strings.EqualFold(configName, name) {
+			return nil
+		}
+		available = append(available, configName)
+	}
+	sort.Strings(available[1:])
+
+	return fmt.Errorf("the Maintenance Configuration %q is not available in %q - available Maintenance Configurations are: %s", name, loc, strings.Join(available, ", "))
+}
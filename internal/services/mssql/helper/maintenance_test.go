@@ -0,0 +1,68 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package helper
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/maintenance/2023-04-01/publicmaintenanceconfigurations"
+)
+
+func TestValidateMaintenanceConfigurationForLocation(t *testing.T) {
+	config := func(name, location string, scope publicmaintenanceconfigurations.MaintenanceScope) publicmaintenanceconfigurations.MaintenanceConfiguration {
+		return publicmaintenanceconfigurations.MaintenanceConfiguration{
+			Name:     pointer.To(name),
+			Location: pointer.To(location),
+			Properties: &publicmaintenanceconfigurations.MaintenanceConfigurationProperties{
+				MaintenanceScope: pointer.To(scope),
+			},
+		}
+	}
+	configs := []publicmaintenanceconfigurations.MaintenanceConfiguration{
+		config("SQL_WestEurope_DB_1", "westeurope", publicmaintenanceconfigurations.MaintenanceScopeSQLDB),
+		config("SQL_WestEurope_DB_2", "westeurope", publicmaintenanceconfigurations.MaintenanceScopeSQLDB),
+		config("SQL_WestEurope_MI_1", "westeurope", publicmaintenanceconfigurations.MaintenanceScopeSQLManagedInstance),
+		config("SQL_EastUS_DB_1", "eastus", publicmaintenanceconfigurations.MaintenanceScopeSQLDB),
+	}
+
+	cases := []struct {
+		Name     string
+		Location string
+		Errors   bool
+	}{
+		{
+			Name:     "SQL_WestEurope_DB_1",
+			Location: "West Europe",
+			Errors:   false,
+		},
+		{
+			Name:     "sql_westeurope_db_2",
+			Location: "westeurope",
+			Errors:   false,
+		},
+		{
+			Name:     "SQL_EastUS_DB_1",
+			Location: "westeurope",
+			Errors:   true,
+		},
+		{
+			Name:     "SQL_WestEurope_MI_1",
+			Location: "westeurope",
+			Errors:   true,
+		},
+		{
+			Name:     "SQL_NorthEurope_DB_1",
+			Location: "northeurope",
+			Errors:   true,
+		},
+	}
+
+	for _, tc := range cases {
+		err := validateMaintenanceConfigurationForLocation(configs, tc.Name, tc.Location, publicmaintenanceconfigurations.MaintenanceScopeSQLDB)
+		if (err != nil) != tc.Errors {
+			t.Fatalf("expected %q in %q to error (%t) but got: %+v", tc.Name, tc.Location, tc.Errors, err)
+		}
+	}
+}
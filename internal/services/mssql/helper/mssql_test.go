@@ -220,3 +220,44 @@ func TestValidateMsSqlElasticPoolName(t *testing.T) {
 		}
 	}
 }
+
+func TestMSSQLElasticPoolDefaultPerDatabaseSettings(t *testing.T) {
+	cases := []struct {
+		Name        string
+		Capacity    int
+		MaxCapacity float64
+	}{
+		{
+			Name:        "BasicPool",
+			Capacity:    100,
+			MaxCapacity: 5,
+		},
+		{
+			Name:        "StandardPool",
+			Capacity:    50,
+			MaxCapacity: 50,
+		},
+		{
+			Name:        "PremiumPool",
+			Capacity:    4000,
+			MaxCapacity: 4000,
+		},
+		{
+			Name:        "HS_Gen5",
+			Capacity:    4,
+			MaxCapacity: 4,
+		},
+		{
+			Name:        "GP_Gen5",
+			Capacity:    128,
+			MaxCapacity: 80,
+		},
+	}
+
+	for _, tc := range cases {
+		minCapacity, maxCapacity := MSSQLElasticPoolDefaultPerDatabaseSettings(tc.Name, tc.Capacity)
+		if minCapacity != 0 || maxCapacity != tc.MaxCapacity {
+			t.Fatalf("expected %q with a capacity of %d to have a min/max of 0/%f, got %f/%f", tc.Name, tc.Capacity, tc.MaxCapacity, minCapacity, maxCapacity)
+		}
+	}
+}
@@ -113,6 +113,40 @@ func resourceMsSqlDatabase() *pluginsdk.Resource {
 					}
 				}
 
+				return nil
+			},
+			func(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+				// NOTE: the API only rejects a Maintenance Configuration which isn't available in the server's region once the
+				// database is being provisioned, so check this at plan time when the server already exists
+				if !d.HasChange("maintenance_configuration_name") || !d.NewValueKnown("maintenance_configuration_name") || !d.NewValueKnown("server_id") {
+					return nil
+				}
+				maintenanceConfigurationName := d.Get("maintenance_configuration_name").(string)
+				if maintenanceConfigurationName == "" {
+					return nil
+				}
+
+				serverId, err := commonids.ParseSqlServerID(d.Get("server_id").(string))
+				if err != nil {
+					return err
+				}
+
+				server, err := meta.(*clients.Client).MSSQL.ServersClient.Get(ctx, *serverId, servers.DefaultGetOperationOptions())
+				if err != nil {
+					if response.WasNotFound(server.HttpResponse) {
+						return nil
+					}
+					return fmt.Errorf("retrieving %s: %+v", *serverId, err)
+				}
+				if server.Model == nil {
+					return nil
+				}
+
+				client := meta.(*clients.Client).Maintenance.PublicConfigurationsClient
+				if err := helper.ValidateMaintenanceConfigurationForLocation(ctx, client, serverId.SubscriptionId, maintenanceConfigurationName, server.Model.Location, publicmaintenanceconfigurations.MaintenanceScopeSQLDB); err != nil {
+					return fmt.Errorf("validating `maintenance_configuration_name`: %+v", err)
+				}
+
 				return nil
 			}),
 	}
@@ -138,9 +138,11 @@ func resourceMsSqlElasticPool() *pluginsdk.Resource {
 			},
 
 			"per_database_settings": {
-				Type:     pluginsdk.TypeList,
-				Required: true,
-				MaxItems: 1,
+				Type:          pluginsdk.TypeList,
+				Optional:      true,
+				Computed:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"auto_per_database_settings"},
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
 						"min_capacity": {
@@ -158,6 +160,13 @@ func resourceMsSqlElasticPool() *pluginsdk.Resource {
 				},
 			},
 
+			"auto_per_database_settings": {
+				Type:          pluginsdk.TypeBool,
+				Optional:      true,
+				Default:       false,
+				ConflictsWith: []string{"per_database_settings"},
+			},
+
 			"max_size_bytes": {
 				Type:          pluginsdk.TypeInt,
 				Optional:      true,
@@ -207,6 +216,29 @@ func resourceMsSqlElasticPool() *pluginsdk.Resource {
 		},
 
 		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				if !diff.Get("auto_per_database_settings").(bool) {
+					if rawConfig := diff.GetRawConfig(); !rawConfig.IsNull() {
+						if v := rawConfig.AsValueMap()["per_database_settings"]; v.IsNull() || (v.IsKnown() && v.LengthInt() == 0) {
+							return fmt.Errorf("one of `per_database_settings` or `auto_per_database_settings` must be specified")
+						}
+					}
+					return nil
+				}
+
+				if !diff.NewValueKnown("sku.0.name") || !diff.NewValueKnown("sku.0.capacity") {
+					return nil
+				}
+
+				minCapacity, maxCapacity := helper.MSSQLElasticPoolDefaultPerDatabaseSettings(diff.Get("sku.0.name").(string), diff.Get("sku.0.capacity").(int))
+				return diff.SetNew("per_database_settings", []interface{}{
+					map[string]interface{}{
+						"min_capacity": minCapacity,
+						"max_capacity": maxCapacity,
+					},
+				})
+			},
+
 			func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
 				if err := helper.MSSQLElasticPoolValidateSKU(diff); err != nil {
 					return err
@@ -215,6 +247,22 @@ func resourceMsSqlElasticPool() *pluginsdk.Resource {
 				return nil
 			},
 
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				// NOTE: the API only rejects a Maintenance Configuration which isn't available in the region once the
+				// elastic pool is being provisioned, so check this at plan time
+				if !diff.HasChanges("maintenance_configuration_name", "location") || !diff.NewValueKnown("maintenance_configuration_name") || !diff.NewValueKnown("location") {
+					return nil
+				}
+
+				client := v.(*clients.Client).Maintenance.PublicConfigurationsClient
+				subscriptionId := v.(*clients.Client).Account.SubscriptionId
+				if err := helper.ValidateMaintenanceConfigurationForLocation(ctx, client, subscriptionId, diff.Get("maintenance_configuration_name").(string), diff.Get("location").(string), publicmaintenanceconfigurations.MaintenanceScopeSQLDB); err != nil {
+					return fmt.Errorf("validating `maintenance_configuration_name`: %+v", err)
+				}
+
+				return nil
+			},
+
 			pluginsdk.ForceNewIfChange("enclave_type", func(ctx context.Context, old, new, _ interface{}) bool {
 				// enclave_type cannot be removed once it has been set
 				// but can be changed between VBS and Default...
@@ -263,7 +311,6 @@ func resourceMsSqlElasticPoolCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		Sku:      sku,
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 		Properties: &elasticpools.ElasticPoolProperties{
-			LicenseType:                pointer.To(elasticpools.ElasticPoolLicenseType(d.Get("license_type").(string))),
 			PerDatabaseSettings:        expandMsSqlElasticPoolPerDatabaseSettings(d),
 			ZoneRedundant:              pointer.To(d.Get("zone_redundant").(bool)),
 			MaintenanceConfigurationId: pointer.To(maintenanceConfigId.ID()),
@@ -271,6 +318,14 @@ func resourceMsSqlElasticPoolCreateUpdate(d *pluginsdk.ResourceData, meta interf
 		},
 	}
 
+	// NOTE: the API rejects `license_type` for Hyperscale elastic pools, so the computed value is only sent back for
+	// other tiers - but an explicitly configured value is always sent, so that the API can surface the error
+	if v := d.Get("license_type").(string); v != "" {
+		if !strings.EqualFold(pointer.From(sku.Tier), "Hyperscale") || !d.GetRawConfig().AsValueMap()["license_type"].IsNull() {
+			elasticPool.Properties.LicenseType = pointer.To(elasticpools.ElasticPoolLicenseType(v))
+		}
+	}
+
 	// NOTE: The service default is actually nil/empty which indicates enclave is disabled. the value `Default` is NOT the default.
 	if v, ok := d.GetOk("enclave_type"); ok && v.(string) != "" {
 		elasticPool.Properties.PreferredEnclaveType = pointer.To(elasticpools.AlwaysEncryptedEnclaveType(v.(string)))
@@ -326,6 +326,31 @@ func TestAccMsSqlElasticPool_hyperScale(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlElasticPool_hyperScaleAutoPerDatabaseSettings(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_elasticpool", "test")
+	r := MsSqlElasticPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.hyperScaleAutoPerDatabaseSettings(data, 4),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("per_database_settings.0.min_capacity").HasValue("0"),
+				check.That(data.ResourceName).Key("per_database_settings.0.max_capacity").HasValue("4"),
+			),
+		},
+		data.ImportStep("max_size_gb", "auto_per_database_settings"),
+		{
+			Config: r.hyperScaleAutoPerDatabaseSettings(data, 6),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("per_database_settings.0.max_capacity").HasValue("6"),
+			),
+		},
+		data.ImportStep("max_size_gb", "auto_per_database_settings"),
+	})
+}
+
 func TestAccMsSqlElasticPool_vCoreToStandardDTU(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_elasticpool", "test")
 	r := MsSqlElasticPoolResource{}
@@ -422,6 +447,18 @@ func TestAccMsSqlElasticPool_dcFamilyVCoreEnclaveTypeError(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlElasticPool_maintenanceConfigurationInAnotherRegion(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_elasticpool", "test")
+	r := MsSqlElasticPoolResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.maintenanceConfigurationInAnotherRegion(data),
+			ExpectError: regexp.MustCompile(`the Maintenance Configuration "SQL_JapanWest_DB_1" is not available`),
+		},
+	})
+}
+
 func (MsSqlElasticPoolResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := commonids.ParseSqlElasticPoolID(state.ID)
 	if err != nil {
@@ -565,6 +602,48 @@ resource "azurerm_mssql_elasticpool" "test" {
 `, data.RandomInteger, data.Locations.Primary, skuName, skuTier, skuCapacity, maxSizeGB, databaseSettingsMin, databaseSettingsMax, zoneRedundant, configName, enclaveType)
 }
 
+func (MsSqlElasticPoolResource) maintenanceConfigurationInAnotherRegion(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                           = "acctest-pool-dtu-%[1]d"
+  resource_group_name            = azurerm_resource_group.test.name
+  location                       = "%[2]s"
+  server_name                    = azurerm_mssql_server.test.name
+  max_size_gb                    = 50
+  maintenance_configuration_name = "SQL_JapanWest_DB_1"
+
+  sku {
+    name     = "StandardPool"
+    tier     = "Standard"
+    capacity = 50
+  }
+
+  per_database_settings {
+    min_capacity = 0
+    max_capacity = 50
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
 func (MsSqlElasticPoolResource) templateUpdateToDTU(data acceptance.TestData, skuName string, skuTier string, skuCapacity int, maxSizeGB float64, databaseSettingsMin int, databaseSettingsMax int, zoneRedundant bool, enclaveType string) string {
 	configName := "SQL_Default"
 	if skuTier != "Basic" {
@@ -748,6 +827,43 @@ resource "azurerm_mssql_elasticpool" "test" {
 `, data.RandomInteger, data.Locations.Primary, skuName, skuTier, skuCapacity, skuFamily, databaseSettingsMin, databaseSettingsMax, enclaveType)
 }
 
+func (MsSqlElasticPoolResource) hyperScaleAutoPerDatabaseSettings(data acceptance.TestData, capacity int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_mssql_server" "test" {
+  name                         = "acctest%[1]d"
+  resource_group_name          = azurerm_resource_group.test.name
+  location                     = azurerm_resource_group.test.location
+  version                      = "12.0"
+  administrator_login          = "4dm1n157r470r"
+  administrator_login_password = "4-v3ry-53cr37-p455w0rd"
+}
+
+resource "azurerm_mssql_elasticpool" "test" {
+  name                       = "acctest-pool-vcore-%[1]d"
+  resource_group_name        = azurerm_resource_group.test.name
+  location                   = azurerm_resource_group.test.location
+  server_name                = azurerm_mssql_server.test.name
+  auto_per_database_settings = true
+
+  sku {
+    name     = "HS_Gen5"
+    tier     = "Hyperscale"
+    capacity = %[3]d
+    family   = "Gen5"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, capacity)
+}
+
 func (MsSqlElasticPoolResource) noLicenseType(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -4,16 +4,26 @@
 package mssql
 
 import (
+	"context"
 	"fmt"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobcredentials"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobexecutions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobs"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobsteps"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobtargetexecutions"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobtargetgroups"
 	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/go-uuid"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -78,6 +88,12 @@ func resourceMsSqlJobCredential() *pluginsdk.Resource {
 				Optional:     true,
 				RequiredWith: []string{"password_wo"},
 			},
+
+			"validation_database_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: commonids.ValidateSqlDatabaseID,
+			},
 		},
 	}
 }
@@ -128,6 +144,12 @@ func resourceMsSqlJobCredentialCreate(d *pluginsdk.ResourceData, meta interface{
 
 	d.SetId(jobCredentialId.ID())
 
+	if v := d.Get("validation_database_id").(string); v != "" {
+		if err := validateMsSqlJobCredentialConnection(ctx, meta.(*clients.Client).MSSQL, jobCredentialId, v); err != nil {
+			return fmt.Errorf("validating the connection for %s: %+v", jobCredentialId, err)
+		}
+	}
+
 	return resourceMsSqlJobCredentialRead(d, meta)
 }
 
@@ -181,6 +203,12 @@ func resourceMsSqlJobCredentialUpdate(d *pluginsdk.ResourceData, meta interface{
 		return fmt.Errorf("updating %s: %+v", jobCredentialId, err)
 	}
 
+	if v := d.Get("validation_database_id").(string); v != "" && d.HasChanges("username", "password", "password_wo_version", "validation_database_id") {
+		if err := validateMsSqlJobCredentialConnection(ctx, meta.(*clients.Client).MSSQL, jobCredentialId, v); err != nil {
+			return fmt.Errorf("validating the connection for %s: %+v", jobCredentialId, err)
+		}
+	}
+
 	return resourceMsSqlJobCredentialRead(d, meta)
 }
 
@@ -214,6 +242,7 @@ func resourceMsSqlJobCredentialRead(d *pluginsdk.ResourceData, meta interface{})
 	}
 
 	d.Set("password_wo_version", d.Get("password_wo_version").(int))
+	d.Set("validation_database_id", d.Get("validation_database_id").(string))
 
 	return nil
 }
@@ -228,6 +257,11 @@ func resourceMsSqlJobCredentialDelete(d *pluginsdk.ResourceData, meta interface{
 		return err
 	}
 
+	// remove anything left over from a validation which was interrupted, since the Job Step references this credential
+	if err := deleteMsSqlJobCredentialValidationResources(ctx, meta.(*clients.Client).MSSQL, *id); err != nil {
+		return err
+	}
+
 	_, err = client.Delete(ctx, *id)
 	if err != nil {
 		return fmt.Errorf("deleting %s: %+v", id, err)
@@ -235,3 +269,178 @@ func resourceMsSqlJobCredentialDelete(d *pluginsdk.ResourceData, meta interface{
 
 	return nil
 }
+
+// validateMsSqlJobCredentialConnection checks that the Job Credential can be used to log in to the specified database
+// by running `SELECT 1` against it. Since the Elastic Jobs API has no dedicated endpoint for this, a temporary Target
+// Group and Job are created for the validation, and removed once the Job Execution has completed.
+func validateMsSqlJobCredentialConnection(ctx context.Context, client *client.Client, id jobcredentials.CredentialId, validationDatabaseId string) error {
+	databaseId, err := commonids.ParseSqlDatabaseIDInsensitively(validationDatabaseId)
+	if err != nil {
+		return err
+	}
+
+	// the temporary resources are named after the credential, so that any left over from an interrupted validation are
+	// removed by the next validation, or when the credential is deleted
+	if err := deleteMsSqlJobCredentialValidationResources(ctx, client, id); err != nil {
+		return err
+	}
+	defer func() {
+		// the resources are removed even if the apply has been cancelled
+		cleanupCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Minute)
+		defer cancel()
+		if err := deleteMsSqlJobCredentialValidationResources(cleanupCtx, client, id); err != nil {
+			log.Printf("[WARN] %+v", err)
+		}
+	}()
+
+	name := msSqlJobCredentialValidationName(id)
+	targetGroupId := jobtargetgroups.NewTargetGroupID(id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.JobAgentName, name)
+	targetGroup := jobtargetgroups.JobTargetGroup{
+		Properties: &jobtargetgroups.JobTargetGroupProperties{
+			Members: []jobtargetgroups.JobTarget{
+				{
+					DatabaseName:   pointer.To(databaseId.DatabaseName),
+					MembershipType: pointer.To(jobtargetgroups.JobTargetGroupMembershipTypeInclude),
+					ServerName:     pointer.To(databaseId.ServerName),
+					Type:           jobtargetgroups.JobTargetTypeSqlDatabase,
+				},
+			},
+		},
+	}
+	if _, err := client.JobTargetGroupsClient.CreateOrUpdate(ctx, targetGroupId, targetGroup); err != nil {
+		return fmt.Errorf("creating %s: %+v", targetGroupId, err)
+	}
+
+	jobId := jobs.NewJobID(id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.JobAgentName, name)
+	job := jobs.Job{
+		Properties: &jobs.JobProperties{
+			Description: pointer.To(fmt.Sprintf("Validates the connection for the Job Credential %q", id.CredentialName)),
+		},
+	}
+	if _, err := client.JobsClient.CreateOrUpdate(ctx, jobId, job); err != nil {
+		return fmt.Errorf("creating %s: %+v", jobId, err)
+	}
+
+	stepId := jobsteps.NewStepID(id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.JobAgentName, name, "validate")
+	step := jobsteps.JobStep{
+		Properties: &jobsteps.JobStepProperties{
+			Action: jobsteps.JobStepAction{
+				Value: "SELECT 1",
+			},
+			Credential: pointer.To(id.ID()),
+			ExecutionOptions: &jobsteps.JobStepExecutionOptions{
+				RetryAttempts:  pointer.To(int64(0)),
+				TimeoutSeconds: pointer.To(int64(60)),
+			},
+			TargetGroup: targetGroupId.ID(),
+		},
+	}
+	if _, err := client.JobStepsClient.CreateOrUpdate(ctx, stepId, step); err != nil {
+		return fmt.Errorf("creating %s: %+v", stepId, err)
+	}
+
+	executionName, err := uuid.GenerateUUID()
+	if err != nil {
+		return fmt.Errorf("generating an ID for the execution of %s: %+v", jobId, err)
+	}
+	executionId := jobexecutions.NewExecutionID(id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.JobAgentName, name, executionName)
+	if _, err := client.JobExecutionsClient.CreateOrUpdate(ctx, executionId); err != nil {
+		return fmt.Errorf("starting %s: %+v", executionId, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{
+			string(jobexecutions.JobExecutionLifecycleCreated),
+			string(jobexecutions.JobExecutionLifecycleInProgress),
+			string(jobexecutions.JobExecutionLifecycleWaitingForChildJobExecutions),
+			string(jobexecutions.JobExecutionLifecycleWaitingForRetry),
+		},
+		Target: []string{
+			string(jobexecutions.JobExecutionLifecycleSucceeded),
+		},
+		Refresh:    msSqlJobCredentialValidationRefreshFunc(ctx, client, executionId),
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s: %+v", executionId, err)
+	}
+
+	return nil
+}
+
+func msSqlJobCredentialValidationRefreshFunc(ctx context.Context, client *client.Client, id jobexecutions.ExecutionId) pluginsdk.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		resp, err := client.JobExecutionsClient.Get(ctx, id)
+		if err != nil {
+			// the execution can take a few seconds to become available after it's been submitted
+			if response.WasNotFound(resp.HttpResponse) {
+				return resp, string(jobexecutions.JobExecutionLifecycleCreated), nil
+			}
+			return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+		}
+
+		if resp.Model == nil || resp.Model.Properties == nil || resp.Model.Properties.Lifecycle == nil {
+			return resp, string(jobexecutions.JobExecutionLifecycleCreated), nil
+		}
+
+		lifecycle := *resp.Model.Properties.Lifecycle
+		switch lifecycle {
+		case jobexecutions.JobExecutionLifecycleCanceled, jobexecutions.JobExecutionLifecycleFailed, jobexecutions.JobExecutionLifecycleSkipped, jobexecutions.JobExecutionLifecycleSucceededWithSkipped, jobexecutions.JobExecutionLifecycleTimedOut:
+			return nil, "", fmt.Errorf("the execution finished with the status %q: %s", lifecycle, msSqlJobCredentialValidationFailureMessage(ctx, client, id, pointer.From(resp.Model.Properties.LastMessage)))
+		}
+
+		return resp, string(lifecycle), nil
+	}
+}
+
+// msSqlJobCredentialValidationFailureMessage returns the message from the execution against the target database, which
+// (unlike the message for the Job Execution) contains the reason the login failed
+func msSqlJobCredentialValidationFailureMessage(ctx context.Context, client *client.Client, id jobexecutions.ExecutionId, fallback string) string {
+	executionId := jobtargetexecutions.NewExecutionID(id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.JobAgentName, id.JobName, id.JobExecutionId)
+	resp, err := client.JobTargetExecutionsClient.ListByJobExecutionComplete(ctx, executionId, jobtargetexecutions.DefaultListByJobExecutionOperationOptions())
+	if err != nil {
+		log.Printf("[DEBUG] listing the Target Executions for %s: %+v", id, err)
+		return fallback
+	}
+
+	messages := make([]string, 0)
+	for _, item := range resp.Items {
+		if props := item.Properties; props != nil && props.Target != nil && props.LastMessage != nil && *props.LastMessage != "" {
+			messages = append(messages, fmt.Sprintf("%s/%s: %s", pointer.From(props.Target.ServerName), pointer.From(props.Target.DatabaseName), *props.LastMessage))
+		}
+	}
+	if len(messages) == 0 {
+		return fallback
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+func msSqlJobCredentialValidationName(id jobcredentials.CredentialId) string {
+	return fmt.Sprintf("tf-validate-%s", id.CredentialName)
+}
+
+// deleteMsSqlJobCredentialValidationResources removes the temporary Job and Target Group used to validate the Job
+// Credential, should they exist
+func deleteMsSqlJobCredentialValidationResources(ctx context.Context, client *client.Client, id jobcredentials.CredentialId) error {
+	name := msSqlJobCredentialValidationName(id)
+
+	// removing the Job also removes the Job Steps and Job Executions within it
+	jobId := jobs.NewJobID(id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.JobAgentName, name)
+	if resp, err := client.JobsClient.Delete(ctx, jobId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+		return fmt.Errorf("deleting %s used for validating %s: %+v", jobId, id, err)
+	}
+
+	targetGroupId := jobtargetgroups.NewTargetGroupID(id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.JobAgentName, name)
+	if resp, err := client.JobTargetGroupsClient.Delete(ctx, targetGroupId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+		return fmt.Errorf("deleting %s used for validating %s: %+v", targetGroupId, id, err)
+	}
+
+	return nil
+}
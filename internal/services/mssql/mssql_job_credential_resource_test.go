@@ -38,6 +38,21 @@ func TestAccMsSqlJobCredential_basic(t *testing.T) {
 	})
 }
 
+func TestAccMsSqlJobCredential_validateConnection(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_job_credential", "test")
+	r := MsSqlJobCredentialResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.validateConnection(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("password", "validation_database_id"),
+	})
+}
+
 func TestAccMsSqlJobCredential_requiresImport(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mssql_job_credential", "test")
 	r := MsSqlJobCredentialResource{}
@@ -184,6 +199,36 @@ resource "azurerm_mssql_job_credential" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r MsSqlJobCredentialResource) validateConnection(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_firewall_rule" "test" {
+  name             = "AllowAzureServices"
+  server_id        = azurerm_mssql_server.test.id
+  start_ip_address = "0.0.0.0"
+  end_ip_address   = "0.0.0.0"
+}
+
+resource "azurerm_mssql_database" "target" {
+  name      = "acctestmssqldbtarget%[2]d"
+  server_id = azurerm_mssql_server.test.id
+  collation = "SQL_Latin1_General_CP1_CI_AS"
+  sku_name  = "S0"
+}
+
+resource "azurerm_mssql_job_credential" "test" {
+  name                   = "acctestmssqljobcredential%[2]d"
+  job_agent_id           = azurerm_mssql_job_agent.test.id
+  username               = azurerm_mssql_server.test.administrator_login
+  password               = azurerm_mssql_server.test.administrator_login_password
+  validation_database_id = azurerm_mssql_database.target.id
+
+  depends_on = [azurerm_mssql_firewall_rule.test]
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r MsSqlJobCredentialResource) writeOnlyPassword(data acceptance.TestData, secret string, version int) string {
 	return fmt.Sprintf(`
 %[1]s
@@ -0,0 +1,178 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/jobprivateendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MsSqlJobPrivateEndpointResource struct{}
+
+type MsSqlJobPrivateEndpointResourceModel struct {
+	Name              string `tfschema:"name"`
+	JobAgentID        string `tfschema:"job_agent_id"`
+	TargetServerID    string `tfschema:"target_server_id"`
+	PrivateEndpointID string `tfschema:"private_endpoint_id"`
+}
+
+var _ sdk.Resource = MsSqlJobPrivateEndpointResource{}
+
+func (MsSqlJobPrivateEndpointResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"job_agent_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: jobprivateendpoints.ValidateJobAgentID,
+		},
+		"target_server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSqlServerID,
+		},
+	}
+}
+
+func (MsSqlJobPrivateEndpointResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_endpoint_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (MsSqlJobPrivateEndpointResource) ModelObject() interface{} {
+	return &MsSqlJobPrivateEndpointResourceModel{}
+}
+
+func (MsSqlJobPrivateEndpointResource) ResourceType() string {
+	return "azurerm_mssql_job_private_endpoint"
+}
+
+func (r MsSqlJobPrivateEndpointResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.JobPrivateEndpointsClient
+
+			var model MsSqlJobPrivateEndpointResourceModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			jobAgent, err := jobprivateendpoints.ParseJobAgentID(model.JobAgentID)
+			if err != nil {
+				return err
+			}
+
+			id := jobprivateendpoints.NewPrivateEndpointID(jobAgent.SubscriptionId, jobAgent.ResourceGroupName, jobAgent.ServerName, jobAgent.JobAgentName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			parameters := jobprivateendpoints.JobPrivateEndpoint{
+				Name: pointer.To(model.Name),
+				Properties: &jobprivateendpoints.JobPrivateEndpointProperties{
+					TargetServerAzureResourceId: model.TargetServerID,
+				},
+			}
+
+			if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (MsSqlJobPrivateEndpointResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.JobPrivateEndpointsClient
+
+			id, err := jobprivateendpoints.ParsePrivateEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := MsSqlJobPrivateEndpointResourceModel{
+				Name:       id.PrivateEndpointName,
+				JobAgentID: jobprivateendpoints.NewJobAgentID(id.SubscriptionId, id.ResourceGroupName, id.ServerName, id.JobAgentName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					targetServerId, err := commonids.ParseSqlServerIDInsensitively(props.TargetServerAzureResourceId)
+					if err != nil {
+						return err
+					}
+					state.TargetServerID = targetServerId.ID()
+					state.PrivateEndpointID = pointer.From(props.PrivateEndpointId)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (MsSqlJobPrivateEndpointResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.JobPrivateEndpointsClient
+
+			id, err := jobprivateendpoints.ParsePrivateEndpointID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (MsSqlJobPrivateEndpointResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return jobprivateendpoints.ValidatePrivateEndpointID
+}
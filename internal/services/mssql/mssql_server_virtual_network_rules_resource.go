@@ -0,0 +1,372 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/virtualnetworkrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/mssql/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+// mssqlServerVirtualNetworkRulesParallelism is the maximum number of Virtual Network Rule operations which are
+// in-flight against a single Server at any one time
+const mssqlServerVirtualNetworkRulesParallelism = 10
+
+type MsSqlServerVirtualNetworkRulesResource struct{}
+
+type MsSqlServerVirtualNetworkRulesResourceModel struct {
+	ServerId string                               `tfschema:"server_id"`
+	Rules    []MsSqlServerVirtualNetworkRuleModel `tfschema:"rule"`
+}
+
+type MsSqlServerVirtualNetworkRuleModel struct {
+	Name                             string `tfschema:"name"`
+	SubnetId                         string `tfschema:"subnet_id"`
+	IgnoreMissingVnetServiceEndpoint bool   `tfschema:"ignore_missing_vnet_service_endpoint"`
+}
+
+var (
+	_ sdk.ResourceWithUpdate        = MsSqlServerVirtualNetworkRulesResource{}
+	_ sdk.ResourceWithCustomizeDiff = MsSqlServerVirtualNetworkRulesResource{}
+)
+
+func (r MsSqlServerVirtualNetworkRulesResource) ModelObject() interface{} {
+	return &MsSqlServerVirtualNetworkRulesResourceModel{}
+}
+
+func (r MsSqlServerVirtualNetworkRulesResource) ResourceType() string {
+	return "azurerm_mssql_server_virtual_network_rules"
+}
+
+func (r MsSqlServerVirtualNetworkRulesResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return commonids.ValidateSqlServerID
+}
+
+func (r MsSqlServerVirtualNetworkRulesResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"server_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: commonids.ValidateSqlServerID,
+		},
+
+		"rule": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: validate.VirtualNetworkRuleName,
+					},
+
+					"subnet_id": {
+						Type:         pluginsdk.TypeString,
+						Required:     true,
+						ValidateFunc: commonids.ValidateSubnetID,
+					},
+
+					"ignore_missing_vnet_service_endpoint": {
+						Type:     pluginsdk.TypeBool,
+						Optional: true,
+						Default:  false,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r MsSqlServerVirtualNetworkRulesResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r MsSqlServerVirtualNetworkRulesResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.VirtualNetworkRulesClient
+
+			var config MsSqlServerVirtualNetworkRulesResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseSqlServerID(config.ServerId)
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.ListByServerComplete(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing Virtual Network Rules for %s: %+v", id, err)
+			}
+
+			// this resource is authoritative over every rule on the Server, so any existing rules must be imported first
+			// rather than being silently taken over (and potentially removed)
+			if len(existing.Items) > 0 {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			if err := reconcileMsSqlServerVirtualNetworkRules(ctx, client, *id, existing.Items, config.Rules); err != nil {
+				return err
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MsSqlServerVirtualNetworkRulesResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.VirtualNetworkRulesClient
+
+			id, err := commonids.ParseSqlServerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ListByServerComplete(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.LatestHttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("listing Virtual Network Rules for %s: %+v", id, err)
+			}
+
+			if len(resp.Items) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			rules, err := flattenMsSqlServerVirtualNetworkRules(resp.Items)
+			if err != nil {
+				return err
+			}
+
+			state := MsSqlServerVirtualNetworkRulesResourceModel{
+				ServerId: id.ID(),
+				Rules:    rules,
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MsSqlServerVirtualNetworkRulesResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.VirtualNetworkRulesClient
+
+			id, err := commonids.ParseSqlServerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config MsSqlServerVirtualNetworkRulesResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.ListByServerComplete(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing Virtual Network Rules for %s: %+v", id, err)
+			}
+
+			return reconcileMsSqlServerVirtualNetworkRules(ctx, client, *id, existing.Items, config.Rules)
+		},
+	}
+}
+
+func (r MsSqlServerVirtualNetworkRulesResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.MSSQL.VirtualNetworkRulesClient
+
+			id, err := commonids.ParseSqlServerID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			locks.ByID(id.ID())
+			defer locks.UnlockByID(id.ID())
+
+			existing, err := client.ListByServerComplete(ctx, *id)
+			if err != nil {
+				// the rules are removed along with the Server
+				if response.WasNotFound(existing.LatestHttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("listing Virtual Network Rules for %s: %+v", id, err)
+			}
+
+			return reconcileMsSqlServerVirtualNetworkRules(ctx, client, *id, existing.Items, []MsSqlServerVirtualNetworkRuleModel{})
+		},
+	}
+}
+
+func (r MsSqlServerVirtualNetworkRulesResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var config MsSqlServerVirtualNetworkRulesResourceModel
+			if err := metadata.DecodeDiff(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return validateMsSqlServerVirtualNetworkRuleNamesAreUnique(config.Rules)
+		},
+	}
+}
+
+func validateMsSqlServerVirtualNetworkRuleNamesAreUnique(input []MsSqlServerVirtualNetworkRuleModel) error {
+	names := make(map[string]struct{})
+	for _, v := range input {
+		// names which aren't known until apply can't be compared
+		if v.Name == "" {
+			continue
+		}
+
+		key := strings.ToLower(v.Name)
+		if _, ok := names[key]; ok {
+			return fmt.Errorf("the Virtual Network Rule name %q is specified more than once in `rule`", v.Name)
+		}
+		names[key] = struct{}{}
+	}
+
+	return nil
+}
+
+// reconcileMsSqlServerVirtualNetworkRules diffs the existing rules against the desired rules, then creates, updates
+// and deletes only the rules which have changed - running up to mssqlServerVirtualNetworkRulesParallelism
+// operations concurrently.
+func reconcileMsSqlServerVirtualNetworkRules(ctx context.Context, client *virtualnetworkrules.VirtualNetworkRulesClient, serverId commonids.SqlServerId, existing []virtualnetworkrules.VirtualNetworkRule, desired []MsSqlServerVirtualNetworkRuleModel) error {
+	existingRules := make(map[string]virtualnetworkrules.VirtualNetworkRule)
+	for _, v := range existing {
+		if v.Name == nil {
+			continue
+		}
+		existingRules[strings.ToLower(*v.Name)] = v
+	}
+
+	operations := make([]func() error, 0)
+	for _, rule := range desired {
+		id := virtualnetworkrules.NewVirtualNetworkRuleID(serverId.SubscriptionId, serverId.ResourceGroupName, serverId.ServerName, rule.Name)
+
+		subnetId, err := commonids.ParseSubnetID(rule.SubnetId)
+		if err != nil {
+			return err
+		}
+
+		if current, ok := existingRules[strings.ToLower(rule.Name)]; ok {
+			delete(existingRules, strings.ToLower(rule.Name))
+
+			if props := current.Properties; props != nil {
+				currentSubnetId, err := commonids.ParseSubnetIDInsensitively(props.VirtualNetworkSubnetId)
+				if err == nil && strings.EqualFold(currentSubnetId.ID(), subnetId.ID()) && pointer.From(props.IgnoreMissingVnetServiceEndpoint) == rule.IgnoreMissingVnetServiceEndpoint {
+					continue
+				}
+			}
+		}
+
+		payload := virtualnetworkrules.VirtualNetworkRule{
+			Properties: &virtualnetworkrules.VirtualNetworkRuleProperties{
+				VirtualNetworkSubnetId:           subnetId.ID(),
+				IgnoreMissingVnetServiceEndpoint: pointer.To(rule.IgnoreMissingVnetServiceEndpoint),
+			},
+		}
+		operations = append(operations, func() error {
+			if err := client.CreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating/updating %s: %+v", id, err)
+			}
+			return nil
+		})
+	}
+
+	// anything left over is no longer defined in the configuration
+	for name := range existingRules {
+		id := virtualnetworkrules.NewVirtualNetworkRuleID(serverId.SubscriptionId, serverId.ResourceGroupName, serverId.ServerName, pointer.From(existingRules[name].Name))
+		operations = append(operations, func() error {
+			if err := client.DeleteThenPoll(ctx, id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+			return nil
+		})
+	}
+
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	errs := make([]error, 0)
+	semaphore := make(chan struct{}, mssqlServerVirtualNetworkRulesParallelism)
+
+	for _, operation := range operations {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(op func() error) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			if err := op(); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(operation)
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
+
+func flattenMsSqlServerVirtualNetworkRules(input []virtualnetworkrules.VirtualNetworkRule) ([]MsSqlServerVirtualNetworkRuleModel, error) {
+	output := make([]MsSqlServerVirtualNetworkRuleModel, 0)
+
+	for _, v := range input {
+		rule := MsSqlServerVirtualNetworkRuleModel{
+			Name: pointer.From(v.Name),
+		}
+
+		if props := v.Properties; props != nil {
+			subnetId, err := commonids.ParseSubnetIDInsensitively(props.VirtualNetworkSubnetId)
+			if err != nil {
+				return nil, fmt.Errorf("parsing subnet ID returned by API %q: %+v", props.VirtualNetworkSubnetId, err)
+			}
+			rule.SubnetId = subnetId.ID()
+			rule.IgnoreMissingVnetServiceEndpoint = pointer.From(props.IgnoreMissingVnetServiceEndpoint)
+		}
+
+		output = append(output, rule)
+	}
+
+	return output, nil
+}
@@ -0,0 +1,151 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mssql_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MsSqlServerVirtualNetworkRulesResource struct{}
+
+func TestAccMsSqlServerVirtualNetworkRules_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_server_virtual_network_rules", "test")
+	r := MsSqlServerVirtualNetworkRulesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMsSqlServerVirtualNetworkRules_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_server_virtual_network_rules", "test")
+	r := MsSqlServerVirtualNetworkRulesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMsSqlServerVirtualNetworkRules_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mssql_server_virtual_network_rules", "test")
+	r := MsSqlServerVirtualNetworkRulesResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("3"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("rule.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MsSqlServerVirtualNetworkRulesResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParseSqlServerID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.MSSQL.VirtualNetworkRulesClient.ListByServerComplete(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("listing Virtual Network Rules for %s: %+v", *id, err)
+	}
+
+	return pointer.To(len(resp.Items) > 0), nil
+}
+
+func (r MsSqlServerVirtualNetworkRulesResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_server_virtual_network_rules" "test" {
+  server_id = azurerm_mssql_server.test.id
+
+  rule {
+    name      = "acctestsqlvnetrule1-%[2]d"
+    subnet_id = azurerm_subnet.test1.id
+  }
+}
+`, MsSqlVirtualNetworkRuleResource{}.template(data), data.RandomInteger)
+}
+
+func (r MsSqlServerVirtualNetworkRulesResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mssql_server_virtual_network_rules" "import" {
+  server_id = azurerm_mssql_server_virtual_network_rules.test.server_id
+
+  rule {
+    name      = azurerm_mssql_server_virtual_network_rules.test.rule.0.name
+    subnet_id = azurerm_mssql_server_virtual_network_rules.test.rule.0.subnet_id
+  }
+}
+`, r.basic(data))
+}
+
+func (r MsSqlServerVirtualNetworkRulesResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_mssql_server_virtual_network_rules" "test" {
+  server_id = azurerm_mssql_server.test.id
+
+  rule {
+    name      = "acctestsqlvnetrule1-%[2]d"
+    subnet_id = azurerm_subnet.test1.id
+  }
+
+  rule {
+    name      = "acctestsqlvnetrule2-%[2]d"
+    subnet_id = azurerm_subnet.test2.id
+  }
+
+  rule {
+    name                                 = "acctestsqlvnetrule3-%[2]d"
+    subnet_id                            = azurerm_subnet.test3.id
+    ignore_missing_vnet_service_endpoint = true
+  }
+}
+`, MsSqlVirtualNetworkRuleResource{}.template(data), data.RandomInteger)
+}
@@ -72,9 +72,11 @@ func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		MsSqlFailoverGroupResource{},
 		MsSqlJobResource{},
+		MsSqlJobPrivateEndpointResource{},
 		MsSqlJobScheduleResource{},
 		MsSqlJobStepResource{},
 		MsSqlJobTargetGroupResource{},
+		MsSqlServerVirtualNetworkRulesResource{},
 		MsSqlVirtualMachineAvailabilityGroupListenerResource{},
 		MsSqlVirtualMachineGroupResource{},
 		ServerDNSAliasResource{},
@@ -125,6 +125,11 @@ func dataSourceMysqlFlexibleServer() *pluginsdk.Resource {
 				Computed: true,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
+						"accelerated_logs_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
 						"auto_grow_enabled": {
 							Type:     pluginsdk.TypeBool,
 							Computed: true,
@@ -256,10 +261,11 @@ func flattenDataSourceArmServerStorage(storage *servers.Storage) []interface{} {
 
 	return []interface{}{
 		map[string]interface{}{
-			"size_gb":            size,
-			"iops":               iops,
-			"auto_grow_enabled":  *storage.AutoGrow == servers.EnableStatusEnumEnabled,
-			"io_scaling_enabled": *storage.AutoIoScaling == servers.EnableStatusEnumEnabled,
+			"size_gb":                  size,
+			"iops":                     iops,
+			"auto_grow_enabled":        *storage.AutoGrow == servers.EnableStatusEnumEnabled,
+			"io_scaling_enabled":       *storage.AutoIoScaling == servers.EnableStatusEnumEnabled,
+			"accelerated_logs_enabled": pointer.From(storage.LogOnDisk) == servers.EnableStatusEnumEnabled,
 		},
 	}
 }
@@ -19,6 +19,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/serverfailover"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/servers"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/mysql/2023-12-30/servervalidateestimatehighavailability"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/privatedns/2024-06-01/privatezones"
 	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
@@ -267,6 +268,12 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 				MaxItems: 1,
 				Elem: &pluginsdk.Resource{
 					Schema: map[string]*pluginsdk.Schema{
+						"accelerated_logs_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Computed: true,
+						},
+
 						"auto_grow_enabled": {
 							Type:     pluginsdk.TypeBool,
 							Optional: true,
@@ -330,6 +337,33 @@ func resourceMysqlFlexibleServer() *pluginsdk.Resource {
 			pluginsdk.ForceNewIfChange("storage.0.size_gb", func(ctx context.Context, old, new, meta interface{}) bool {
 				return new.(int) < old.(int)
 			}),
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				// Accelerated Logs are only available for the Business Critical (Memory Optimized) tier
+				skuName := diff.Get("sku_name").(string)
+				if diff.Get("storage.0.accelerated_logs_enabled").(bool) && skuName != "" && !strings.HasPrefix(skuName, "MO_") {
+					return fmt.Errorf("`accelerated_logs_enabled` can only be enabled when `sku_name` is a Business Critical (`MO_`) SKU, got %q", skuName)
+				}
+				return nil
+			},
+			func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
+				zone := diff.Get("zone").(string)
+				standbyZone := diff.Get("high_availability.0.standby_availability_zone").(string)
+				if zone == "" || standbyZone == "" {
+					return nil
+				}
+
+				switch servers.HighAvailabilityMode(diff.Get("high_availability.0.mode").(string)) {
+				case servers.HighAvailabilityModeZoneRedundant:
+					if zone == standbyZone {
+						return fmt.Errorf("`standby_availability_zone` must be different to `zone` when `high_availability.0.mode` is `%s`", servers.HighAvailabilityModeZoneRedundant)
+					}
+				case servers.HighAvailabilityModeSameZone:
+					if zone != standbyZone {
+						return fmt.Errorf("`standby_availability_zone` must be the same as `zone` when `high_availability.0.mode` is `%s`", servers.HighAvailabilityModeSameZone)
+					}
+				}
+				return nil
+			},
 		),
 	}
 }
@@ -392,7 +426,7 @@ func resourceMysqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface
 		}
 	}
 
-	storageSettings := expandArmServerStorage(d.Get("storage").([]interface{}))
+	storageSettings := expandArmServerStorage(d.Get("storage").([]interface{}), d.HasChange("storage.0.accelerated_logs_enabled"))
 	if storageSettings != nil {
 		if storageSettings.Iops != nil && *storageSettings.AutoIoScaling == servers.EnableStatusEnumEnabled {
 			return fmt.Errorf("`iops` can not be set if `io_scaling_enabled` is set to true")
@@ -410,7 +444,7 @@ func resourceMysqlFlexibleServerCreate(d *pluginsdk.ResourceData, meta interface
 		Properties: &servers.ServerProperties{
 			CreateMode:       &createMode,
 			Version:          &version,
-			Storage:          expandArmServerStorage(d.Get("storage").([]interface{})),
+			Storage:          expandArmServerStorage(d.Get("storage").([]interface{}), d.HasChange("storage.0.accelerated_logs_enabled")),
 			Network:          expandArmServerNetwork(d),
 			HighAvailability: expandFlexibleServerHighAvailability(d.Get("high_availability").([]interface{})),
 			Backup:           expandArmServerBackup(d),
@@ -657,7 +691,7 @@ func resourceMysqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta interface
 	if d.HasChange("storage") && d.Get("storage.0.auto_grow_enabled").(bool) {
 		parameters := servers.ServerForUpdate{
 			Properties: &servers.ServerPropertiesForUpdate{
-				Storage: expandArmServerStorage(d.Get("storage").([]interface{})),
+				Storage: expandArmServerStorage(d.Get("storage").([]interface{}), d.HasChange("storage.0.accelerated_logs_enabled")),
 			},
 		}
 
@@ -674,6 +708,19 @@ func resourceMysqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta interface
 			return fmt.Errorf("failing over %s: %+v", *id, err)
 		}
 	} else if d.HasChange("high_availability") {
+		// validate the requested Standby Availability Zone against the zones available for the server's region before
+		// disabling High Availability, since the server would otherwise be left without High Availability
+		if standbyZone := d.Get("high_availability.0.standby_availability_zone").(string); standbyZone != "" && d.Get("high_availability.0.mode").(string) == string(servers.HighAvailabilityModeZoneRedundant) {
+			validateClient := meta.(*clients.Client).MySQL.FlexibleServers.ServerValidateEstimateHighAvailability
+			validateId := servervalidateestimatehighavailability.NewFlexibleServerID(id.SubscriptionId, id.ResourceGroupName, id.FlexibleServerName)
+			input := servervalidateestimatehighavailability.HighAvailabilityValidationEstimation{
+				ExpectedStandbyAvailabilityZone: pointer.To(standbyZone),
+			}
+			if _, err := validateClient.ServersValidateEstimateHighAvailability(ctx, validateId, input); err != nil {
+				return fmt.Errorf("validating `standby_availability_zone` %q for %s: %+v", standbyZone, *id, err)
+			}
+		}
+
 		mode := servers.HighAvailabilityModeDisabled
 		parameters := servers.ServerForUpdate{
 			Properties: &servers.ServerPropertiesForUpdate{
@@ -753,7 +800,7 @@ func resourceMysqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta interface
 	if d.HasChange("storage") && !d.Get("storage.0.auto_grow_enabled").(bool) {
 		parameters := servers.ServerForUpdate{
 			Properties: &servers.ServerPropertiesForUpdate{
-				Storage: expandArmServerStorage(d.Get("storage").([]interface{})),
+				Storage: expandArmServerStorage(d.Get("storage").([]interface{}), d.HasChange("storage.0.accelerated_logs_enabled")),
 			},
 		}
 
@@ -814,7 +861,7 @@ func expandArmServerMaintenanceWindow(input []interface{}) *servers.MaintenanceW
 	return &maintenanceWindow
 }
 
-func expandArmServerStorage(inputs []interface{}) *servers.Storage {
+func expandArmServerStorage(inputs []interface{}, includeAcceleratedLogs bool) *servers.Storage {
 	if len(inputs) == 0 || inputs[0] == nil {
 		return nil
 	}
@@ -835,6 +882,16 @@ func expandArmServerStorage(inputs []interface{}) *servers.Storage {
 		AutoIoScaling: &autoIoScaling,
 	}
 
+	// `accelerated_logs_enabled` is only sent when it's been configured, so that a value set outside of Terraform
+	// isn't overwritten
+	if includeAcceleratedLogs {
+		logOnDisk := servers.EnableStatusEnumDisabled
+		if v := input["accelerated_logs_enabled"].(bool); v {
+			logOnDisk = servers.EnableStatusEnumEnabled
+		}
+		storage.LogOnDisk = &logOnDisk
+	}
+
 	if v := input["size_gb"].(int); v != 0 {
 		storage.StorageSizeGB = utils.Int64(int64(v))
 	}
@@ -862,10 +919,11 @@ func flattenArmServerStorage(storage *servers.Storage) []interface{} {
 
 	return []interface{}{
 		map[string]interface{}{
-			"size_gb":            size,
-			"iops":               iops,
-			"auto_grow_enabled":  *storage.AutoGrow == servers.EnableStatusEnumEnabled,
-			"io_scaling_enabled": *storage.AutoIoScaling == servers.EnableStatusEnumEnabled,
+			"size_gb":                  size,
+			"iops":                     iops,
+			"auto_grow_enabled":        *storage.AutoGrow == servers.EnableStatusEnumEnabled,
+			"io_scaling_enabled":       *storage.AutoIoScaling == servers.EnableStatusEnumEnabled,
+			"accelerated_logs_enabled": pointer.From(storage.LogOnDisk) == servers.EnableStatusEnumEnabled,
 		},
 	}
 }
@@ -388,6 +388,30 @@ func TestAccMySqlFlexibleServer_updateStorage(t *testing.T) {
 	})
 }
 
+func TestAccMySqlFlexibleServer_acceleratedLogs(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server", "test")
+	r := MySqlFlexibleServerResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.acceleratedLogs(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage.0.accelerated_logs_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("administrator_password"),
+		{
+			Config: r.acceleratedLogs(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("storage.0.accelerated_logs_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("administrator_password"),
+	})
+}
+
 func TestAccMySqlFlexibleServer_updateReplicationRole(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_mysql_flexible_server", "test")
 	r := MySqlFlexibleServerResource{}
@@ -1063,6 +1087,27 @@ resource "azurerm_mysql_flexible_server" "test" {
 `, r.template(data), data.RandomInteger, sizeGB, iops, autoGrowEnabled, ioScalingEnabled)
 }
 
+func (r MySqlFlexibleServerResource) acceleratedLogs(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_mysql_flexible_server" "test" {
+  name                   = "acctest-fs-%d"
+  resource_group_name    = azurerm_resource_group.test.name
+  location               = azurerm_resource_group.test.location
+  administrator_login    = "adminTerraform"
+  administrator_password = "QAZwsx123"
+  sku_name               = "MO_Standard_E2ds_v4"
+  version                = "8.0.21"
+  zone                   = "1"
+
+  storage {
+    accelerated_logs_enabled = %t
+  }
+}
+`, r.template(data), data.RandomInteger, enabled)
+}
+
 func (r MySqlFlexibleServerResource) updateStorageNoIOPS(data acceptance.TestData, sizeGB int, autoGrowEnabled bool, ioScalingEnabled bool) string {
 	return fmt.Sprintf(`
 %s
@@ -4,6 +4,7 @@
 package netapp
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
@@ -309,6 +310,16 @@ func resourceNetAppVolume() *pluginsdk.Resource {
 				},
 			},
 
+			"data_protection_replication_mirror_state": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				Computed: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					string(volumesreplication.MirrorStateBroken),
+					string(volumesreplication.MirrorStateMirrored),
+				}, false),
+			},
+
 			"data_protection_snapshot_policy": {
 				Type:     pluginsdk.TypeList,
 				Optional: true,
@@ -638,6 +649,10 @@ func resourceNetAppVolumeCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		if err := waitForReplAuthorization(ctx, replicationClient, *replVolID); err != nil {
 			return err
 		}
+
+		if err := updateNetAppVolumeReplicationMirrorState(ctx, d, meta, id); err != nil {
+			return err
+		}
 	}
 
 	d.SetId(id.ID())
@@ -750,6 +765,12 @@ func resourceNetAppVolumeUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		return err
 	}
 
+	if d.HasChange("data_protection_replication_mirror_state") {
+		if err := updateNetAppVolumeReplicationMirrorState(ctx, d, meta, *id); err != nil {
+			return err
+		}
+	}
+
 	return resourceNetAppVolumeRead(d, meta)
 }
 
@@ -832,6 +853,20 @@ func resourceNetAppVolumeRead(d *pluginsdk.ResourceData, meta interface{}) error
 		if err := d.Set("data_protection_replication", flattenNetAppVolumeDataProtectionReplication(props.DataProtection)); err != nil {
 			return fmt.Errorf("setting `data_protection_replication`: %+v", err)
 		}
+
+		mirrorState := ""
+		if isNetAppVolumeReplicationDestination(props.DataProtection) {
+			replicationClient := meta.(*clients.Client).NetApp.VolumeReplicationClient
+			replicationStatus, err := replicationClient.VolumesReplicationStatus(ctx, volumesreplication.NewVolumeID(id.SubscriptionId, id.ResourceGroupName, id.NetAppAccountName, id.CapacityPoolName, id.VolumeName))
+			if err != nil && !response.WasNotFound(replicationStatus.HttpResponse) {
+				return fmt.Errorf("retrieving replication status for %s: %+v", id, err)
+			}
+			if model := replicationStatus.Model; model != nil && model.MirrorState != nil {
+				mirrorState = string(*model.MirrorState)
+			}
+		}
+		d.Set("data_protection_replication_mirror_state", mirrorState)
+
 		if err := d.Set("data_protection_snapshot_policy", flattenNetAppVolumeDataProtectionSnapshotPolicy(props.DataProtection)); err != nil {
 			return fmt.Errorf("setting `data_protection_snapshot_policy`: %+v", err)
 		}
@@ -993,6 +1028,92 @@ func resourceNetAppVolumeDelete(d *pluginsdk.ResourceData, meta interface{}) err
 	return nil
 }
 
+// updateNetAppVolumeReplicationMirrorState breaks, resyncs or re-establishes the replication of a data protection
+// destination volume so that its mirror state matches `data_protection_replication_mirror_state`
+func updateNetAppVolumeReplicationMirrorState(ctx context.Context, d *pluginsdk.ResourceData, meta interface{}, id volumes.VolumeId) error {
+	replicationClient := meta.(*clients.Client).NetApp.VolumeReplicationClient
+
+	desiredState := d.Get("data_protection_replication_mirror_state").(string)
+	if desiredState == "" {
+		return nil
+	}
+
+	dataProtectionReplication := expandNetAppVolumeDataProtectionReplication(d.Get("data_protection_replication").([]interface{}))
+	if !isNetAppVolumeReplicationDestination(dataProtectionReplication) {
+		return fmt.Errorf("`data_protection_replication_mirror_state` can only be specified for a data protection replication destination volume, %s", id)
+	}
+
+	replicaVolumeId := volumesreplication.NewVolumeID(id.SubscriptionId, id.ResourceGroupName, id.NetAppAccountName, id.CapacityPoolName, id.VolumeName)
+
+	currentState := ""
+	res, err := replicationClient.VolumesReplicationStatus(ctx, replicaVolumeId)
+	if err != nil && !response.WasNotFound(res.HttpResponse) {
+		return fmt.Errorf("retrieving replication status for %s: %+v", id, err)
+	}
+	replicationExists := !response.WasNotFound(res.HttpResponse)
+	if model := res.Model; model != nil && model.MirrorState != nil {
+		currentState = string(*model.MirrorState)
+	}
+
+	if strings.EqualFold(currentState, desiredState) {
+		return nil
+	}
+
+	switch desiredState {
+	case string(volumesreplication.MirrorStateBroken):
+		if !replicationExists {
+			return fmt.Errorf("breaking replication for %s: the replication relationship does not exist", id)
+		}
+
+		// the baseline transfer needs to complete before the replication can be broken
+		if strings.EqualFold(currentState, string(volumesreplication.MirrorStateUninitialized)) {
+			if err := waitForReplMirrorState(ctx, replicationClient, replicaVolumeId, "mirrored"); err != nil {
+				return fmt.Errorf("waiting for replica %s to become 'mirrored': %+v", id, err)
+			}
+		}
+
+		// Can't use VolumesBreakReplicationThenPoll because from time to time the LRO SDK fails,
+		// please see Pandora's issue: https://github.com/hashicorp/pandora/issues/4571
+		if _, err := replicationClient.VolumesBreakReplication(ctx, replicaVolumeId, volumesreplication.BreakReplicationRequest{
+			ForceBreakReplication: utils.Bool(true),
+		}); err != nil {
+			return fmt.Errorf("breaking replication for %s: %+v", id, err)
+		}
+
+		if err := waitForReplMirrorState(ctx, replicationClient, replicaVolumeId, "broken"); err != nil {
+			return fmt.Errorf("waiting for the breaking of replication for %s: %+v", id, err)
+		}
+
+	case string(volumesreplication.MirrorStateMirrored):
+		if strings.EqualFold(currentState, string(volumesreplication.MirrorStateUninitialized)) {
+			// the baseline transfer is still in progress, so there's nothing to do other than wait for it to complete
+			log.Printf("[DEBUG] Waiting for the baseline transfer of %s to complete", id)
+		} else if replicationExists {
+			// resyncing from the destination volume discards any changes made to it since the replication was broken
+			if err := replicationClient.VolumesResyncReplicationThenPoll(ctx, replicaVolumeId); err != nil {
+				return fmt.Errorf("resyncing replication for %s: %+v", id, err)
+			}
+		} else {
+			// the replication relationship has been deleted, so needs to be re-established from the source volume
+			if err := replicationClient.VolumesReestablishReplicationThenPoll(ctx, replicaVolumeId, volumesreplication.ReestablishReplicationRequest{
+				SourceVolumeId: pointer.To(dataProtectionReplication.Replication.RemoteVolumeResourceId),
+			}); err != nil {
+				return fmt.Errorf("re-establishing replication for %s: %+v", id, err)
+			}
+		}
+
+		if err := waitForReplMirrorState(ctx, replicationClient, replicaVolumeId, "mirrored"); err != nil {
+			return fmt.Errorf("waiting for replica %s to become 'mirrored': %+v", id, err)
+		}
+	}
+
+	return nil
+}
+
+func isNetAppVolumeReplicationDestination(input *volumes.VolumePropertiesDataProtection) bool {
+	return input != nil && input.Replication != nil && input.Replication.EndpointType != nil && strings.EqualFold(string(*input.Replication.EndpointType), string(volumes.EndpointTypeDst))
+}
+
 func expandNetAppVolumeExportPolicyRule(input []interface{}) *volumes.VolumePropertiesExportPolicy {
 	results := make([]volumes.ExportPolicyRule, 0)
 	for _, item := range input {
@@ -174,6 +174,38 @@ func TestAccNetAppVolume_crossRegionReplication(t *testing.T) {
 	})
 }
 
+func TestAccNetAppVolume_crossRegionReplicationBreakAndResync(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_netapp_volume", "test_secondary")
+	r := NetAppVolumeResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.crossRegionReplicationMirrorState(data, "Mirrored"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_protection_replication_mirror_state").HasValue("Mirrored"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.crossRegionReplicationMirrorState(data, "Broken"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_protection_replication_mirror_state").HasValue("Broken"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.crossRegionReplicationMirrorState(data, "Mirrored"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("data_protection_replication_mirror_state").HasValue("Mirrored"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccNetAppVolume_nfsv3FromSnapshot(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_netapp_volume", "test_snapshot_vol")
 	r := NetAppVolumeResource{}
@@ -804,8 +836,16 @@ resource "azurerm_netapp_volume" "test" {
 `, template, data.RandomInteger)
 }
 
-func (NetAppVolumeResource) crossRegionReplication(data acceptance.TestData) string {
+func (r NetAppVolumeResource) crossRegionReplication(data acceptance.TestData) string {
+	return r.crossRegionReplicationMirrorState(data, "")
+}
+
+func (NetAppVolumeResource) crossRegionReplicationMirrorState(data acceptance.TestData, mirrorState string) string {
 	template := NetAppVolumeResource{}.templateForCrossRegionReplication(data)
+	mirrorStateConfig := ""
+	if mirrorState != "" {
+		mirrorStateConfig = fmt.Sprintf("data_protection_replication_mirror_state = %q", mirrorState)
+	}
 	return fmt.Sprintf(`
 %[1]s
 
@@ -866,12 +906,14 @@ resource "azurerm_netapp_volume" "test_secondary" {
     replication_frequency     = "10minutes"
   }
 
+  %[4]s
+
   tags = {
     "CreatedOnDate"    = "2022-07-08T23:50:21Z",
     "SkipASMAzSecPack" = "true"
   }
 }
-`, template, data.RandomInteger, "eastus2")
+`, template, data.RandomInteger, "eastus2", mirrorStateConfig)
 }
 
 func (NetAppVolumeResource) nfsv3FromSnapshot(data acceptance.TestData) string {
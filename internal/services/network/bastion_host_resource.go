@@ -174,10 +174,60 @@ func resourceBastionHost() *pluginsdk.Resource {
 				}
 				return false
 			}),
+			resourceBastionHostValidateSkuFeatures,
 		),
 	}
 }
 
+func resourceBastionHostValidateSkuFeatures(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+	sku := bastionhosts.BastionHostSkuName(d.Get("sku").(string))
+	standardOrPremium := sku == bastionhosts.BastionHostSkuNameStandard || sku == bastionhosts.BastionHostSkuNamePremium
+
+	if d.Get("scale_units").(int) > 2 && !standardOrPremium {
+		return fmt.Errorf("`scale_units` only can be changed when `sku` is `Standard` or `Premium`. `scale_units` is always `2` when `sku` is `Basic`")
+	}
+
+	for _, feature := range []string{"file_copy_enabled", "ip_connect_enabled", "kerberos_enabled", "shareable_link_enabled", "tunneling_enabled"} {
+		if d.Get(feature).(bool) && !standardOrPremium {
+			return fmt.Errorf("`%s` is only supported when `sku` is `Standard` or `Premium`", feature)
+		}
+	}
+
+	if d.Get("session_recording_enabled").(bool) && sku != bastionhosts.BastionHostSkuNamePremium {
+		return fmt.Errorf("`session_recording_enabled` is only supported when `sku` is `Premium`")
+	}
+
+	// the Developer SKU is deployed into shared infrastructure, so is connected to a Virtual Network rather than a dedicated subnet
+	// these values can be unknown at plan time (e.g. when `dynamic` blocks or references to other resources are used),
+	// in which case they're validated by the API during apply instead
+	config := d.GetRawConfig().AsValueMap()
+	virtualNetworkId := config["virtual_network_id"]
+	ipConfiguration := config["ip_configuration"]
+	if !virtualNetworkId.IsKnown() || !ipConfiguration.IsKnown() {
+		return nil
+	}
+
+	virtualNetworkIdSet := !virtualNetworkId.IsNull()
+	ipConfigurationSet := !ipConfiguration.IsNull() && ipConfiguration.LengthInt() > 0
+	if sku == bastionhosts.BastionHostSkuNameDeveloper {
+		if !virtualNetworkIdSet {
+			return fmt.Errorf("`virtual_network_id` is required when `sku` is `Developer`")
+		}
+		if ipConfigurationSet {
+			return fmt.Errorf("`ip_configuration` is not supported when `sku` is `Developer`")
+		}
+	} else if sku != "" {
+		if virtualNetworkIdSet {
+			return fmt.Errorf("`virtual_network_id` is only supported when `sku` is `Developer`")
+		}
+		if !ipConfigurationSet {
+			return fmt.Errorf("`ip_configuration` is required when `sku` is `%s`", sku)
+		}
+	}
+
+	return nil
+}
+
 func resourceBastionHostCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Network.BastionHostsClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -188,7 +238,6 @@ func resourceBastionHostCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	id := bastionhosts.NewBastionHostID(subscriptionId, d.Get("resource_group_name").(string), d.Get("name").(string))
 
-	scaleUnits := d.Get("scale_units").(int)
 	sku := bastionhosts.BastionHostSkuName(d.Get("sku").(string))
 	fileCopyEnabled := d.Get("file_copy_enabled").(bool)
 	ipConnectEnabled := d.Get("ip_connect_enabled").(bool)
@@ -197,34 +246,6 @@ func resourceBastionHostCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 	tunnelingEnabled := d.Get("tunneling_enabled").(bool)
 	sessionRecordingEnabled := d.Get("session_recording_enabled").(bool)
 
-	if scaleUnits > 2 && (sku != bastionhosts.BastionHostSkuNameStandard && sku != bastionhosts.BastionHostSkuNamePremium) {
-		return fmt.Errorf("`scale_units` only can be changed when `sku` is `Standard` or `Premium`. `scale_units` is always `2` when `sku` is `Basic`")
-	}
-
-	if fileCopyEnabled && (sku != bastionhosts.BastionHostSkuNameStandard && sku != bastionhosts.BastionHostSkuNamePremium) {
-		return fmt.Errorf("`file_copy_enabled` is only supported when `sku` is `Standard` or `Premium`")
-	}
-
-	if ipConnectEnabled && (sku != bastionhosts.BastionHostSkuNameStandard && sku != bastionhosts.BastionHostSkuNamePremium) {
-		return fmt.Errorf("`ip_connect_enabled` is only supported when `sku` is `Standard` or `Premium`")
-	}
-
-	if kerberosEnabled && (sku != bastionhosts.BastionHostSkuNameStandard && sku != bastionhosts.BastionHostSkuNamePremium) {
-		return fmt.Errorf("`kerberos_enabled` is only supported when `sku` is `Standard` or `Premium`")
-	}
-
-	if shareableLinkEnabled && (sku != bastionhosts.BastionHostSkuNameStandard && sku != bastionhosts.BastionHostSkuNamePremium) {
-		return fmt.Errorf("`shareable_link_enabled` is only supported when `sku` is `Standard` or `Premium`")
-	}
-
-	if tunnelingEnabled && (sku != bastionhosts.BastionHostSkuNameStandard && sku != bastionhosts.BastionHostSkuNamePremium) {
-		return fmt.Errorf("`tunneling_enabled` is only supported when `sku` is `Standard` or `Premium`")
-	}
-
-	if sessionRecordingEnabled && sku != bastionhosts.BastionHostSkuNamePremium {
-		return fmt.Errorf("`session_recording_enabled` is only supported when `sku` is `Premium`")
-	}
-
 	existing, err := client.Get(ctx, id)
 	if err != nil {
 		if !response.WasNotFound(existing.HttpResponse) {
@@ -282,15 +303,9 @@ func resourceBastionHostCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 	}
 
 	if v, ok := d.GetOk("virtual_network_id"); ok {
-		if sku != bastionhosts.BastionHostSkuNameDeveloper {
-			return fmt.Errorf("`virtual_network_id` is only supported when `sku` is `Developer`")
-		}
-
 		parameters.Properties.VirtualNetwork = &bastionhosts.SubResource{
 			Id: pointer.To(v.(string)),
 		}
-	} else if sku == bastionhosts.BastionHostSkuNameDeveloper {
-		return fmt.Errorf("`virtual_network_id` is required when `sku` is `Developer`")
 	}
 
 	if err := client.CreateOrUpdateThenPoll(ctx, id, parameters); err != nil {
@@ -340,49 +355,31 @@ func resourceBastionHostUpdate(d *pluginsdk.ResourceData, meta interface{}) erro
 
 	if d.HasChange("file_copy_enabled") {
 		fileCopyEnabled := d.Get("file_copy_enabled").(bool)
-		if fileCopyEnabled && (sku != bastionhosts.BastionHostSkuNameStandard && sku != bastionhosts.BastionHostSkuNamePremium) {
-			return fmt.Errorf("`file_copy_enabled` is only supported when `sku` is `Standard` or `Premium`")
-		}
 		payload.Properties.EnableFileCopy = pointer.To(fileCopyEnabled)
 	}
 
 	if d.HasChange("ip_connect_enabled") {
 		ipConnectEnabled := d.Get("ip_connect_enabled").(bool)
-		if ipConnectEnabled && (sku != bastionhosts.BastionHostSkuNameStandard && sku != bastionhosts.BastionHostSkuNamePremium) {
-			return fmt.Errorf("`ip_connect_enabled` is only supported when `sku` is `Standard` or `Premium`")
-		}
 		payload.Properties.EnableIPConnect = pointer.To(ipConnectEnabled)
 	}
 
 	if d.HasChange("scale_units") {
 		scaleUnits := d.Get("scale_units").(int)
-		if scaleUnits > 2 && (sku != bastionhosts.BastionHostSkuNameStandard && sku != bastionhosts.BastionHostSkuNamePremium) {
-			return fmt.Errorf("`scale_units` only can be changed when `sku` is `Standard` or `Premium`. `scale_units` is always `2` when `sku` is `Basic`")
-		}
 		payload.Properties.ScaleUnits = pointer.To(int64(scaleUnits))
 	}
 
 	if d.HasChange("shareable_link_enabled") {
 		shareableLinkEnabled := d.Get("shareable_link_enabled").(bool)
-		if shareableLinkEnabled && (sku != bastionhosts.BastionHostSkuNameStandard && sku != bastionhosts.BastionHostSkuNamePremium) {
-			return fmt.Errorf("`shareable_link_enabled` is only supported when `sku` is `Standard` or `Premium`")
-		}
 		payload.Properties.EnableShareableLink = pointer.To(shareableLinkEnabled)
 	}
 
 	if d.HasChange("tunneling_enabled") {
 		tunnelingEnabled := d.Get("tunneling_enabled").(bool)
-		if tunnelingEnabled && (sku != bastionhosts.BastionHostSkuNameStandard && sku != bastionhosts.BastionHostSkuNamePremium) {
-			return fmt.Errorf("`tunneling_enabled` is only supported when `sku` is `Standard` or `Premium`")
-		}
 		payload.Properties.EnableTunneling = pointer.To(tunnelingEnabled)
 	}
 
 	if d.HasChange("session_recording_enabled") {
 		sessionRecordingEnabled := d.Get("session_recording_enabled").(bool)
-		if sessionRecordingEnabled && sku != bastionhosts.BastionHostSkuNamePremium {
-			return fmt.Errorf("`session_recording_enabled` is only supported when `sku` is `Premium`")
-		}
 		payload.Properties.EnableSessionRecording = pointer.To(sessionRecordingEnabled)
 	}
 
@@ -6,6 +6,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-01-01/bastionhosts"
@@ -139,6 +140,18 @@ func TestAccBastionHost_developerSku(t *testing.T) {
 	})
 }
 
+func TestAccBastionHost_developerSkuUnsupportedFeature(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_bastion_host", "test")
+	r := BastionHostResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.developerSkuUnsupportedFeature(data),
+			ExpectError: regexp.MustCompile("`tunneling_enabled` is only supported when `sku` is `Standard` or `Premium`"),
+		},
+	})
+}
+
 func TestAccBastionHost_premiumSku(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_bastion_host", "test")
 	r := BastionHostResource{}
@@ -464,6 +477,35 @@ resource "azurerm_bastion_host" "test" {
 `, data.RandomInteger, data.Locations.Ternary, data.RandomString, data.RandomString)
 }
 
+func (BastionHostResource) developerSkuUnsupportedFeature(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-bastion-%d"
+  location = "%s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestVNet%s"
+  address_space       = ["192.168.1.0/24"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_bastion_host" "test" {
+  name                = "acctestBastion%s"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "Developer"
+  virtual_network_id  = azurerm_virtual_network.test.id
+  tunneling_enabled   = true
+}
+`, data.RandomInteger, data.Locations.Ternary, data.RandomString, data.RandomString)
+}
+
 func (BastionHostResource) premiumSku(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -12,6 +12,7 @@ import (
 	network_2024_05_01 "github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/privateendpointconnections"
 )
 
 type Client struct {
@@ -21,6 +22,8 @@ type Client struct {
 	// VMSS Data Source requires the Network Interfaces and VMSSPublicIpAddresses client from `2023-09-01` for the `ListVirtualMachineScaleSetVMNetworkInterfacesComplete` method
 	NetworkInterfacesClient     *networkinterfaces.NetworkInterfacesClient
 	VMSSPublicIPAddressesClient *vmsspublicipaddresses.VMSSPublicIPAddressesClient
+	// PrivateEndpointConnectionsClient manages the Private Endpoint Connections of the supported target resources, using the pinned API Version of each Resource Provider
+	PrivateEndpointConnectionsClient *privateendpointconnections.Client
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -42,6 +45,11 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(VMSSPublicIPAddressesClient.Client, o.Authorizers.ResourceManager)
 
+	PrivateEndpointConnectionsClient, err := privateendpointconnections.NewClient(o)
+	if err != nil {
+		return nil, fmt.Errorf("building Private Endpoint Connections Client: %+v", err)
+	}
+
 	client, err := network_2024_05_01.NewClientWithBaseURI(o.Environment.ResourceManager, func(c *resourcemanager.Client) {
 		o.Configure(c, o.Authorizers.ResourceManager)
 	})
@@ -50,9 +58,10 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 
 	return &Client{
-		BastionHostsClient:          BastionHostsClient,
-		NetworkInterfacesClient:     NetworkInterfacesClient,
-		VMSSPublicIPAddressesClient: VMSSPublicIPAddressesClient,
-		Client:                      client,
+		BastionHostsClient:               BastionHostsClient,
+		PrivateEndpointConnectionsClient: PrivateEndpointConnectionsClient,
+		NetworkInterfacesClient:          NetworkInterfacesClient,
+		VMSSPublicIPAddressesClient:      VMSSPublicIPAddressesClient,
+		Client:                           client,
 	}, nil
 }
@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/expressroutecircuitconnections"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/expressroutecircuits"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
@@ -70,10 +71,28 @@ func resourceExpressRouteCircuitConnection() *pluginsdk.Resource {
 			},
 
 			"authorization_key": {
-				Type:         pluginsdk.TypeString,
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				ValidateFunc:  validation.IsUUID,
+				ConflictsWith: []string{"authorization_key_wo"},
+			},
+
+			"authorization_key_wo": {
+				Type:          pluginsdk.TypeString,
+				Optional:      true,
+				Sensitive:     true,
+				WriteOnly:     true,
+				ValidateFunc:  validation.IsUUID,
+				ConflictsWith: []string{"authorization_key"},
+				RequiredWith:  []string{"authorization_key_wo_version"},
+			},
+
+			"authorization_key_wo_version": {
+				Type:         pluginsdk.TypeInt,
 				Optional:     true,
-				Sensitive:    true,
-				ValidateFunc: validation.IsUUID,
+				ValidateFunc: validation.IntAtLeast(1),
+				RequiredWith: []string{"authorization_key_wo"},
 			},
 
 			"address_prefix_ipv6": {
@@ -127,9 +146,11 @@ func resourceExpressRouteCircuitConnectionCreate(d *pluginsdk.ResourceData, meta
 		},
 	}
 
-	if v, ok := d.GetOk("authorization_key"); ok {
-		expressRouteCircuitConnectionParameters.Properties.AuthorizationKey = pointer.To(v.(string))
+	authorizationKey, err := expandExpressRouteCircuitConnectionAuthorizationKey(d)
+	if err != nil {
+		return err
 	}
+	expressRouteCircuitConnectionParameters.Properties.AuthorizationKey = authorizationKey
 
 	if v, ok := d.GetOk("address_prefix_ipv6"); ok {
 		circuitId := expressroutecircuits.NewExpressRouteCircuitID(circuitPeeringId.SubscriptionId, circuitPeeringId.ResourceGroupName, circuitPeeringId.CircuitName)
@@ -190,7 +211,12 @@ func resourceExpressRouteCircuitConnectionRead(d *pluginsdk.ResourceData, meta i
 			if props.AuthorizationKey != nil && *props.AuthorizationKey != "*****************" {
 				authorizationKey = *props.AuthorizationKey
 			}
+			// the `authorization_key_wo` is write-only, so the key shouldn't be persisted into the state when it's used
+			if _, ok := d.GetOk("authorization_key_wo_version"); ok {
+				authorizationKey = ""
+			}
 			d.Set("authorization_key", authorizationKey)
+			d.Set("authorization_key_wo_version", d.Get("authorization_key_wo_version").(int))
 
 			addressPrefixIPv6 := ""
 			if props.IPv6CircuitConnectionConfig != nil && props.IPv6CircuitConnectionConfig.AddressPrefix != nil {
@@ -245,9 +271,11 @@ func resourceExpressRouteCircuitConnectionUpdate(d *pluginsdk.ResourceData, meta
 		},
 	}
 
-	if v, ok := d.GetOk("authorization_key"); ok {
-		expressRouteCircuitConnectionParameters.Properties.AuthorizationKey = pointer.To(v.(string))
+	authorizationKey, err := expandExpressRouteCircuitConnectionAuthorizationKey(d)
+	if err != nil {
+		return err
 	}
+	expressRouteCircuitConnectionParameters.Properties.AuthorizationKey = authorizationKey
 
 	if v, ok := d.GetOk("address_prefix_ipv6"); ok {
 		circuitId := expressroutecircuits.NewExpressRouteCircuitID(circuitPeeringId.SubscriptionId, circuitPeeringId.ResourceGroupName, circuitPeeringId.CircuitName)
@@ -289,3 +317,19 @@ func resourceExpressRouteCircuitConnectionDelete(d *pluginsdk.ResourceData, meta
 
 	return nil
 }
+
+func expandExpressRouteCircuitConnectionAuthorizationKey(d *pluginsdk.ResourceData) (*string, error) {
+	authorizationKeyWo, err := pluginsdk.GetWriteOnly(d, "authorization_key_wo", cty.String)
+	if err != nil {
+		return nil, err
+	}
+	if !authorizationKeyWo.IsNull() {
+		return pointer.To(authorizationKeyWo.AsString()), nil
+	}
+
+	if v, ok := d.GetOk("authorization_key"); ok {
+		return pointer.To(v.(string)), nil
+	}
+
+	return nil, nil
+}
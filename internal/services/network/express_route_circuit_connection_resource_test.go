@@ -10,9 +10,13 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/expressroutecircuitconnections"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/provider/framework"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 )
 
@@ -21,10 +25,11 @@ type ExpressRouteCircuitConnectionResource struct{}
 func TestAccExpressRouteCircuitConnection(t *testing.T) {
 	acceptance.RunTestsInSequence(t, map[string]map[string]func(t *testing.T){
 		"Resource": {
-			"basic":          testAccExpressRouteCircuitConnection_basic,
-			"requiresImport": testAccExpressRouteCircuitConnection_requiresImport,
-			"complete":       testAccExpressRouteCircuitConnection_complete,
-			"update":         testAccExpressRouteCircuitConnection_update,
+			"basic":                     testAccExpressRouteCircuitConnection_basic,
+			"requiresImport":            testAccExpressRouteCircuitConnection_requiresImport,
+			"complete":                  testAccExpressRouteCircuitConnection_complete,
+			"update":                    testAccExpressRouteCircuitConnection_update,
+			"writeOnlyAuthorizationKey": testAccExpressRouteCircuitConnection_writeOnlyAuthorizationKey,
 		},
 	})
 }
@@ -106,6 +111,38 @@ func testAccExpressRouteCircuitConnection_update(t *testing.T) {
 	})
 }
 
+func testAccExpressRouteCircuitConnection_writeOnlyAuthorizationKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_express_route_circuit_connection", "test")
+	r := ExpressRouteCircuitConnectionResource{}
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.11.0"))),
+		},
+		ProtoV5ProviderFactories: framework.ProtoV5ProviderFactoriesInit(context.Background(), "azurerm"),
+		Steps: []acceptance.TestStep{
+			{
+				Config: r.writeOnlyAuthorizationKey(data, "846a1918-b7a2-4917-b43c-8c4cdaee006a", 1),
+				Check: acceptance.ComposeTestCheckFunc(
+					check.That(data.ResourceName).ExistsInAzure(r),
+					check.That(data.ResourceName).Key("authorization_key").IsEmpty(),
+					check.That(data.ResourceName).Key("authorization_key_wo_version").HasValue("1"),
+				),
+			},
+			data.ImportStep("authorization_key", "authorization_key_wo_version"),
+			{
+				Config: r.writeOnlyAuthorizationKey(data, "946a1918-b7a2-4917-b43c-8c4cdaee006a", 2),
+				Check: acceptance.ComposeTestCheckFunc(
+					check.That(data.ResourceName).ExistsInAzure(r),
+					check.That(data.ResourceName).Key("authorization_key").IsEmpty(),
+					check.That(data.ResourceName).Key("authorization_key_wo_version").HasValue("2"),
+				),
+			},
+			data.ImportStep("authorization_key", "authorization_key_wo_version"),
+		},
+	})
+}
+
 func (r ExpressRouteCircuitConnectionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := expressroutecircuitconnections.ParsePeeringConnectionID(state.ID)
 	if err != nil {
@@ -160,6 +197,21 @@ resource "azurerm_express_route_circuit_connection" "test" {
 `, r.template(data), data.RandomInteger, authorizationKey)
 }
 
+func (r ExpressRouteCircuitConnectionResource) writeOnlyAuthorizationKey(data acceptance.TestData, authorizationKey string, version int) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_express_route_circuit_connection" "test" {
+  name                         = "acctest-ExpressRouteCircuitConn-%d"
+  peering_id                   = azurerm_express_route_circuit_peering.test.id
+  peer_peering_id              = azurerm_express_route_circuit_peering.peer_test.id
+  address_prefix_ipv4          = "192.169.8.0/29"
+  authorization_key_wo         = "%s"
+  authorization_key_wo_version = %d
+}
+`, r.template(data), data.RandomInteger, authorizationKey, version)
+}
+
 func (r ExpressRouteCircuitConnectionResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
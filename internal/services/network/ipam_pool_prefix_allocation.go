@@ -0,0 +1,140 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"regexp"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/ipampools"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/subnets"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualnetworks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// ipamPoolPrefixAllocationSchema returns the schema used to allocate the address space of a Virtual Network or Subnet
+// from a Network Manager IPAM Pool - one allocation may be made per address family (IPv4/IPv6).
+func ipamPoolPrefixAllocationSchema(addressField string) *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:         pluginsdk.TypeList,
+		Optional:     true,
+		MaxItems:     2,
+		ExactlyOneOf: []string{addressField, "ipam_pool_prefix_allocation"},
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"ipam_pool_id": {
+					Type:         pluginsdk.TypeString,
+					Required:     true,
+					ValidateFunc: ipampools.ValidateIPamPoolID,
+				},
+
+				"number_of_ip_addresses": {
+					Type:     pluginsdk.TypeString,
+					Required: true,
+					ValidateFunc: validation.StringMatch(
+						regexp.MustCompile(`^[1-9][0-9]*$`),
+						"`number_of_ip_addresses` must be a positive whole number.",
+					),
+				},
+
+				"allocated_address_prefixes": {
+					Type:     pluginsdk.TypeList,
+					Computed: true,
+					Elem: &pluginsdk.Schema{
+						Type: pluginsdk.TypeString,
+					},
+				},
+			},
+		},
+	}
+}
+
+func expandVirtualNetworkIpamPoolPrefixAllocations(input []interface{}) *[]virtualnetworks.IPamPoolPrefixAllocation {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]virtualnetworks.IPamPoolPrefixAllocation, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, virtualnetworks.IPamPoolPrefixAllocation{
+			NumberOfIPAddresses: pointer.To(v["number_of_ip_addresses"].(string)),
+			Pool: &virtualnetworks.IPamPoolPrefixAllocationPool{
+				Id: pointer.To(v["ipam_pool_id"].(string)),
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenVirtualNetworkIpamPoolPrefixAllocations(input *[]virtualnetworks.IPamPoolPrefixAllocation) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		poolId := ""
+		if item.Pool != nil {
+			poolId = pointer.From(item.Pool.Id)
+			if parsed, err := ipampools.ParseIPamPoolIDInsensitively(poolId); err == nil {
+				poolId = parsed.ID()
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"ipam_pool_id":               poolId,
+			"number_of_ip_addresses":     pointer.From(item.NumberOfIPAddresses),
+			"allocated_address_prefixes": pointer.From(item.AllocatedAddressPrefixes),
+		})
+	}
+
+	return results
+}
+
+func expandSubnetIpamPoolPrefixAllocations(input []interface{}) *[]subnets.IPamPoolPrefixAllocation {
+	if len(input) == 0 {
+		return nil
+	}
+
+	results := make([]subnets.IPamPoolPrefixAllocation, 0)
+	for _, item := range input {
+		v := item.(map[string]interface{})
+		results = append(results, subnets.IPamPoolPrefixAllocation{
+			NumberOfIPAddresses: pointer.To(v["number_of_ip_addresses"].(string)),
+			Pool: &subnets.IPamPoolPrefixAllocationPool{
+				Id: pointer.To(v["ipam_pool_id"].(string)),
+			},
+		})
+	}
+
+	return &results
+}
+
+func flattenSubnetIpamPoolPrefixAllocations(input *[]subnets.IPamPoolPrefixAllocation) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, item := range *input {
+		poolId := ""
+		if item.Pool != nil {
+			poolId = pointer.From(item.Pool.Id)
+			if parsed, err := ipampools.ParseIPamPoolIDInsensitively(poolId); err == nil {
+				poolId = parsed.ID()
+			}
+		}
+
+		results = append(results, map[string]interface{}{
+			"ipam_pool_id":               poolId,
+			"number_of_ip_addresses":     pointer.From(item.NumberOfIPAddresses),
+			"allocated_address_prefixes": pointer.From(item.AllocatedAddressPrefixes),
+		})
+	}
+
+	return results
+}
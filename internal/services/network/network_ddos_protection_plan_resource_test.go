@@ -31,6 +31,9 @@ func TestAccNetworkDDoSProtectionPlan(t *testing.T) {
 		"datasource": {
 			"basic": testAccNetworkDDoSProtectionPlanDataSource_basic,
 		},
+		"publicIpAssociation": {
+			"withPlan": testAccPublicIpDdosProtectionAssociation_withPlan,
+		},
 	}
 
 	for group, steps := range testCases {
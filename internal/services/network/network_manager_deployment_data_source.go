@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/networkmanagers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ManagerDeploymentDataSource struct{}
+
+var _ sdk.DataSource = ManagerDeploymentDataSource{}
+
+type ManagerDeploymentDataSourceModel struct {
+	NetworkManagerId string   `tfschema:"network_manager_id"`
+	Location         string   `tfschema:"location"`
+	ScopeAccess      string   `tfschema:"scope_access"`
+	CommitTime       string   `tfschema:"commit_time"`
+	ConfigurationIds []string `tfschema:"configuration_ids"`
+	DeploymentStatus string   `tfschema:"deployment_status"`
+	ErrorMessage     string   `tfschema:"error_message"`
+}
+
+func (r ManagerDeploymentDataSource) ResourceType() string {
+	return "azurerm_network_manager_deployment"
+}
+
+func (r ManagerDeploymentDataSource) ModelObject() interface{} {
+	return &ManagerDeploymentDataSourceModel{}
+}
+
+func (r ManagerDeploymentDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"network_manager_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: networkmanagers.ValidateNetworkManagerID,
+		},
+
+		"location": commonschema.LocationWithoutForceNew(),
+
+		"scope_access": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(networkmanagers.ConfigurationTypeConnectivity),
+				string(networkmanagers.ConfigurationTypeSecurityAdmin),
+			}, false),
+		},
+	}
+}
+
+func (r ManagerDeploymentDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"commit_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"configuration_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+
+		"deployment_status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"error_message": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ManagerDeploymentDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.NetworkManagers
+
+			var state ManagerDeploymentDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			networkManagerId, err := networkmanagers.ParseNetworkManagerID(state.NetworkManagerId)
+			if err != nil {
+				return err
+			}
+
+			id := parse.NewNetworkManagerDeploymentID(networkManagerId.SubscriptionId, networkManagerId.ResourceGroupName, networkManagerId.NetworkManagerName, state.Location, state.ScopeAccess)
+
+			// a commit which is still in progress is waited on, so that the final status can be used to gate dependent resources
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+			stateConf := &pluginsdk.StateChangeConf{
+				MinTimeout: 30 * time.Second,
+				Pending:    []string{string(networkmanagers.DeploymentStatusDeploying)},
+				Target: []string{
+					"NotFound",
+					string(networkmanagers.DeploymentStatusDeployed),
+					string(networkmanagers.DeploymentStatusFailed),
+					string(networkmanagers.DeploymentStatusNotStarted),
+				},
+				Refresh: resourceManagerDeploymentResultRefreshFunc(ctx, client, id),
+				Timeout: time.Until(deadline),
+			}
+
+			result, err := stateConf.WaitForStateContext(ctx)
+			if err != nil {
+				return fmt.Errorf("waiting for %s to finish deploying: %+v", id, err)
+			}
+
+			resp, ok := result.(networkmanagers.NetworkManagerDeploymentStatusListOperationResponse)
+			if !ok || resp.Model == nil || resp.Model.Value == nil || len(*resp.Model.Value) == 0 || len(pointer.From((*resp.Model.Value)[0].ConfigurationIds)) == 0 {
+				return fmt.Errorf("%s was not found", id)
+			}
+
+			status := (*resp.Model.Value)[0]
+			state.Location = location.Normalize(state.Location)
+			state.CommitTime = pointer.From(status.CommitTime)
+			state.ConfigurationIds = pointer.From(status.ConfigurationIds)
+			state.DeploymentStatus = string(pointer.From(status.DeploymentStatus))
+			state.ErrorMessage = pointer.From(status.ErrorMessage)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type ManagerDeploymentDataSource struct{}
+
+func testAccNetworkManagerDeploymentDataSource_basicAdmin(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_network_manager_deployment", "test")
+	d := ManagerDeploymentDataSource{}
+	data.DataSourceTestInSequence(t, []acceptance.TestStep{
+		{
+			Config: d.basicAdmin(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("deployment_status").HasValue("Deployed"),
+				check.That(data.ResourceName).Key("configuration_ids.#").HasValue("1"),
+				check.That(data.ResourceName).Key("commit_time").Exists(),
+			),
+		},
+	})
+}
+
+func (d ManagerDeploymentDataSource) basicAdmin(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_network_manager_deployment" "test" {
+  network_manager_id = azurerm_network_manager_deployment.test.network_manager_id
+  location           = azurerm_network_manager_deployment.test.location
+  scope_access       = azurerm_network_manager_deployment.test.scope_access
+}
+`, ManagerDeploymentResource{}.basicAdmin(data))
+}
@@ -0,0 +1,256 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/staticcidrs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+var _ sdk.ResourceWithUpdate = ManagerIpamPoolStaticCidrResource{}
+
+type ManagerIpamPoolStaticCidrResource struct{}
+
+func (ManagerIpamPoolStaticCidrResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return staticcidrs.ValidateStaticCidrID
+}
+
+func (ManagerIpamPoolStaticCidrResource) ResourceType() string {
+	return "azurerm_network_manager_ipam_pool_static_cidr"
+}
+
+func (ManagerIpamPoolStaticCidrResource) ModelObject() interface{} {
+	return &ManagerIpamPoolStaticCidrResourceModel{}
+}
+
+type ManagerIpamPoolStaticCidrResourceModel struct {
+	AddressPrefixes               []string `tfschema:"address_prefixes"`
+	Description                   string   `tfschema:"description"`
+	IpamPoolId                    string   `tfschema:"ipam_pool_id"`
+	Name                          string   `tfschema:"name"`
+	NumberOfIPAddressesToAllocate string   `tfschema:"number_of_ip_addresses_to_allocate"`
+	TotalNumberOfIPAddresses      string   `tfschema:"total_number_of_ip_addresses"`
+}
+
+func (ManagerIpamPoolStaticCidrResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9\_\.\-]{1,64}$`),
+				"`name` must be between 1 and 64 characters long and can only contain letters, numbers, underscores(_), periods(.), and hyphens(-).",
+			),
+		},
+
+		"ipam_pool_id": commonschema.ResourceIDReferenceRequiredForceNew(&staticcidrs.IPamPoolId{}),
+
+		"address_prefixes": {
+			Type:         pluginsdk.TypeList,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"address_prefixes", "number_of_ip_addresses_to_allocate"},
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.IsCIDR,
+			},
+		},
+
+		"number_of_ip_addresses_to_allocate": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ExactlyOneOf: []string{"address_prefixes", "number_of_ip_addresses_to_allocate"},
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[1-9][0-9]*$`),
+				"`number_of_ip_addresses_to_allocate` must be a positive whole number.",
+			),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (ManagerIpamPoolStaticCidrResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"total_number_of_ip_addresses": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.StaticCidrs
+
+			var config ManagerIpamPoolStaticCidrResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			ipamPoolId, err := staticcidrs.ParseIPamPoolID(config.IpamPoolId)
+			if err != nil {
+				return err
+			}
+
+			id := staticcidrs.NewStaticCidrID(ipamPoolId.SubscriptionId, ipamPoolId.ResourceGroupName, ipamPoolId.NetworkManagerName, ipamPoolId.IpamPoolName, config.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			properties := staticcidrs.StaticCidrProperties{}
+			if len(config.AddressPrefixes) > 0 {
+				properties.AddressPrefixes = pointer.To(config.AddressPrefixes)
+			}
+			if config.NumberOfIPAddressesToAllocate != "" {
+				properties.NumberOfIPAddressesToAllocate = pointer.To(config.NumberOfIPAddressesToAllocate)
+			}
+			if config.Description != "" {
+				properties.Description = pointer.To(config.Description)
+			}
+
+			payload := staticcidrs.StaticCidr{
+				Name:       pointer.To(config.Name),
+				Properties: &properties,
+			}
+
+			if _, err := client.Create(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.StaticCidrs
+
+			id, err := staticcidrs.ParseStaticCidrID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			schema := ManagerIpamPoolStaticCidrResourceModel{
+				Name:       id.StaticCidrName,
+				IpamPoolId: staticcidrs.NewIPamPoolID(id.SubscriptionId, id.ResourceGroupName, id.NetworkManagerName, id.IpamPoolName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					schema.AddressPrefixes = pointer.From(props.AddressPrefixes)
+					schema.Description = pointer.From(props.Description)
+					schema.NumberOfIPAddressesToAllocate = pointer.From(props.NumberOfIPAddressesToAllocate)
+					schema.TotalNumberOfIPAddresses = pointer.From(props.TotalNumberOfIPAddresses)
+				}
+			}
+
+			return metadata.Encode(&schema)
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.StaticCidrs
+
+			id, err := staticcidrs.ParseStaticCidrID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config ManagerIpamPoolStaticCidrResourceModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", id)
+			}
+			if existing.Model.Properties == nil {
+				return fmt.Errorf("retrieving %s: `properties` was nil", id)
+			}
+
+			// the API has no PATCH operation, so the existing Static CIDR is sent back with the updated fields
+			payload := *existing.Model
+			payload.Properties.ProvisioningState = nil
+			payload.Properties.TotalNumberOfIPAddresses = nil
+
+			if metadata.ResourceData.HasChange("description") {
+				payload.Properties.Description = pointer.To(config.Description)
+			}
+
+			if _, err := client.Create(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.StaticCidrs
+
+			id, err := staticcidrs.ParseStaticCidrID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.DeleteThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
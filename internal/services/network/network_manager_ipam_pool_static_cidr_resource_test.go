@@ -0,0 +1,158 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/staticcidrs"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagerIpamPoolStaticCidrResource struct{}
+
+func testAccNetworkManagerIpamPoolStaticCidr_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_ipam_pool_static_cidr", "test")
+	r := ManagerIpamPoolStaticCidrResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("total_number_of_ip_addresses").HasValue("16"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerIpamPoolStaticCidr_numberOfIPAddresses(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_ipam_pool_static_cidr", "test")
+	r := ManagerIpamPoolStaticCidrResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.numberOfIPAddresses(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerIpamPoolStaticCidr_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_ipam_pool_static_cidr", "test")
+	r := ManagerIpamPoolStaticCidrResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func testAccNetworkManagerIpamPoolStaticCidr_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_manager_ipam_pool_static_cidr", "test")
+	r := ManagerIpamPoolStaticCidrResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r ManagerIpamPoolStaticCidrResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := staticcidrs.ParseStaticCidrID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Network.StaticCidrs.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
+
+func (r ManagerIpamPoolStaticCidrResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_network_manager_ipam_pool_static_cidr" "test" {
+  name             = "acctest-cidr-%[2]d"
+  ipam_pool_id     = azurerm_network_manager_ipam_pool.test.id
+  address_prefixes = ["10.0.0.0/28"]
+}
+`, ManagerIpamPoolResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ManagerIpamPoolStaticCidrResource) numberOfIPAddresses(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_network_manager_ipam_pool_static_cidr" "test" {
+  name                               = "acctest-cidr-%[2]d"
+  ipam_pool_id                       = azurerm_network_manager_ipam_pool.test.id
+  number_of_ip_addresses_to_allocate = "8"
+}
+`, ManagerIpamPoolResource{}.basic(data), data.RandomInteger)
+}
+
+func (r ManagerIpamPoolStaticCidrResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_manager_ipam_pool_static_cidr" "import" {
+  name             = azurerm_network_manager_ipam_pool_static_cidr.test.name
+  ipam_pool_id     = azurerm_network_manager_ipam_pool_static_cidr.test.ipam_pool_id
+  address_prefixes = azurerm_network_manager_ipam_pool_static_cidr.test.address_prefixes
+}
+`, r.basic(data))
+}
+
+func (r ManagerIpamPoolStaticCidrResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_network_manager_ipam_pool_static_cidr" "test" {
+  name             = "acctest-cidr-%[2]d"
+  ipam_pool_id     = azurerm_network_manager_ipam_pool.test.id
+  address_prefixes = ["10.0.0.0/28"]
+  description      = "Reserved for on-premises connectivity"
+}
+`, ManagerIpamPoolResource{}.basic(data), data.RandomInteger)
+}
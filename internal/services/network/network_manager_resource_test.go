@@ -94,13 +94,22 @@ func TestAccNetworkManager(t *testing.T) {
 			"update":         testAccNetworkManagerDeployment_update,
 			"withTriggers":   testAccNetworkManagerDeployment_withTriggers,
 			"requiresImport": testAccNetworkManagerDeployment_requiresImport,
+			"dataSource":     testAccNetworkManagerDeploymentDataSource_basicAdmin,
 		},
 		"IPAMPool": {
-			"basic":          testAccNetworkManagerIpamPool_basic,
-			"basicIPv6":      testAccNetworkManagerIpamPool_basicIPv6,
-			"complete":       testAccNetworkManagerIpamPool_complete,
-			"update":         testAccNetworkManagerIpamPool_update,
-			"requiresImport": testAccNetworkManagerIpamPool_requiresImport,
+			"basic":                          testAccNetworkManagerIpamPool_basic,
+			"basicIPv6":                      testAccNetworkManagerIpamPool_basicIPv6,
+			"complete":                       testAccNetworkManagerIpamPool_complete,
+			"update":                         testAccNetworkManagerIpamPool_update,
+			"requiresImport":                 testAccNetworkManagerIpamPool_requiresImport,
+			"subnetPrefixAllocation":         testAccSubnet_ipamPoolPrefixAllocation,
+			"virtualNetworkPrefixAllocation": testAccVirtualNetwork_ipamPoolPrefixAllocation,
+		},
+		"IPAMPoolStaticCidr": {
+			"basic":               testAccNetworkManagerIpamPoolStaticCidr_basic,
+			"numberOfIPAddresses": testAccNetworkManagerIpamPoolStaticCidr_numberOfIPAddresses,
+			"update":              testAccNetworkManagerIpamPoolStaticCidr_update,
+			"requiresImport":      testAccNetworkManagerIpamPoolStaticCidr_requiresImport,
 		},
 		"VerifierWorkspace": {
 			"basic":          testAccNetorkManagerVerifierWorkspace_basic,
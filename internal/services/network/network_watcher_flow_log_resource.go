@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -12,6 +13,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/identity"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/flowlogs"
@@ -163,6 +165,14 @@ func resourceNetworkWatcherFlowLog() *pluginsdk.Resource {
 				ValidateFunc: validation.IntBetween(1, 2),
 			},
 
+			"enabled_filtering_criteria": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+
+			"identity": commonschema.UserAssignedIdentityOptional(),
+
 			"location": {
 				Type:             pluginsdk.TypeString,
 				Optional:         true,
@@ -175,6 +185,29 @@ func resourceNetworkWatcherFlowLog() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			// `enabled_filtering_criteria` and `identity` are only supported by Virtual Network Flow Logs, which target a
+			// Virtual Network, Subnet or Network Interface rather than a Network Security Group
+			targetResourceId := d.Get("target_resource_id").(string)
+			if !features.FivePointOh() {
+				if v := d.Get("network_security_group_id").(string); v != "" {
+					targetResourceId = v
+				}
+			}
+			if _, err := networksecuritygroups.ParseNetworkSecurityGroupIDInsensitively(targetResourceId); err != nil {
+				return nil
+			}
+
+			if d.Get("enabled_filtering_criteria").(string) != "" {
+				return fmt.Errorf("`enabled_filtering_criteria` cannot be specified when the target is a Network Security Group")
+			}
+			if len(d.Get("identity").([]interface{})) > 0 {
+				return fmt.Errorf("`identity` cannot be specified when the target is a Network Security Group")
+			}
+
+			return nil
+		}),
 	}
 
 	if !features.FivePointOh() {
@@ -276,6 +309,18 @@ func resourceNetworkWatcherFlowLogCreate(d *pluginsdk.ResourceData, meta interfa
 		parameters.Properties.FlowAnalyticsConfiguration = expandNetworkWatcherFlowLogTrafficAnalytics(d)
 	}
 
+	if v, ok := d.GetOk("enabled_filtering_criteria"); ok {
+		parameters.Properties.EnabledFilteringCriteria = pointer.To(v.(string))
+	}
+
+	if v, ok := d.GetOk("identity"); ok {
+		expandedIdentity, err := expandNetworkWatcherFlowLogIdentity(v.([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		parameters.Identity = expandedIdentity
+	}
+
 	if version, ok := d.GetOk("version"); ok {
 		format := &flowlogs.FlowLogFormatParameters{
 			Version: pointer.To(int64(version.(int))),
@@ -358,6 +403,18 @@ func resourceNetworkWatcherFlowLogUpdate(d *pluginsdk.ResourceData, meta interfa
 		}
 	}
 
+	if d.HasChange("enabled_filtering_criteria") {
+		payload.Properties.EnabledFilteringCriteria = pointer.To(d.Get("enabled_filtering_criteria").(string))
+	}
+
+	if d.HasChange("identity") {
+		expandedIdentity, err := expandNetworkWatcherFlowLogIdentity(d.Get("identity").([]interface{}))
+		if err != nil {
+			return fmt.Errorf("expanding `identity`: %+v", err)
+		}
+		payload.Identity = expandedIdentity
+	}
+
 	if d.HasChange("tags") {
 		payload.Tags = tags.Expand(d.Get("tags").(map[string]interface{}))
 	}
@@ -398,12 +455,21 @@ func resourceNetworkWatcherFlowLogRead(d *pluginsdk.ResourceData, meta interface
 	if model := resp.Model; model != nil {
 		d.Set("location", location.NormalizeNilable(model.Location))
 
+		flattenedIdentity, err := flattenNetworkWatcherFlowLogIdentity(model.Identity)
+		if err != nil {
+			return fmt.Errorf("flattening `identity`: %+v", err)
+		}
+		if err := d.Set("identity", flattenedIdentity); err != nil {
+			return fmt.Errorf("setting `identity`: %+v", err)
+		}
+
 		if props := model.Properties; props != nil {
 			if err := d.Set("traffic_analytics", flattenNetworkWatcherFlowLogTrafficAnalytics(props.FlowAnalyticsConfiguration)); err != nil {
 				return fmt.Errorf("setting `traffic_analytics`: %+v", err)
 			}
 
 			d.Set("enabled", props.Enabled)
+			d.Set("enabled_filtering_criteria", pointer.From(props.EnabledFilteringCriteria))
 
 			version := 0
 			if format := props.Format; format != nil {
@@ -482,6 +548,31 @@ func resourceNetworkWatcherFlowLogDelete(d *pluginsdk.ResourceData, meta interfa
 	return nil
 }
 
+// expandNetworkWatcherFlowLogIdentity expands the `identity` block into the API model - the API only supports User
+// Assigned Identities for Flow Logs, but models this as a SystemAndUserAssigned identity.
+func expandNetworkWatcherFlowLogIdentity(input []interface{}) (*identity.SystemAndUserAssignedMap, error) {
+	expanded, err := identity.ExpandUserAssignedMap(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity.SystemAndUserAssignedMap{
+		Type:        expanded.Type,
+		IdentityIds: expanded.IdentityIds,
+	}, nil
+}
+
+func flattenNetworkWatcherFlowLogIdentity(input *identity.SystemAndUserAssignedMap) (*[]interface{}, error) {
+	if input == nil {
+		return &[]interface{}{}, nil
+	}
+
+	return identity.FlattenUserAssignedMap(&identity.UserAssignedMap{
+		Type:        input.Type,
+		IdentityIds: input.IdentityIds,
+	})
+}
+
 func expandNetworkWatcherFlowLogRetentionPolicy(input []interface{}) *flowlogs.RetentionPolicyParameters {
 	if len(input) < 1 || input[0] == nil {
 		return nil
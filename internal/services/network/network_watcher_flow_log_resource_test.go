@@ -49,6 +49,36 @@ func testAccNetworkWatcherFlowLog_basicWithVirtualNetwork(t *testing.T) {
 	})
 }
 
+func testAccNetworkWatcherFlowLog_virtualNetworkComplete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_network_watcher_flow_log", "test")
+	r := NetworkWatcherFlowLogResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.virtualNetworkComplete(data, "dstPort=443"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("identity.0.type").HasValue("UserAssigned"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.virtualNetworkComplete(data, "srcIP=10.0.0.0/16||dstPort=443"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basicConfigWithVirtualNetwork(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func testAccNetworkWatcherFlowLog_basicWithSubnet(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_network_watcher_flow_log", "test")
 	r := NetworkWatcherFlowLogResource{}
@@ -433,6 +463,70 @@ resource "azurerm_network_watcher_flow_log" "test" {
 `, r.prerequisites(data), data.RandomInteger, data.RandomInteger)
 }
 
+func (r NetworkWatcherFlowLogResource) virtualNetworkComplete(data acceptance.TestData, filteringCriteria string) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvn-%[2]d"
+  address_space       = ["10.0.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_user_assigned_identity" "test" {
+  name                = "acctestuai-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_role_assignment" "test" {
+  scope                = azurerm_storage_account.test.id
+  role_definition_name = "Storage Blob Data Contributor"
+  principal_id         = azurerm_user_assigned_identity.test.principal_id
+}
+
+resource "azurerm_log_analytics_workspace" "test" {
+  name                = "acctestLAW-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  sku                 = "PerGB2018"
+}
+
+resource "azurerm_network_watcher_flow_log" "test" {
+  network_watcher_name = azurerm_network_watcher.test.name
+  resource_group_name  = azurerm_resource_group.test.name
+  name                 = "flowlog-%[2]d"
+
+  target_resource_id         = azurerm_virtual_network.test.id
+  storage_account_id         = azurerm_storage_account.test.id
+  enabled                    = true
+  enabled_filtering_criteria = "%[3]s"
+  version                    = 2
+
+  identity {
+    type         = "UserAssigned"
+    identity_ids = [azurerm_user_assigned_identity.test.id]
+  }
+
+  retention_policy {
+    enabled = true
+    days    = 7
+  }
+
+  traffic_analytics {
+    enabled               = true
+    workspace_id          = azurerm_log_analytics_workspace.test.workspace_id
+    workspace_region      = azurerm_log_analytics_workspace.test.location
+    workspace_resource_id = azurerm_log_analytics_workspace.test.id
+    interval_in_minutes   = 10
+  }
+
+  depends_on = [azurerm_role_assignment.test]
+}
+`, r.prerequisites(data), data.RandomInteger, filteringCriteria)
+}
+
 func (r NetworkWatcherFlowLogResource) basicConfigWithSubnet(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
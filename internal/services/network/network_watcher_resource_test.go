@@ -79,6 +79,7 @@ func TestAccNetworkWatcher(t *testing.T) {
 		"FlowLog": {
 			"basic":                   testAccNetworkWatcherFlowLog_basic,
 			"basicWithVirtualNetwork": testAccNetworkWatcherFlowLog_basicWithVirtualNetwork,
+			"virtualNetworkComplete":  testAccNetworkWatcherFlowLog_virtualNetworkComplete,
 			"basicWithSubnet":         testAccNetworkWatcherFlowLog_basicWithSubnet,
 			"basicWithNIC":            testAccNetworkWatcherFlowLog_basicWithNIC,
 			"requiresImport":          testAccNetworkWatcherFlowLog_requiresImport,
@@ -157,6 +157,15 @@ func resourcePointToSiteVPNGateway() *pluginsdk.Resource {
 							ForceNew: true,
 							Default:  false,
 						},
+
+						"configuration_policy_group_ids": {
+							Type:     pluginsdk.TypeSet,
+							Optional: true,
+							Elem: &pluginsdk.Schema{
+								Type:         pluginsdk.TypeString,
+								ValidateFunc: virtualwans.ValidateConfigurationPolicyGroupID,
+							},
+						},
 					},
 				},
 			},
@@ -393,8 +402,9 @@ func expandPointToSiteVPNGatewayConnectionConfiguration(input []interface{}) *[]
 				VpnClientAddressPool: &virtualwans.AddressSpace{
 					AddressPrefixes: &addressPrefixes,
 				},
-				RoutingConfiguration:   expandPointToSiteVPNGatewayConnectionRouteConfiguration(raw["route"].([]interface{})),
-				EnableInternetSecurity: pointer.To(raw["internet_security_enabled"].(bool)),
+				RoutingConfiguration:                 expandPointToSiteVPNGatewayConnectionRouteConfiguration(raw["route"].([]interface{})),
+				EnableInternetSecurity:               pointer.To(raw["internet_security_enabled"].(bool)),
+				ConfigurationPolicyGroupAssociations: expandPointToSiteVPNGatewayConnectionConfigurationPolicyGroups(raw["configuration_policy_group_ids"].(*pluginsdk.Set).List()),
 			},
 		})
 	}
@@ -402,6 +412,18 @@ func expandPointToSiteVPNGatewayConnectionConfiguration(input []interface{}) *[]
 	return &configurations
 }
 
+func expandPointToSiteVPNGatewayConnectionConfigurationPolicyGroups(input []interface{}) *[]virtualwans.SubResource {
+	results := make([]virtualwans.SubResource, 0)
+
+	for _, item := range input {
+		results = append(results, virtualwans.SubResource{
+			Id: pointer.To(item.(string)),
+		})
+	}
+
+	return &results
+}
+
 func expandPointToSiteVPNGatewayConnectionRouteConfiguration(input []interface{}) *virtualwans.RoutingConfiguration {
 	if len(input) == 0 {
 		return nil
@@ -464,6 +486,7 @@ func flattenPointToSiteVPNGatewayConnectionConfiguration(input *[]virtualwans.P2
 
 		route := make([]interface{}, 0)
 		addressPrefixes := make([]interface{}, 0)
+		configurationPolicyGroupIds := make([]interface{}, 0)
 		enableInternetSecurity := false
 		if props := v.Properties; props != nil {
 			if props.VpnClientAddressPool == nil {
@@ -483,6 +506,14 @@ func flattenPointToSiteVPNGatewayConnectionConfiguration(input *[]virtualwans.P2
 			if props.RoutingConfiguration != nil {
 				route = flattenPointToSiteVPNGatewayConnectionRouteConfiguration(props.RoutingConfiguration)
 			}
+
+			if props.ConfigurationPolicyGroupAssociations != nil {
+				for _, item := range *props.ConfigurationPolicyGroupAssociations {
+					if item.Id != nil {
+						configurationPolicyGroupIds = append(configurationPolicyGroupIds, *item.Id)
+					}
+				}
+			}
 		}
 
 		output = append(output, map[string]interface{}{
@@ -492,8 +523,9 @@ func flattenPointToSiteVPNGatewayConnectionConfiguration(input *[]virtualwans.P2
 					"address_prefixes": addressPrefixes,
 				},
 			},
-			"route":                     route,
-			"internet_security_enabled": enableInternetSecurity,
+			"route":                          route,
+			"internet_security_enabled":      enableInternetSecurity,
+			"configuration_policy_group_ids": configurationPolicyGroupIds,
 		})
 	}
 
@@ -0,0 +1,263 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/privateendpoints"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/privateendpointconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type PrivateEndpointConnectionApprovalResource struct{}
+
+var _ sdk.ResourceWithUpdate = PrivateEndpointConnectionApprovalResource{}
+
+type PrivateEndpointConnectionApprovalModel struct {
+	TargetResourceId    string   `tfschema:"target_resource_id"`
+	Name                string   `tfschema:"name"`
+	PrivateEndpointName string   `tfschema:"private_endpoint_name"`
+	Status              string   `tfschema:"status"`
+	Description         string   `tfschema:"description"`
+	PrivateEndpointId   string   `tfschema:"private_endpoint_id"`
+	GroupIds            []string `tfschema:"group_ids"`
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"target_resource_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: privateendpointconnections.ValidateTargetResourceID,
+		},
+
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"name", "private_endpoint_name"},
+		},
+
+		"private_endpoint_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+			ExactlyOneOf: []string{"name", "private_endpoint_name"},
+		},
+
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Optional: true,
+			Default:  privateendpointconnections.StatusApproved,
+			ValidateFunc: validation.StringInSlice([]string{
+				privateendpointconnections.StatusApproved,
+				privateendpointconnections.StatusRejected,
+			}, false),
+		},
+
+		"description": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.StringLenBetween(1, 140),
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"private_endpoint_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"group_ids": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Schema{
+				Type: pluginsdk.TypeString,
+			},
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) ModelObject() interface{} {
+	return &PrivateEndpointConnectionApprovalModel{}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) ResourceType() string {
+	return "azurerm_private_endpoint_connection_approval"
+}
+
+func (r PrivateEndpointConnectionApprovalResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return privateendpointconnections.ValidatePrivateEndpointConnectionID
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateEndpointConnectionsClient
+
+			var config PrivateEndpointConnectionApprovalModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				return fmt.Errorf("internal-error: context had no deadline")
+			}
+
+			// managed Private Endpoints (e.g. from Data Factory, Synapse or Search) are provisioned asynchronously, so the
+			// connection may not have been created on the target resource yet
+			var id privateendpointconnections.PrivateEndpointConnectionId
+			err := pluginsdk.Retry(time.Until(deadline), func() *pluginsdk.RetryError {
+				if config.Name != "" {
+					id = privateendpointconnections.NewPrivateEndpointConnectionID(config.TargetResourceId, config.Name)
+					existing, err := client.Get(ctx, id)
+					if err != nil {
+						return pluginsdk.NonRetryableError(err)
+					}
+					if existing == nil {
+						return pluginsdk.RetryableError(fmt.Errorf("waiting for %s to be created", id))
+					}
+					return nil
+				}
+
+				connections, err := client.List(ctx, config.TargetResourceId)
+				if err != nil {
+					return pluginsdk.NonRetryableError(err)
+				}
+				for _, connection := range connections {
+					if connection.Name == "" || connection.PrivateEndpointId == "" {
+						continue
+					}
+
+					privateEndpointId, err := privateendpoints.ParsePrivateEndpointIDInsensitively(connection.PrivateEndpointId)
+					if err != nil {
+						continue
+					}
+					if strings.EqualFold(privateEndpointId.PrivateEndpointName, config.PrivateEndpointName) {
+						id = privateendpointconnections.NewPrivateEndpointConnectionID(config.TargetResourceId, connection.Name)
+						return nil
+					}
+				}
+				return pluginsdk.RetryableError(fmt.Errorf("waiting for the Private Endpoint Connection for the Private Endpoint %q to be created on %q", config.PrivateEndpointName, config.TargetResourceId))
+			})
+			if err != nil {
+				return err
+			}
+
+			if err := client.UpdateStatus(ctx, id, config.Status, connectionStateDescription(config)); err != nil {
+				return fmt.Errorf("setting the status of %s to %q: %+v", id, config.Status, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateEndpointConnectionsClient
+
+			id, err := privateendpointconnections.ParsePrivateEndpointConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			connection, err := client.Get(ctx, *id)
+			if err != nil {
+				return err
+			}
+			if connection == nil {
+				return metadata.MarkAsGone(id)
+			}
+
+			state := PrivateEndpointConnectionApprovalModel{
+				TargetResourceId: id.TargetResourceId,
+				Name:             id.PrivateEndpointConnectionName,
+			}
+
+			// this is only used to locate the connection during creation
+			state.PrivateEndpointName = metadata.ResourceData.Get("private_endpoint_name").(string)
+
+			state.Status = connection.Status
+			state.Description = connection.Description
+			state.PrivateEndpointId = connection.PrivateEndpointId
+			state.GroupIds = connection.GroupIds
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateEndpointConnectionsClient
+
+			id, err := privateendpointconnections.ParsePrivateEndpointConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config PrivateEndpointConnectionApprovalModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			if metadata.ResourceData.HasChanges("status", "description") {
+				if err := client.UpdateStatus(ctx, *id, config.Status, connectionStateDescription(config)); err != nil {
+					return fmt.Errorf("setting the status of %s to %q: %+v", id, config.Status, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.PrivateEndpointConnectionsClient
+
+			id, err := privateendpointconnections.ParsePrivateEndpointConnectionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func connectionStateDescription(input PrivateEndpointConnectionApprovalModel) string {
+	if input.Description != "" {
+		return input.Description
+	}
+
+	return fmt.Sprintf("%s by Terraform", input.Status)
+}
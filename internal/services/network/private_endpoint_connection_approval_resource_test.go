@@ -0,0 +1,147 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/privateendpointconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PrivateEndpointConnectionApprovalResource struct{}
+
+func TestAccPrivateEndpointConnectionApproval_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint_connection_approval", "test")
+	r := PrivateEndpointConnectionApprovalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Approved"),
+				check.That(data.ResourceName).Key("private_endpoint_id").Exists(),
+			),
+		},
+		data.ImportStep("private_endpoint_name"),
+	})
+}
+
+func TestAccPrivateEndpointConnectionApproval_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_private_endpoint_connection_approval", "test")
+	r := PrivateEndpointConnectionApprovalResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("private_endpoint_name"),
+		{
+			Config: r.rejected(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Rejected"),
+			),
+		},
+		data.ImportStep("private_endpoint_name"),
+	})
+}
+
+func (r PrivateEndpointConnectionApprovalResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := privateendpointconnections.ParsePrivateEndpointConnectionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	connection, err := client.Network.PrivateEndpointConnectionsClient.Get(ctx, *id)
+	if err != nil {
+		return nil, err
+	}
+
+	return pointer.To(connection != nil), nil
+}
+
+func (r PrivateEndpointConnectionApprovalResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint_connection_approval" "test" {
+  target_resource_id    = azurerm_storage_account.test.id
+  private_endpoint_name = azurerm_private_endpoint.test.name
+}
+`, r.template(data))
+}
+
+func (r PrivateEndpointConnectionApprovalResource) rejected(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_private_endpoint_connection_approval" "test" {
+  target_resource_id    = azurerm_storage_account.test.id
+  private_endpoint_name = azurerm_private_endpoint.test.name
+  status                = "Rejected"
+  description           = "Rejected during testing"
+}
+`, r.template(data))
+}
+
+func (PrivateEndpointConnectionApprovalResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-pec-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvnet-%[1]d"
+  address_space       = ["10.5.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_subnet" "test" {
+  name                 = "acctestsnet-%[1]d"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+  address_prefixes     = ["10.5.2.0/24"]
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestsa%[3]s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+}
+
+resource "azurerm_private_endpoint" "test" {
+  name                = "acctest-pe-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  subnet_id           = azurerm_subnet.test.id
+
+  private_service_connection {
+    name                           = "acctest-psc-%[1]d"
+    is_manual_connection           = true
+    private_connection_resource_id = azurerm_storage_account.test.id
+    subresource_names              = ["blob"]
+    request_message                = "please approve"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
@@ -0,0 +1,152 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privateendpointconnections
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	cosmosDbConnections "github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/privateendpointconnections"
+	keyVaultConnections "github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/privateendpointconnections"
+	sqlConnections "github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/privateendpointconnections"
+	storageConnections "github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/privateendpointconnections"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
+)
+
+// Client manages the Private Endpoint Connections of the target resources supported by this package, using the
+// pinned API Version of the Resource Provider which owns each type of target resource.
+type Client struct {
+	CosmosDBClient *cosmosDbConnections.PrivateEndpointConnectionsClient
+	KeyVaultClient *keyVaultConnections.PrivateEndpointConnectionsClient
+	SqlClient      *sqlConnections.PrivateEndpointConnectionsClient
+	StorageClient  *storageConnections.PrivateEndpointConnectionsClient
+}
+
+func NewClient(o *common.ClientOptions) (*Client, error) {
+	cosmosDbClient, err := cosmosDbConnections.NewPrivateEndpointConnectionsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building CosmosDB Private Endpoint Connections Client: %+v", err)
+	}
+	o.Configure(cosmosDbClient.Client, o.Authorizers.ResourceManager)
+
+	keyVaultClient, err := keyVaultConnections.NewPrivateEndpointConnectionsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Key Vault Private Endpoint Connections Client: %+v", err)
+	}
+	o.Configure(keyVaultClient.Client, o.Authorizers.ResourceManager)
+
+	sqlClient, err := sqlConnections.NewPrivateEndpointConnectionsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building SQL Private Endpoint Connections Client: %+v", err)
+	}
+	o.Configure(sqlClient.Client, o.Authorizers.ResourceManager)
+
+	storageClient, err := storageConnections.NewPrivateEndpointConnectionsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Storage Private Endpoint Connections Client: %+v", err)
+	}
+	o.Configure(storageClient.Client, o.Authorizers.ResourceManager)
+
+	return &Client{
+		CosmosDBClient: cosmosDbClient,
+		KeyVaultClient: keyVaultClient,
+		SqlClient:      sqlClient,
+		StorageClient:  storageClient,
+	}, nil
+}
+
+// resourceProviderClient manages the Private Endpoint Connections for a single type of target resource
+type resourceProviderClient interface {
+	list(ctx context.Context, targetResourceId string) ([]Connection, error)
+
+	// get returns nil if the Private Endpoint Connection doesn't exist
+	get(ctx context.Context, id PrivateEndpointConnectionId) (*Connection, error)
+
+	updateStatus(ctx context.Context, id PrivateEndpointConnectionId, status string, description string) error
+
+	delete(ctx context.Context, id PrivateEndpointConnectionId) error
+}
+
+// supportedResourceTypes maps the (lower-cased) Resource Types which are supported to the Resource Provider
+// specific client used to manage their Private Endpoint Connections
+var supportedResourceTypes = map[string]func(c Client) resourceProviderClient{
+	"microsoft.documentdb/databaseaccounts": func(c Client) resourceProviderClient {
+		return cosmosDbConnectionsClient{client: c.CosmosDBClient}
+	},
+	"microsoft.keyvault/vaults": func(c Client) resourceProviderClient {
+		return keyVaultConnectionsClient{client: c.KeyVaultClient}
+	},
+	"microsoft.sql/servers": func(c Client) resourceProviderClient {
+		return sqlConnectionsClient{client: c.SqlClient}
+	},
+	"microsoft.storage/storageaccounts": func(c Client) resourceProviderClient {
+		return storageConnectionsClient{client: c.StorageClient}
+	},
+}
+
+// SupportedResourceTypes returns the Resource Types whose Private Endpoint Connections can be managed by this Client
+func SupportedResourceTypes() []string {
+	return []string{
+		"Microsoft.DocumentDB/databaseAccounts",
+		"Microsoft.KeyVault/vaults",
+		"Microsoft.Sql/servers",
+		"Microsoft.Storage/storageAccounts",
+	}
+}
+
+func (c Client) clientForTargetResource(targetResourceId string) (resourceProviderClient, error) {
+	target, err := ParseTargetResourceID(targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	client, ok := supportedResourceTypes[strings.ToLower(fmt.Sprintf("%s/%s", target.ProviderNamespace, target.ResourceType))]
+	if !ok {
+		return nil, fmt.Errorf("managing the Private Endpoint Connections of %q isn't supported - supported Resource Types are: %s", targetResourceId, strings.Join(SupportedResourceTypes(), ", "))
+	}
+
+	return client(c), nil
+}
+
+// List returns the Private Endpoint Connections for the specified target resource
+func (c Client) List(ctx context.Context, targetResourceId string) ([]Connection, error) {
+	client, err := c.clientForTargetResource(targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.list(ctx, targetResourceId)
+}
+
+// Get retrieves the specified Private Endpoint Connection, returning nil if it doesn't exist
+func (c Client) Get(ctx context.Context, id PrivateEndpointConnectionId) (*Connection, error) {
+	client, err := c.clientForTargetResource(id.TargetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.get(ctx, id)
+}
+
+// UpdateStatus sets the status of the specified Private Endpoint Connection, leaving the remaining properties
+// of the connection as-is
+func (c Client) UpdateStatus(ctx context.Context, id PrivateEndpointConnectionId, status string, description string) error {
+	client, err := c.clientForTargetResource(id.TargetResourceId)
+	if err != nil {
+		return err
+	}
+
+	return client.updateStatus(ctx, id, status, description)
+}
+
+// Delete removes the specified Private Endpoint Connection from the target resource
+func (c Client) Delete(ctx context.Context, id PrivateEndpointConnectionId) error {
+	client, err := c.clientForTargetResource(id.TargetResourceId)
+	if err != nil {
+		return err
+	}
+
+	return client.delete(ctx, id)
+}
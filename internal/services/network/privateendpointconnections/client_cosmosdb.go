@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privateendpointconnections
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	cosmosDbConnections "github.com/hashicorp/go-azure-sdk/resource-manager/cosmosdb/2024-08-15/privateendpointconnections"
+)
+
+var _ resourceProviderClient = cosmosDbConnectionsClient{}
+
+type cosmosDbConnectionsClient struct {
+	client *cosmosDbConnections.PrivateEndpointConnectionsClient
+}
+
+func (c cosmosDbConnectionsClient) list(ctx context.Context, targetResourceId string) ([]Connection, error) {
+	accountId, err := cosmosDbConnections.ParseDatabaseAccountIDInsensitively(targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ListByDatabaseAccount(ctx, *accountId)
+	if err != nil {
+		return nil, fmt.Errorf("listing Private Endpoint Connections for %s: %+v", accountId, err)
+	}
+
+	out := make([]Connection, 0)
+	if model := resp.Model; model != nil {
+		for _, v := range pointer.From(model.Value) {
+			out = append(out, flattenCosmosDbConnection(v))
+		}
+	}
+
+	return out, nil
+}
+
+func (c cosmosDbConnectionsClient) get(ctx context.Context, id PrivateEndpointConnectionId) (*Connection, error) {
+	connectionId, err := cosmosDbConnections.ParsePrivateEndpointConnectionIDInsensitively(id.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Get(ctx, *connectionId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", connectionId, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: `model` was nil", connectionId)
+	}
+
+	return pointer.To(flattenCosmosDbConnection(*resp.Model)), nil
+}
+
+func (c cosmosDbConnectionsClient) updateStatus(ctx context.Context, id PrivateEndpointConnectionId, status string, description string) error {
+	connectionId, err := cosmosDbConnections.ParsePrivateEndpointConnectionIDInsensitively(id.ID())
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.client.Get(ctx, *connectionId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", connectionId, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", connectionId)
+	}
+
+	payload := *existing.Model
+	payload.Properties.ProvisioningState = nil
+	if payload.Properties.PrivateLinkServiceConnectionState == nil {
+		payload.Properties.PrivateLinkServiceConnectionState = &cosmosDbConnections.PrivateLinkServiceConnectionStateProperty{}
+	}
+	payload.Properties.PrivateLinkServiceConnectionState.Status = pointer.To(status)
+	payload.Properties.PrivateLinkServiceConnectionState.Description = pointer.To(description)
+
+	if err := c.client.CreateOrUpdateThenPoll(ctx, *connectionId, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", connectionId, err)
+	}
+
+	return nil
+}
+
+func (c cosmosDbConnectionsClient) delete(ctx context.Context, id PrivateEndpointConnectionId) error {
+	connectionId, err := cosmosDbConnections.ParsePrivateEndpointConnectionIDInsensitively(id.ID())
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.DeleteThenPoll(ctx, *connectionId); err != nil {
+		return fmt.Errorf("deleting %s: %+v", connectionId, err)
+	}
+
+	return nil
+}
+
+func flattenCosmosDbConnection(input cosmosDbConnections.PrivateEndpointConnection) Connection {
+	out := Connection{
+		Id:       pointer.From(input.Id),
+		Name:     pointer.From(input.Name),
+		GroupIds: make([]string, 0),
+	}
+
+	if props := input.Properties; props != nil {
+		if props.PrivateEndpoint != nil {
+			out.PrivateEndpointId = pointer.From(props.PrivateEndpoint.Id)
+		}
+		if state := props.PrivateLinkServiceConnectionState; state != nil {
+			out.Status = pointer.From(state.Status)
+			out.Description = pointer.From(state.Description)
+		}
+		if props.GroupId != nil {
+			out.GroupIds = []string{*props.GroupId}
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,121 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privateendpointconnections
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	keyVaultConnections "github.com/hashicorp/go-azure-sdk/resource-manager/keyvault/2023-07-01/privateendpointconnections"
+)
+
+var _ resourceProviderClient = keyVaultConnectionsClient{}
+
+type keyVaultConnectionsClient struct {
+	client *keyVaultConnections.PrivateEndpointConnectionsClient
+}
+
+func (c keyVaultConnectionsClient) list(ctx context.Context, targetResourceId string) ([]Connection, error) {
+	keyVaultId, err := commonids.ParseKeyVaultIDInsensitively(targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ListByResourceComplete(ctx, *keyVaultId)
+	if err != nil {
+		return nil, fmt.Errorf("listing Private Endpoint Connections for %s: %+v", keyVaultId, err)
+	}
+
+	out := make([]Connection, 0)
+	for _, v := range resp.Items {
+		out = append(out, flattenKeyVaultConnection(v))
+	}
+
+	return out, nil
+}
+
+func (c keyVaultConnectionsClient) get(ctx context.Context, id PrivateEndpointConnectionId) (*Connection, error) {
+	connectionId, err := commonids.ParseKeyVaultPrivateEndpointConnectionIDInsensitively(id.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Get(ctx, *connectionId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", connectionId, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: `model` was nil", connectionId)
+	}
+
+	return pointer.To(flattenKeyVaultConnection(*resp.Model)), nil
+}
+
+func (c keyVaultConnectionsClient) updateStatus(ctx context.Context, id PrivateEndpointConnectionId, status string, description string) error {
+	connectionId, err := commonids.ParseKeyVaultPrivateEndpointConnectionIDInsensitively(id.ID())
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.client.Get(ctx, *connectionId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", connectionId, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", connectionId)
+	}
+
+	payload := *existing.Model
+	payload.Properties.ProvisioningState = nil
+	if payload.Properties.PrivateLinkServiceConnectionState == nil {
+		payload.Properties.PrivateLinkServiceConnectionState = &keyVaultConnections.PrivateLinkServiceConnectionState{}
+	}
+	payload.Properties.PrivateLinkServiceConnectionState.Status = pointer.To(keyVaultConnections.PrivateEndpointServiceConnectionStatus(status))
+	payload.Properties.PrivateLinkServiceConnectionState.Description = pointer.To(description)
+
+	if _, err := c.client.Put(ctx, *connectionId, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", connectionId, err)
+	}
+
+	return nil
+}
+
+func (c keyVaultConnectionsClient) delete(ctx context.Context, id PrivateEndpointConnectionId) error {
+	connectionId, err := commonids.ParseKeyVaultPrivateEndpointConnectionIDInsensitively(id.ID())
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.DeleteThenPoll(ctx, *connectionId); err != nil {
+		return fmt.Errorf("deleting %s: %+v", connectionId, err)
+	}
+
+	return nil
+}
+
+func flattenKeyVaultConnection(input keyVaultConnections.PrivateEndpointConnection) Connection {
+	out := Connection{
+		Id:       pointer.From(input.Id),
+		Name:     pointer.From(input.Name),
+		GroupIds: make([]string, 0),
+	}
+
+	if props := input.Properties; props != nil {
+		if props.PrivateEndpoint != nil {
+			out.PrivateEndpointId = pointer.From(props.PrivateEndpoint.Id)
+		}
+		if state := props.PrivateLinkServiceConnectionState; state != nil {
+			out.Status = string(pointer.From(state.Status))
+			out.Description = pointer.From(state.Description)
+		}
+	}
+
+	return out
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privateendpointconnections
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	sqlConnections "github.com/hashicorp/go-azure-sdk/resource-manager/sql/2023-08-01-preview/privateendpointconnections"
+)
+
+var _ resourceProviderClient = sqlConnectionsClient{}
+
+type sqlConnectionsClient struct {
+	client *sqlConnections.PrivateEndpointConnectionsClient
+}
+
+func (c sqlConnectionsClient) list(ctx context.Context, targetResourceId string) ([]Connection, error) {
+	serverId, err := commonids.ParseSqlServerIDInsensitively(targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ListByServerComplete(ctx, *serverId)
+	if err != nil {
+		return nil, fmt.Errorf("listing Private Endpoint Connections for %s: %+v", serverId, err)
+	}
+
+	out := make([]Connection, 0)
+	for _, v := range resp.Items {
+		out = append(out, flattenSqlConnection(v))
+	}
+
+	return out, nil
+}
+
+func (c sqlConnectionsClient) get(ctx context.Context, id PrivateEndpointConnectionId) (*Connection, error) {
+	connectionId, err := sqlConnections.ParsePrivateEndpointConnectionIDInsensitively(id.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Get(ctx, *connectionId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", connectionId, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: `model` was nil", connectionId)
+	}
+
+	return pointer.To(flattenSqlConnection(*resp.Model)), nil
+}
+
+func (c sqlConnectionsClient) updateStatus(ctx context.Context, id PrivateEndpointConnectionId, status string, description string) error {
+	connectionId, err := sqlConnections.ParsePrivateEndpointConnectionIDInsensitively(id.ID())
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.client.Get(ctx, *connectionId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", connectionId, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", connectionId)
+	}
+
+	payload := *existing.Model
+	payload.Properties.ProvisioningState = nil
+	if payload.Properties.PrivateLinkServiceConnectionState == nil {
+		payload.Properties.PrivateLinkServiceConnectionState = &sqlConnections.PrivateLinkServiceConnectionStateProperty{}
+	}
+	payload.Properties.PrivateLinkServiceConnectionState.Status = sqlConnections.PrivateLinkServiceConnectionStateStatus(status)
+	payload.Properties.PrivateLinkServiceConnectionState.Description = description
+
+	if err := c.client.CreateOrUpdateThenPoll(ctx, *connectionId, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", connectionId, err)
+	}
+
+	return nil
+}
+
+func (c sqlConnectionsClient) delete(ctx context.Context, id PrivateEndpointConnectionId) error {
+	connectionId, err := sqlConnections.ParsePrivateEndpointConnectionIDInsensitively(id.ID())
+	if err != nil {
+		return err
+	}
+
+	if err := c.client.DeleteThenPoll(ctx, *connectionId); err != nil {
+		return fmt.Errorf("deleting %s: %+v", connectionId, err)
+	}
+
+	return nil
+}
+
+func flattenSqlConnection(input sqlConnections.PrivateEndpointConnection) Connection {
+	out := Connection{
+		Id:       pointer.From(input.Id),
+		Name:     pointer.From(input.Name),
+		GroupIds: make([]string, 0),
+	}
+
+	if props := input.Properties; props != nil {
+		if props.PrivateEndpoint != nil {
+			out.PrivateEndpointId = pointer.From(props.PrivateEndpoint.Id)
+		}
+		if state := props.PrivateLinkServiceConnectionState; state != nil {
+			out.Status = string(state.Status)
+			out.Description = state.Description
+		}
+		out.GroupIds = pointer.From(props.GroupIds)
+	}
+
+	return out
+}
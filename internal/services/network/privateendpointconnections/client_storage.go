@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privateendpointconnections
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	storageConnections "github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/privateendpointconnections"
+)
+
+var _ resourceProviderClient = storageConnectionsClient{}
+
+type storageConnectionsClient struct {
+	client *storageConnections.PrivateEndpointConnectionsClient
+}
+
+func (c storageConnectionsClient) list(ctx context.Context, targetResourceId string) ([]Connection, error) {
+	accountId, err := commonids.ParseStorageAccountIDInsensitively(targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.List(ctx, *accountId)
+	if err != nil {
+		return nil, fmt.Errorf("listing Private Endpoint Connections for %s: %+v", accountId, err)
+	}
+
+	out := make([]Connection, 0)
+	if model := resp.Model; model != nil {
+		for _, v := range pointer.From(model.Value) {
+			out = append(out, flattenStorageConnection(v))
+		}
+	}
+
+	return out, nil
+}
+
+func (c storageConnectionsClient) get(ctx context.Context, id PrivateEndpointConnectionId) (*Connection, error) {
+	connectionId, err := storageConnections.ParsePrivateEndpointConnectionIDInsensitively(id.ID())
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.Get(ctx, *connectionId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", connectionId, err)
+	}
+	if resp.Model == nil {
+		return nil, fmt.Errorf("retrieving %s: `model` was nil", connectionId)
+	}
+
+	return pointer.To(flattenStorageConnection(*resp.Model)), nil
+}
+
+func (c storageConnectionsClient) updateStatus(ctx context.Context, id PrivateEndpointConnectionId, status string, description string) error {
+	connectionId, err := storageConnections.ParsePrivateEndpointConnectionIDInsensitively(id.ID())
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.client.Get(ctx, *connectionId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", connectionId, err)
+	}
+	if existing.Model == nil || existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", connectionId)
+	}
+
+	payload := *existing.Model
+	payload.Properties.ProvisioningState = nil
+	payload.Properties.PrivateLinkServiceConnectionState.Status = pointer.To(storageConnections.PrivateEndpointServiceConnectionStatus(status))
+	payload.Properties.PrivateLinkServiceConnectionState.Description = pointer.To(description)
+
+	if _, err := c.client.Put(ctx, *connectionId, payload); err != nil {
+		return fmt.Errorf("updating %s: %+v", connectionId, err)
+	}
+
+	return nil
+}
+
+func (c storageConnectionsClient) delete(ctx context.Context, id PrivateEndpointConnectionId) error {
+	connectionId, err := storageConnections.ParsePrivateEndpointConnectionIDInsensitively(id.ID())
+	if err != nil {
+		return err
+	}
+
+	if resp, err := c.client.Delete(ctx, *connectionId); err != nil && !response.WasNotFound(resp.HttpResponse) {
+		return fmt.Errorf("deleting %s: %+v", connectionId, err)
+	}
+
+	return nil
+}
+
+func flattenStorageConnection(input storageConnections.PrivateEndpointConnection) Connection {
+	out := Connection{
+		Id:       pointer.From(input.Id),
+		Name:     pointer.From(input.Name),
+		GroupIds: make([]string, 0),
+	}
+
+	if props := input.Properties; props != nil {
+		if props.PrivateEndpoint != nil {
+			out.PrivateEndpointId = pointer.From(props.PrivateEndpoint.Id)
+		}
+		out.Status = string(pointer.From(props.PrivateLinkServiceConnectionState.Status))
+		out.Description = pointer.From(props.PrivateLinkServiceConnectionState.Description)
+	}
+
+	return out
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privateendpointconnections
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PrivateEndpointConnectionId is the ID of a Private Endpoint Connection on an arbitrary target resource, in the
+// format `{targetResourceId}/privateEndpointConnections/{privateEndpointConnectionName}`
+type PrivateEndpointConnectionId struct {
+	TargetResourceId              string
+	PrivateEndpointConnectionName string
+}
+
+func NewPrivateEndpointConnectionID(targetResourceId string, privateEndpointConnectionName string) PrivateEndpointConnectionId {
+	return PrivateEndpointConnectionId{
+		TargetResourceId:              strings.TrimSuffix(targetResourceId, "/"),
+		PrivateEndpointConnectionName: privateEndpointConnectionName,
+	}
+}
+
+// ParsePrivateEndpointConnectionID parses 'input' into a PrivateEndpointConnectionId
+func ParsePrivateEndpointConnectionID(input string) (*PrivateEndpointConnectionId, error) {
+	trimmed := strings.TrimSuffix(input, "/")
+	index := strings.LastIndex(strings.ToLower(trimmed), "/privateendpointconnections/")
+	if index == -1 {
+		return nil, fmt.Errorf("parsing %q: expected the ID to contain a `privateEndpointConnections` segment", input)
+	}
+
+	targetResourceId := trimmed[:index]
+	name := trimmed[index+len("/privateEndpointConnections/"):]
+	if name == "" || strings.Contains(name, "/") {
+		return nil, fmt.Errorf("parsing %q: expected the ID to end with the name of the Private Endpoint Connection", input)
+	}
+
+	if _, err := ParseTargetResourceID(targetResourceId); err != nil {
+		return nil, fmt.Errorf("parsing %q: %+v", input, err)
+	}
+
+	id := NewPrivateEndpointConnectionID(targetResourceId, name)
+	return &id, nil
+}
+
+// ValidatePrivateEndpointConnectionID checks that 'input' can be parsed as a PrivateEndpointConnectionId
+func ValidatePrivateEndpointConnectionID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	if _, err := ParsePrivateEndpointConnectionID(v); err != nil {
+		errors = append(errors, err)
+	}
+
+	return
+}
+
+// ID returns the formatted Private Endpoint Connection ID
+func (id PrivateEndpointConnectionId) ID() string {
+	return fmt.Sprintf("%s/privateEndpointConnections/%s", id.TargetResourceId, id.PrivateEndpointConnectionName)
+}
+
+// String returns a human-readable description of this Private Endpoint Connection ID
+func (id PrivateEndpointConnectionId) String() string {
+	components := []string{
+		fmt.Sprintf("Target Resource: %q", id.TargetResourceId),
+		fmt.Sprintf("Private Endpoint Connection Name: %q", id.PrivateEndpointConnectionName),
+	}
+	return fmt.Sprintf("Private Endpoint Connection (%s)", strings.Join(components, "\n"))
+}
@@ -0,0 +1,87 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privateendpointconnections
+
+import (
+	"fmt"
+	"strings"
+)
+
+// TargetResourceId is the ID of a resource which Private Endpoints can connect to, for example
+// a Storage Account, SQL Server or Key Vault.
+type TargetResourceId struct {
+	ID                string
+	SubscriptionId    string
+	ProviderNamespace string
+
+	// ResourceType is the (potentially nested) Resource Type within the Provider Namespace, e.g. `storageAccounts`
+	// or `workspaces/privateLinkHubs`
+	ResourceType string
+}
+
+// ParseTargetResourceID parses 'input' into a TargetResourceId
+func ParseTargetResourceID(input string) (*TargetResourceId, error) {
+	segments := strings.Split(strings.Trim(input, "/"), "/")
+	if len(segments) < 2 || !strings.EqualFold(segments[0], "subscriptions") || segments[1] == "" {
+		return nil, fmt.Errorf("parsing %q: expected the ID to start with `/subscriptions/{subscriptionId}`", input)
+	}
+
+	// use the last `providers` segment so that extension resources are scoped to the correct Resource Provider
+	providersIndex := -1
+	for i, segment := range segments {
+		if strings.EqualFold(segment, "providers") {
+			providersIndex = i
+		}
+	}
+	if providersIndex == -1 || providersIndex+1 >= len(segments) {
+		return nil, fmt.Errorf("parsing %q: expected the ID to contain a Resource Provider", input)
+	}
+
+	typeSegments := segments[providersIndex+2:]
+	if len(typeSegments) == 0 || len(typeSegments)%2 != 0 {
+		return nil, fmt.Errorf("parsing %q: expected the ID to contain pairs of Resource Types and Names", input)
+	}
+
+	resourceTypes := make([]string, 0)
+	for i := 0; i < len(typeSegments); i += 2 {
+		if typeSegments[i] == "" || typeSegments[i+1] == "" {
+			return nil, fmt.Errorf("parsing %q: the Resource Type and Name segments cannot be empty", input)
+		}
+		resourceTypes = append(resourceTypes, typeSegments[i])
+	}
+
+	return &TargetResourceId{
+		ID:                "/" + strings.Join(segments, "/"),
+		SubscriptionId:    segments[1],
+		ProviderNamespace: segments[providersIndex+1],
+		ResourceType:      strings.Join(resourceTypes, "/"),
+	}, nil
+}
+
+// ValidateTargetResourceID checks that 'input' can be parsed as a TargetResourceId of a supported Resource Type
+func ValidateTargetResourceID(input interface{}, key string) (warnings []string, errors []error) {
+	v, ok := input.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected %q to be a string", key))
+		return
+	}
+
+	target, err := ParseTargetResourceID(v)
+	if err != nil {
+		errors = append(errors, err)
+		return
+	}
+
+	if !target.IsSupported() {
+		errors = append(errors, fmt.Errorf("expected %q to be the ID of one of the following Resource Types: %s", key, strings.Join(SupportedResourceTypes(), ", ")))
+	}
+
+	return
+}
+
+// IsSupported returns whether the Private Endpoint Connections of this target resource can be managed
+func (id TargetResourceId) IsSupported() bool {
+	_, ok := supportedResourceTypes[strings.ToLower(fmt.Sprintf("%s/%s", id.ProviderNamespace, id.ResourceType))]
+	return ok
+}
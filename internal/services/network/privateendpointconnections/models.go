@@ -0,0 +1,22 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package privateendpointconnections
+
+const (
+	StatusApproved     = "Approved"
+	StatusDisconnected = "Disconnected"
+	StatusPending      = "Pending"
+	StatusRejected     = "Rejected"
+)
+
+// Connection is a Private Endpoint Connection on a target resource, independent of the Resource Provider
+// which owns the target resource
+type Connection struct {
+	Id                string
+	Name              string
+	PrivateEndpointId string
+	GroupIds          []string
+	Status            string
+	Description       string
+}
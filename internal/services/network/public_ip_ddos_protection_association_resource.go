@@ -0,0 +1,241 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2023-09-01/ddosprotectionplans"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/publicipaddresses"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+const publicIpResourceName = "azurerm_public_ip"
+
+func resourcePublicIpDdosProtectionAssociation() *pluginsdk.Resource {
+	return &pluginsdk.Resource{
+		Create: resourcePublicIpDdosProtectionAssociationCreate,
+		Read:   resourcePublicIpDdosProtectionAssociationRead,
+		Update: resourcePublicIpDdosProtectionAssociationUpdate,
+		Delete: resourcePublicIpDdosProtectionAssociationDelete,
+
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := commonids.ParsePublicIPAddressID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: map[string]*pluginsdk.Schema{
+			"public_ip_address_id": {
+				Type:         pluginsdk.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: commonids.ValidatePublicIPAddressID,
+			},
+
+			"ddos_protection_plan_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				ValidateFunc: ddosprotectionplans.ValidateDdosProtectionPlanID,
+			},
+		},
+	}
+}
+
+func resourcePublicIpDdosProtectionAssociationCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PublicIPAddresses
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commonids.ParsePublicIPAddressID(d.Get("public_ip_address_id").(string))
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.PublicIPAddressesName, publicIpResourceName)
+	defer locks.UnlockByName(id.PublicIPAddressesName, publicIpResourceName)
+
+	existing, err := client.Get(ctx, *id, publicipaddresses.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return fmt.Errorf("%s was not found", id)
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+	if existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	if settings := existing.Model.Properties.DdosSettings; settings != nil && strings.EqualFold(string(pointer.From(settings.ProtectionMode)), string(publicipaddresses.DdosSettingsProtectionModeEnabled)) {
+		return tf.ImportAsExistsError("azurerm_public_ip_ddos_protection_association", id.ID())
+	}
+
+	existing.Model.Properties.DdosSettings = expandPublicIpDdosProtectionAssociation(d.Get("ddos_protection_plan_id").(string))
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, *existing.Model); err != nil {
+		return fmt.Errorf("enabling DDoS protection for %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourcePublicIpDdosProtectionAssociationRead(d, meta)
+}
+
+func resourcePublicIpDdosProtectionAssociationRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PublicIPAddresses
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commonids.ParsePublicIPAddressID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	resp, err := client.Get(ctx, *id, publicipaddresses.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			log.Printf("[DEBUG] %s was not found - removing from state!", id)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	ddosProtectionPlanId := ""
+	if model := resp.Model; model != nil {
+		if props := model.Properties; props != nil {
+			settings := props.DdosSettings
+			if settings == nil || !strings.EqualFold(string(pointer.From(settings.ProtectionMode)), string(publicipaddresses.DdosSettingsProtectionModeEnabled)) {
+				log.Printf("[DEBUG] DDoS protection is not enabled for %s - removing from state!", id)
+				d.SetId("")
+				return nil
+			}
+
+			if settings.DdosProtectionPlan != nil && settings.DdosProtectionPlan.Id != nil {
+				planId, err := ddosprotectionplans.ParseDdosProtectionPlanIDInsensitively(*settings.DdosProtectionPlan.Id)
+				if err != nil {
+					return err
+				}
+				ddosProtectionPlanId = planId.ID()
+			}
+		}
+	}
+
+	d.Set("public_ip_address_id", id.ID())
+	d.Set("ddos_protection_plan_id", ddosProtectionPlanId)
+
+	return nil
+}
+
+func resourcePublicIpDdosProtectionAssociationUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PublicIPAddresses
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commonids.ParsePublicIPAddressID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.PublicIPAddressesName, publicIpResourceName)
+	defer locks.UnlockByName(id.PublicIPAddressesName, publicIpResourceName)
+
+	existing, err := client.Get(ctx, *id, publicipaddresses.DefaultGetOperationOptions())
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+	if existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	if d.HasChange("ddos_protection_plan_id") {
+		existing.Model.Properties.DdosSettings = expandPublicIpDdosProtectionAssociation(d.Get("ddos_protection_plan_id").(string))
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, *existing.Model); err != nil {
+		return fmt.Errorf("updating DDoS protection for %s: %+v", id, err)
+	}
+
+	return resourcePublicIpDdosProtectionAssociationRead(d, meta)
+}
+
+func resourcePublicIpDdosProtectionAssociationDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Network.PublicIPAddresses
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := commonids.ParsePublicIPAddressID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	locks.ByName(id.PublicIPAddressesName, publicIpResourceName)
+	defer locks.UnlockByName(id.PublicIPAddressesName, publicIpResourceName)
+
+	existing, err := client.Get(ctx, *id, publicipaddresses.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(existing.HttpResponse) {
+			return nil
+		}
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if existing.Model == nil {
+		return fmt.Errorf("retrieving %s: `model` was nil", id)
+	}
+	if existing.Model.Properties == nil {
+		return fmt.Errorf("retrieving %s: `properties` was nil", id)
+	}
+
+	// the Public IP falls back to the protection of the Virtual Network it's attached to
+	existing.Model.Properties.DdosSettings = &publicipaddresses.DdosSettings{
+		ProtectionMode: pointer.To(publicipaddresses.DdosSettingsProtectionModeVirtualNetworkInherited),
+	}
+
+	if err := client.CreateOrUpdateThenPoll(ctx, *id, *existing.Model); err != nil {
+		return fmt.Errorf("disabling DDoS protection for %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// expandPublicIpDdosProtectionAssociation enables DDoS protection for a Public IP - when no plan is specified the
+// standalone DDoS IP Protection SKU is used.
+func expandPublicIpDdosProtectionAssociation(ddosProtectionPlanId string) *publicipaddresses.DdosSettings {
+	settings := publicipaddresses.DdosSettings{
+		ProtectionMode: pointer.To(publicipaddresses.DdosSettingsProtectionModeEnabled),
+	}
+
+	if ddosProtectionPlanId != "" {
+		settings.DdosProtectionPlan = &publicipaddresses.SubResource{
+			Id: pointer.To(ddosProtectionPlanId),
+		}
+	}
+
+	return &settings
+}
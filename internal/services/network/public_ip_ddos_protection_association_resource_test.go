@@ -0,0 +1,154 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/publicipaddresses"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type PublicIpDdosProtectionAssociationResource struct{}
+
+func TestAccPublicIpDdosProtectionAssociation_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_public_ip_ddos_protection_association", "test")
+	r := PublicIpDdosProtectionAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPublicIpDdosProtectionAssociation_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_public_ip_ddos_protection_association", "test")
+	r := PublicIpDdosProtectionAssociationResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func testAccPublicIpDdosProtectionAssociation_withPlan(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_public_ip_ddos_protection_association", "test")
+	r := PublicIpDdosProtectionAssociationResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withPlan(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (PublicIpDdosProtectionAssociationResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := commonids.ParsePublicIPAddressID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.Network.PublicIPAddresses.Get(ctx, *id, publicipaddresses.DefaultGetOperationOptions())
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	enabled := false
+	if model := resp.Model; model != nil && model.Properties != nil && model.Properties.DdosSettings != nil {
+		enabled = strings.EqualFold(string(pointer.From(model.Properties.DdosSettings.ProtectionMode)), string(publicipaddresses.DdosSettingsProtectionModeEnabled))
+	}
+
+	return pointer.To(enabled), nil
+}
+
+func (r PublicIpDdosProtectionAssociationResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_public_ip_ddos_protection_association" "test" {
+  public_ip_address_id = azurerm_public_ip.test.id
+}
+`, r.template(data))
+}
+
+func (r PublicIpDdosProtectionAssociationResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_public_ip_ddos_protection_association" "import" {
+  public_ip_address_id = azurerm_public_ip_ddos_protection_association.test.public_ip_address_id
+}
+`, r.basic(data))
+}
+
+func (r PublicIpDdosProtectionAssociationResource) withPlan(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_network_ddos_protection_plan" "test" {
+  name                = "acctestddospplan-%d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_public_ip_ddos_protection_association" "test" {
+  public_ip_address_id    = azurerm_public_ip.test.id
+  ddos_protection_plan_id = azurerm_network_ddos_protection_plan.test.id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (PublicIpDdosProtectionAssociationResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_public_ip" "test" {
+  name                = "acctestpublicip-%[1]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+  allocation_method   = "Static"
+  sku                 = "Standard"
+
+  lifecycle {
+    ignore_changes = [ddos_protection_mode, ddos_protection_plan_id]
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -35,8 +35,10 @@ func (r Registration) WebsiteCategories() []string {
 func (r Registration) DataSources() []sdk.DataSource {
 	return []sdk.DataSource{
 		ManagerDataSource{},
+		ManagerDeploymentDataSource{},
 		ManagerNetworkGroupDataSource{},
 		ManagerConnectivityConfigurationDataSource{},
+		RouteServerBgpConnectionRoutesDataSource{},
 		VPNServerConfigurationDataSource{},
 		VirtualNetworkPeeringDataSource{},
 	}
@@ -53,12 +55,14 @@ func (r Registration) Resources() []sdk.Resource {
 		ManagerNetworkGroupResource{},
 		ManagerResource{},
 		ManagerIpamPoolResource{},
+		ManagerIpamPoolStaticCidrResource{},
 		ManagerScopeConnectionResource{},
 		ManagerSecurityAdminConfigurationResource{},
 		ManagerStaticMemberResource{},
 		ManagerSubscriptionConnectionResource{},
 		ManagerVerifierWorkspaceResource{},
 		PrivateEndpointApplicationSecurityGroupAssociationResource{},
+		PrivateEndpointConnectionApprovalResource{},
 		RouteMapResource{},
 		VirtualHubRoutingIntentResource{},
 	}
@@ -137,6 +141,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_private_endpoint":                          resourcePrivateEndpoint(),
 		"azurerm_private_link_service":                      resourcePrivateLinkService(),
 		"azurerm_public_ip":                                 resourcePublicIp(),
+		"azurerm_public_ip_ddos_protection_association":     resourcePublicIpDdosProtectionAssociation(),
 		"azurerm_public_ip_prefix":                          resourcePublicIpPrefix(),
 		"azurerm_network_security_group":                    resourceNetworkSecurityGroup(),
 		"azurerm_network_security_rule":                     resourceNetworkSecurityRule(),
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualwans"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+var _ sdk.DataSource = RouteServerBgpConnectionRoutesDataSource{}
+
+type RouteServerBgpConnectionRoutesDataSource struct{}
+
+type RouteServerBgpConnectionRoutesDataSourceModel struct {
+	BgpConnectionId string                          `tfschema:"bgp_connection_id"`
+	AdvertisedRoute []RouteServerBgpConnectionRoute `tfschema:"advertised_route"`
+	LearnedRoute    []RouteServerBgpConnectionRoute `tfschema:"learned_route"`
+}
+
+type RouteServerBgpConnectionRoute struct {
+	AsPath       string `tfschema:"as_path"`
+	LocalAddress string `tfschema:"local_address"`
+	Network      string `tfschema:"network"`
+	NextHop      string `tfschema:"next_hop"`
+	Origin       string `tfschema:"origin"`
+	SourcePeer   string `tfschema:"source_peer"`
+	Weight       int64  `tfschema:"weight"`
+}
+
+func (RouteServerBgpConnectionRoutesDataSource) ResourceType() string {
+	return "azurerm_route_server_bgp_connection_routes"
+}
+
+func (RouteServerBgpConnectionRoutesDataSource) ModelObject() interface{} {
+	return &RouteServerBgpConnectionRoutesDataSourceModel{}
+}
+
+func (RouteServerBgpConnectionRoutesDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"bgp_connection_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: commonids.ValidateVirtualHubBGPConnectionID,
+		},
+	}
+}
+
+func (RouteServerBgpConnectionRoutesDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"advertised_route": routeServerBgpConnectionRouteSchema(),
+
+		"learned_route": routeServerBgpConnectionRouteSchema(),
+	}
+}
+
+func (RouteServerBgpConnectionRoutesDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 10 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualWANs
+
+			var state RouteServerBgpConnectionRoutesDataSourceModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := commonids.ParseVirtualHubBGPConnectionID(state.BgpConnectionId)
+			if err != nil {
+				return err
+			}
+
+			learned, err := client.VirtualHubBgpConnectionsListLearnedRoutes(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing learned routes for %s: %+v", id, err)
+			}
+			learnedRoutes, err := routeServerBgpConnectionRoutesFromPoller(ctx, learned.Poller)
+			if err != nil {
+				return fmt.Errorf("retrieving learned routes for %s: %+v", id, err)
+			}
+
+			advertised, err := client.VirtualHubBgpConnectionsListAdvertisedRoutes(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("listing advertised routes for %s: %+v", id, err)
+			}
+			advertisedRoutes, err := routeServerBgpConnectionRoutesFromPoller(ctx, advertised.Poller)
+			if err != nil {
+				return fmt.Errorf("retrieving advertised routes for %s: %+v", id, err)
+			}
+
+			state.BgpConnectionId = id.ID()
+			state.LearnedRoute = flattenRouteServerBgpConnectionRoutes(learnedRoutes)
+			state.AdvertisedRoute = flattenRouteServerBgpConnectionRoutes(advertisedRoutes)
+
+			metadata.SetID(id)
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func routeServerBgpConnectionRouteSchema() *pluginsdk.Schema {
+	return &pluginsdk.Schema{
+		Type:     pluginsdk.TypeList,
+		Computed: true,
+		Elem: &pluginsdk.Resource{
+			Schema: map[string]*pluginsdk.Schema{
+				"as_path": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"local_address": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"network": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"next_hop": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"origin": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"source_peer": {
+					Type:     pluginsdk.TypeString,
+					Computed: true,
+				},
+
+				"weight": {
+					Type:     pluginsdk.TypeInt,
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// routeServerBgpConnectionRoutesFromPoller waits for the route listing to complete - the routes are only returned in the
+// body of the final polling response, keyed by the Route Server instance which learned or advertised them.
+func routeServerBgpConnectionRoutesFromPoller(ctx context.Context, poller pollers.Poller) (map[string][]virtualwans.PeerRoute, error) {
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return nil, fmt.Errorf("polling: %+v", err)
+	}
+
+	lastResponse := poller.LatestResponse()
+	if lastResponse == nil {
+		return nil, fmt.Errorf("last response was nil")
+	}
+
+	result := make(map[string][]virtualwans.PeerRoute)
+	if err := lastResponse.Unmarshal(&result); err != nil {
+		return nil, fmt.Errorf("unmarshaling routes: %+v", err)
+	}
+
+	return result, nil
+}
+
+func flattenRouteServerBgpConnectionRoutes(input map[string][]virtualwans.PeerRoute) []RouteServerBgpConnectionRoute {
+	results := make([]RouteServerBgpConnectionRoute, 0)
+
+	// the routes are grouped by instance, which are sorted so that the ordering is consistent between reads
+	instances := make([]string, 0, len(input))
+	for instance := range input {
+		instances = append(instances, instance)
+	}
+	sort.Strings(instances)
+
+	for _, instance := range instances {
+		for _, route := range input[instance] {
+			results = append(results, RouteServerBgpConnectionRoute{
+				AsPath:       pointer.From(route.AsPath),
+				LocalAddress: pointer.From(route.LocalAddress),
+				Network:      pointer.From(route.Network),
+				NextHop:      pointer.From(route.NextHop),
+				Origin:       pointer.From(route.Origin),
+				SourcePeer:   pointer.From(route.SourcePeer),
+				Weight:       pointer.From(route.Weight),
+			})
+		}
+	}
+
+	return results
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package network_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type RouteServerBgpConnectionRoutesDataSource struct{}
+
+func TestAccDataSourceRouteServerBgpConnectionRoutes_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_route_server_bgp_connection_routes", "test")
+	r := RouteServerBgpConnectionRoutesDataSource{}
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("bgp_connection_id").Exists(),
+				check.That(data.ResourceName).Key("advertised_route.#").Exists(),
+				check.That(data.ResourceName).Key("learned_route.#").Exists(),
+			),
+		},
+	})
+}
+
+func (RouteServerBgpConnectionRoutesDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_route_server_bgp_connection_routes" "test" {
+  bgp_connection_id = azurerm_route_server_bgp_connection.test.id
+}
+`, RouteServerBGPConnectionResource{}.basic(data))
+}
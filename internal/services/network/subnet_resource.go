@@ -130,15 +130,19 @@ func resourceSubnet() *pluginsdk.Resource {
 			},
 
 			"address_prefixes": {
-				Type:     pluginsdk.TypeList,
-				Required: true,
-				MinItems: 1,
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MinItems:     1,
+				ExactlyOneOf: []string{"address_prefixes", "ipam_pool_prefix_allocation"},
 				Elem: &pluginsdk.Schema{
 					Type:         pluginsdk.TypeString,
 					ValidateFunc: validation.StringIsNotEmpty,
 				},
 			},
 
+			"ipam_pool_prefix_allocation": ipamPoolPrefixAllocationSchema("address_prefixes"),
+
 			"service_endpoints": {
 				Type:     pluginsdk.TypeSet,
 				Optional: true,
@@ -248,7 +252,9 @@ func resourceSubnetCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	defer locks.UnlockByName(id.VirtualNetworkName, VirtualNetworkResourceName)
 
 	properties := subnets.SubnetPropertiesFormat{}
-	if value, ok := d.GetOk("address_prefixes"); ok {
+	if value, ok := d.GetOk("ipam_pool_prefix_allocation"); ok {
+		properties.IPamPoolPrefixAllocations = expandSubnetIpamPoolPrefixAllocations(value.([]interface{}))
+	} else if value, ok := d.GetOk("address_prefixes"); ok {
 		var addressPrefixes []string
 		for _, item := range value.([]interface{}) {
 			addressPrefixes = append(addressPrefixes, item.(string))
@@ -361,7 +367,7 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		addressPrefixesRaw := d.Get("address_prefixes").([]interface{})
 		switch len(addressPrefixesRaw) {
 		case 0:
-			// Will never happen as the "MinItem: 1" constraint is set on "address_prefixes"
+			// Will never happen as the "MinItem: 1" constraint is set on "address_prefixes", and the field is Computed when `ipam_pool_prefix_allocation` is used
 		case 1:
 			// N->1: we shall insist on using the `AddressPrefix` and clear the `AddressPrefixes`.
 			props.AddressPrefix = utils.String(addressPrefixesRaw[0].(string))
@@ -374,6 +380,15 @@ func resourceSubnetUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 		}
 	}
 
+	if d.HasChange("ipam_pool_prefix_allocation") {
+		props.IPamPoolPrefixAllocations = expandSubnetIpamPoolPrefixAllocations(d.Get("ipam_pool_prefix_allocation").([]interface{}))
+		if props.IPamPoolPrefixAllocations != nil {
+			// the address prefixes are allocated by the IPAM Pool, so the previously allocated prefixes must not be sent back
+			props.AddressPrefix = nil
+			props.AddressPrefixes = nil
+		}
+	}
+
 	if d.HasChange("default_outbound_access_enabled") {
 		props.DefaultOutboundAccess = pointer.To(d.Get("default_outbound_access_enabled").(bool))
 	}
@@ -476,6 +491,10 @@ func resourceSubnetRead(d *pluginsdk.ResourceData, meta interface{}) error {
 				d.Set("address_prefixes", props.AddressPrefixes)
 			}
 
+			if err := d.Set("ipam_pool_prefix_allocation", flattenSubnetIpamPoolPrefixAllocations(props.IPamPoolPrefixAllocations)); err != nil {
+				return fmt.Errorf("setting `ipam_pool_prefix_allocation`: %+v", err)
+			}
+
 			defaultOutboundAccessEnabled := true
 			if props.DefaultOutboundAccess != nil {
 				defaultOutboundAccessEnabled = *props.DefaultOutboundAccess
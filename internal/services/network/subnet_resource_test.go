@@ -36,6 +36,23 @@ func TestAccSubnet_basic(t *testing.T) {
 	})
 }
 
+func testAccSubnet_ipamPoolPrefixAllocation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_subnet", "test")
+	r := SubnetResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ipamPoolPrefixAllocation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("address_prefixes.#").HasValue("1"),
+				check.That(data.ResourceName).Key("ipam_pool_prefix_allocation.0.allocated_address_prefixes.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccSubnet_basic_addressPrefixes(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_subnet", "test")
 	r := SubnetResource{}
@@ -519,6 +536,23 @@ func (SubnetResource) hasNoRouteTable(ctx context.Context, client *clients.Clien
 	return nil
 }
 
+func (SubnetResource) ipamPoolPrefixAllocation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_subnet" "test" {
+  name                 = "internal"
+  resource_group_name  = azurerm_resource_group.test.name
+  virtual_network_name = azurerm_virtual_network.test.name
+
+  ipam_pool_prefix_allocation {
+    ipam_pool_id           = azurerm_network_manager_ipam_pool.test.id
+    number_of_ip_addresses = "64"
+  }
+}
+`, VirtualNetworkResource{}.ipamPoolPrefixAllocation(data))
+}
+
 func (r SubnetResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
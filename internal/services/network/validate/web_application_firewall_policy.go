@@ -4,6 +4,10 @@
 package validate
 
 import (
+	"fmt"
+	"sort"
+	"strings"
+
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 )
 
@@ -86,3 +90,124 @@ var ValidateWebApplicationFirewallPolicyExclusionRuleSetType = validation.String
 	"Microsoft_BotManagerRuleSet",
 	"Microsoft_DefaultRuleSet",
 }, false)
+
+var (
+	owaspCrs3RuleGroupNames = []string{
+		"General",
+		"Known-CVEs",
+		"REQUEST-911-METHOD-ENFORCEMENT",
+		"REQUEST-913-SCANNER-DETECTION",
+		"REQUEST-920-PROTOCOL-ENFORCEMENT",
+		"REQUEST-921-PROTOCOL-ATTACK",
+		"REQUEST-930-APPLICATION-ATTACK-LFI",
+		"REQUEST-931-APPLICATION-ATTACK-RFI",
+		"REQUEST-932-APPLICATION-ATTACK-RCE",
+		"REQUEST-933-APPLICATION-ATTACK-PHP",
+		"REQUEST-941-APPLICATION-ATTACK-XSS",
+		"REQUEST-942-APPLICATION-ATTACK-SQLI",
+		"REQUEST-943-APPLICATION-ATTACK-SESSION-FIXATION",
+	}
+
+	threatIntelRuleGroupNames = []string{
+		"MS-ThreatIntel-AppSec",
+		"MS-ThreatIntel-CVEs",
+		"MS-ThreatIntel-SQLI",
+		"MS-ThreatIntel-WebShells",
+	}
+
+	botManagerRuleGroupNames = []string{
+		"BadBots",
+		"GoodBots",
+		"KnownBadBots",
+		"UnknownBots",
+	}
+
+	// webApplicationFirewallPolicyRuleGroupNamesByRuleSet maps each supported `{type}/{version}` Managed Rule Set to the
+	// Rule Groups available within it, so that typos can be caught at plan time rather than when the policy is applied
+	webApplicationFirewallPolicyRuleGroupNamesByRuleSet = map[string][]string{
+		"OWASP/2.2.9": {
+			"crs_20_protocol_violations",
+			"crs_21_protocol_anomalies",
+			"crs_23_request_limits",
+			"crs_30_http_policy",
+			"crs_35_bad_robots",
+			"crs_40_generic_attacks",
+			"crs_41_sql_injection_attacks",
+			"crs_41_xss_attacks",
+			"crs_42_tight_security",
+			"crs_45_trojans",
+			"crs_49_inbound_blocking",
+		},
+		"OWASP/3.0": owaspCrs3RuleGroupNames,
+		"OWASP/3.1": append(append([]string{}, owaspCrs3RuleGroupNames...), "REQUEST-944-APPLICATION-ATTACK-JAVA"),
+		"OWASP/3.2": append(append(append([]string{}, owaspCrs3RuleGroupNames...), "REQUEST-944-APPLICATION-ATTACK-JAVA"), threatIntelRuleGroupNames...),
+		"Microsoft_DefaultRuleSet/2.1": append([]string{
+			"General",
+			"METHOD-ENFORCEMENT",
+			"PROTOCOL-ENFORCEMENT",
+			"PROTOCOL-ATTACK",
+			"LFI",
+			"RFI",
+			"RCE",
+			"PHP",
+			"NODEJS",
+			"XSS",
+			"SQLI",
+			"FIX",
+			"JAVA",
+		}, threatIntelRuleGroupNames...),
+		"Microsoft_BotManagerRuleSet/0.1": botManagerRuleGroupNames,
+		"Microsoft_BotManagerRuleSet/1.0": botManagerRuleGroupNames,
+		"Microsoft_BotManagerRuleSet/1.1": botManagerRuleGroupNames,
+	}
+
+	// webApplicationFirewallPolicyRuleActionsByRuleSet maps each `{type}/{version}` Managed Rule Set to the actions which
+	// can be set on an individual rule - older versions of the OWASP Core Rule Set only support enabling/disabling a rule
+	webApplicationFirewallPolicyRuleActionsByRuleSet = map[string][]string{
+		"OWASP/3.2":                       {"Allow", "AnomalyScoring", "Block", "Log"},
+		"Microsoft_DefaultRuleSet/2.1":    {"Allow", "AnomalyScoring", "Block", "Log"},
+		"Microsoft_BotManagerRuleSet/0.1": {"Allow", "Block", "JSChallenge", "Log"},
+		"Microsoft_BotManagerRuleSet/1.0": {"Allow", "Block", "JSChallenge", "Log"},
+		"Microsoft_BotManagerRuleSet/1.1": {"Allow", "Block", "JSChallenge", "Log"},
+	}
+)
+
+// WebApplicationFirewallPolicyRuleGroupNameForRuleSet validates that the specified Rule Group exists within the Managed
+// Rule Set of the specified type and version. Combinations of type and version which aren't known are not validated,
+// since the API remains the source of truth for these.
+func WebApplicationFirewallPolicyRuleGroupNameForRuleSet(ruleSetType, ruleSetVersion, ruleGroupName string) error {
+	ruleGroupNames, ok := webApplicationFirewallPolicyRuleGroupNamesByRuleSet[fmt.Sprintf("%s/%s", ruleSetType, ruleSetVersion)]
+	if !ok {
+		return nil
+	}
+
+	for _, v := range ruleGroupNames {
+		if v == ruleGroupName {
+			return nil
+		}
+	}
+
+	sorted := append([]string{}, ruleGroupNames...)
+	sort.Strings(sorted)
+	return fmt.Errorf("the Rule Group %q is not available in the %q Rule Set version %q, possible values are: %s", ruleGroupName, ruleSetType, ruleSetVersion, strings.Join(sorted, ", "))
+}
+
+// WebApplicationFirewallPolicyRuleActionForRuleSet validates that the specified action can be set on a rule within the
+// Managed Rule Set of the specified type and version.
+func WebApplicationFirewallPolicyRuleActionForRuleSet(ruleSetType, ruleSetVersion, action string) error {
+	actions, ok := webApplicationFirewallPolicyRuleActionsByRuleSet[fmt.Sprintf("%s/%s", ruleSetType, ruleSetVersion)]
+	if !ok {
+		if _, known := webApplicationFirewallPolicyRuleGroupNamesByRuleSet[fmt.Sprintf("%s/%s", ruleSetType, ruleSetVersion)]; known {
+			return fmt.Errorf("the %q Rule Set version %q does not support overriding the action of a rule, rules can only be enabled or disabled", ruleSetType, ruleSetVersion)
+		}
+		return nil
+	}
+
+	for _, v := range actions {
+		if v == action {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("the action %q is not supported by the %q Rule Set version %q, possible values are: %s", action, ruleSetType, ruleSetVersion, strings.Join(actions, ", "))
+}
@@ -0,0 +1,133 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validate
+
+import "testing"
+
+func TestWebApplicationFirewallPolicyRuleGroupNameForRuleSet(t *testing.T) {
+	cases := []struct {
+		Type        string
+		Version     string
+		Input       string
+		ExpectError bool
+	}{
+		{
+			Type:        "OWASP",
+			Version:     "3.2",
+			Input:       "REQUEST-920-PROTOCOL-ENFORCEMENT",
+			ExpectError: false,
+		},
+		{
+			// typo
+			Type:        "OWASP",
+			Version:     "3.2",
+			Input:       "REQUEST-920-PROTOCOL-ENFORCMENT",
+			ExpectError: true,
+		},
+		{
+			// the Java rules were introduced in 3.1
+			Type:        "OWASP",
+			Version:     "3.0",
+			Input:       "REQUEST-944-APPLICATION-ATTACK-JAVA",
+			ExpectError: true,
+		},
+		{
+			// OWASP group names aren't valid for the Default Rule Set
+			Type:        "Microsoft_DefaultRuleSet",
+			Version:     "2.1",
+			Input:       "REQUEST-920-PROTOCOL-ENFORCEMENT",
+			ExpectError: true,
+		},
+		{
+			Type:        "Microsoft_DefaultRuleSet",
+			Version:     "2.1",
+			Input:       "PROTOCOL-ENFORCEMENT",
+			ExpectError: false,
+		},
+		{
+			Type:        "Microsoft_BotManagerRuleSet",
+			Version:     "1.1",
+			Input:       "UnknownBots",
+			ExpectError: false,
+		},
+		{
+			Type:        "Microsoft_BotManagerRuleSet",
+			Version:     "1.1",
+			Input:       "SQLI",
+			ExpectError: true,
+		},
+		{
+			// unknown combinations are left to the API
+			Type:        "Microsoft_DefaultRuleSet",
+			Version:     "3.0",
+			Input:       "SQLI",
+			ExpectError: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %s/%s: %q", tc.Type, tc.Version, tc.Input)
+
+		err := WebApplicationFirewallPolicyRuleGroupNameForRuleSet(tc.Type, tc.Version, tc.Input)
+		if (err != nil) != tc.ExpectError {
+			t.Fatalf("expected error to be %t but got: %+v", tc.ExpectError, err)
+		}
+	}
+}
+
+func TestWebApplicationFirewallPolicyRuleActionForRuleSet(t *testing.T) {
+	cases := []struct {
+		Type        string
+		Version     string
+		Input       string
+		ExpectError bool
+	}{
+		{
+			Type:        "OWASP",
+			Version:     "3.2",
+			Input:       "AnomalyScoring",
+			ExpectError: false,
+		},
+		{
+			Type:        "OWASP",
+			Version:     "3.2",
+			Input:       "JSChallenge",
+			ExpectError: true,
+		},
+		{
+			// actions can't be overridden prior to 3.2
+			Type:        "OWASP",
+			Version:     "3.1",
+			Input:       "Log",
+			ExpectError: true,
+		},
+		{
+			Type:        "Microsoft_DefaultRuleSet",
+			Version:     "2.1",
+			Input:       "Log",
+			ExpectError: false,
+		},
+		{
+			Type:        "Microsoft_BotManagerRuleSet",
+			Version:     "1.0",
+			Input:       "AnomalyScoring",
+			ExpectError: true,
+		},
+		{
+			Type:        "Microsoft_BotManagerRuleSet",
+			Version:     "1.1",
+			Input:       "JSChallenge",
+			ExpectError: false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Logf("[DEBUG] Testing %s/%s: %q", tc.Type, tc.Version, tc.Input)
+
+		err := WebApplicationFirewallPolicyRuleActionForRuleSet(tc.Type, tc.Version, tc.Input)
+		if (err != nil) != tc.ExpectError {
+			t.Fatalf("expected error to be %t but got: %+v", tc.ExpectError, err)
+		}
+	}
+}
@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -41,6 +42,26 @@ func resourceVirtualHubRouteTable() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+			// the Routing Intent is only looked up when the routes change, rather than on every plan
+			if !d.HasChanges("virtual_hub_id", "route") {
+				return nil
+			}
+
+			// the Routing Intent can only be checked once the Virtual Hub exists
+			if !d.NewValueKnown("virtual_hub_id") || !d.NewValueKnown("route") {
+				return nil
+			}
+
+			virtualHubId, err := virtualwans.ParseVirtualHubID(d.Get("virtual_hub_id").(string))
+			if err != nil {
+				return err
+			}
+
+			routes := expandVirtualHubRouteTableHubRoutes(d.Get("route").(*pluginsdk.Set).List())
+			return validateHubRoutesAgainstRoutingIntent(ctx, meta.(*clients.Client).Network.VirtualWANs, *virtualHubId, d.Get("name").(string), *routes)
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"name": {
 				Type:         pluginsdk.TypeString,
@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -41,6 +42,30 @@ func resourceVirtualHubRouteTableRoute() *pluginsdk.Resource {
 			return err
 		}),
 
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, meta interface{}) error {
+			// the Routing Intent can only be checked once the Route Table exists
+			if !d.NewValueKnown("route_table_id") || !d.NewValueKnown("destinations") || !d.NewValueKnown("next_hop") {
+				return nil
+			}
+
+			routeTableId, err := virtualwans.ParseHubRouteTableID(d.Get("route_table_id").(string))
+			if err != nil {
+				return err
+			}
+
+			routes := []virtualwans.HubRoute{
+				{
+					Name:            d.Get("name").(string),
+					DestinationType: d.Get("destinations_type").(string),
+					Destinations:    pointer.From(utils.ExpandStringSlice(d.Get("destinations").(*pluginsdk.Set).List())),
+					NextHopType:     d.Get("next_hop_type").(string),
+					NextHop:         d.Get("next_hop").(string),
+				},
+			}
+			virtualHubId := virtualwans.NewVirtualHubID(routeTableId.SubscriptionId, routeTableId.ResourceGroupName, routeTableId.VirtualHubName)
+			return validateHubRoutesAgainstRoutingIntent(ctx, meta.(*clients.Client).Network.VirtualWANs, virtualHubId, routeTableId.HubRouteTableName, routes)
+		}),
+
 		Schema: map[string]*pluginsdk.Schema{
 			"route_table_id": {
 				Type:         pluginsdk.TypeString,
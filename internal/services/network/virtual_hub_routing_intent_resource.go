@@ -6,8 +6,11 @@ package network
 import (
 	"context"
 	"fmt"
+	"net"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/virtualwans"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
@@ -30,7 +33,10 @@ type RoutingPolicy struct {
 
 type VirtualHubRoutingIntentResource struct{}
 
-var _ sdk.ResourceWithUpdate = VirtualHubRoutingIntentResource{}
+var (
+	_ sdk.ResourceWithUpdate        = VirtualHubRoutingIntentResource{}
+	_ sdk.ResourceWithCustomizeDiff = VirtualHubRoutingIntentResource{}
+)
 
 func (r VirtualHubRoutingIntentResource) ResourceType() string {
 	return "azurerm_virtual_hub_routing_intent"
@@ -214,6 +220,63 @@ func (r VirtualHubRoutingIntentResource) Read() sdk.ResourceFunc {
 	}
 }
 
+func (r VirtualHubRoutingIntentResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Network.VirtualWANs
+
+			var model VirtualHubRoutingIntentModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			policyNames := make(map[string]string)
+			for _, policy := range model.RoutingPolicies {
+				for _, destination := range policy.Destinations {
+					if existing, ok := policyNames[destination]; ok {
+						return fmt.Errorf("the destination %q is specified in both the `routing_policy` %q and %q - each destination can only be routed by a single Routing Policy", destination, existing, policy.Name)
+					}
+					policyNames[destination] = policy.Name
+				}
+			}
+
+			// the custom Route Tables are only looked up when the Routing Policies change, rather than on every plan
+			if !metadata.ResourceDiff.HasChanges("virtual_hub_id", "routing_policy") {
+				return nil
+			}
+
+			// the custom Route Tables can only be checked once the Virtual Hub exists
+			if !metadata.ResourceDiff.NewValueKnown("virtual_hub_id") || model.VirtualHubId == "" {
+				return nil
+			}
+
+			virtualHubId, err := virtualwans.ParseVirtualHubID(model.VirtualHubId)
+			if err != nil {
+				return err
+			}
+
+			routeTables, err := client.HubRouteTablesListComplete(ctx, *virtualHubId)
+			if err != nil {
+				return fmt.Errorf("listing Route Tables for %s: %+v", virtualHubId, err)
+			}
+
+			policies := pointer.From(expandRoutingPolicy(model.RoutingPolicies))
+			for _, routeTable := range routeTables.Items {
+				if routeTable.Properties == nil {
+					continue
+				}
+
+				if err := validateHubRoutesAgainstRoutingPolicies(pointer.From(routeTable.Name), pointer.From(routeTable.Properties.Routes), policies); err != nil {
+					return err
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
 func (r VirtualHubRoutingIntentResource) Delete() sdk.ResourceFunc {
 	return sdk.ResourceFunc{
 		Timeout: 30 * time.Minute,
@@ -271,3 +334,74 @@ func flattenRoutingPolicy(input *[]virtualwans.RoutingPolicy) []RoutingPolicy {
 
 	return result
 }
+
+// routingIntentPrivateTrafficPrefixes are the address ranges routed by a Routing Policy with the `PrivateTraffic` destination
+var routingIntentPrivateTrafficPrefixes = []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"}
+
+// validateHubRoutesAgainstRoutingPolicies checks that the routes of a Hub Route Table don't send traffic which is managed
+// by the Routing Intent of the Virtual Hub to a different next hop, since the Routing Intent would otherwise override them.
+func validateHubRoutesAgainstRoutingPolicies(routeTableName string, routes []virtualwans.HubRoute, policies []virtualwans.RoutingPolicy) error {
+	for _, policy := range policies {
+		// the next hop may not be known until apply time
+		if policy.NextHop == "" {
+			continue
+		}
+
+		for _, destination := range policy.Destinations {
+			for _, route := range routes {
+				if !strings.EqualFold(route.DestinationType, "CIDR") || route.NextHop == "" || strings.EqualFold(route.NextHop, policy.NextHop) {
+					continue
+				}
+
+				for _, prefix := range route.Destinations {
+					if routingPolicyDestinationContainsPrefix(destination, prefix) {
+						return fmt.Errorf("the route %q in the Hub Route Table %q sends traffic for %q to %q, which conflicts with the Routing Policy %q sending %s traffic to %q", route.Name, routeTableName, prefix, route.NextHop, policy.Name, destination, policy.NextHop)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+func routingPolicyDestinationContainsPrefix(destination, prefix string) bool {
+	switch destination {
+	case "Internet":
+		return prefix == "0.0.0.0/0"
+	case "PrivateTraffic":
+		_, prefixNet, err := net.ParseCIDR(prefix)
+		if err != nil {
+			return false
+		}
+
+		for _, v := range routingIntentPrivateTrafficPrefixes {
+			_, privateNet, _ := net.ParseCIDR(v)
+			if privateNet.Contains(prefixNet.IP) || prefixNet.Contains(privateNet.IP) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// validateHubRoutesAgainstRoutingIntent checks the routes of a Hub Route Table against the Routing Intent of the Virtual Hub, if any
+func validateHubRoutesAgainstRoutingIntent(ctx context.Context, client *virtualwans.VirtualWANsClient, virtualHubId virtualwans.VirtualHubId, routeTableName string, routes []virtualwans.HubRoute) error {
+	routingIntents, err := client.RoutingIntentListComplete(ctx, virtualHubId)
+	if err != nil {
+		return fmt.Errorf("listing Routing Intents for %s: %+v", virtualHubId, err)
+	}
+
+	for _, routingIntent := range routingIntents.Items {
+		if routingIntent.Properties == nil {
+			continue
+		}
+
+		if err := validateHubRoutesAgainstRoutingPolicies(routeTableName, routes, pointer.From(routingIntent.Properties.RoutingPolicies)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -78,6 +79,44 @@ func TestAccVirtualHubRoutingIntent_update(t *testing.T) {
 	})
 }
 
+func TestAccVirtualHubRoutingIntent_duplicateDestinations(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_routing_intent", "test")
+	r := VirtualHubRoutingIntentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.duplicateDestinations(data),
+			ExpectError: regexp.MustCompile("each destination can only be routed by a single Routing Policy"),
+		},
+	})
+}
+
+func TestAccVirtualHubRoutingIntent_conflictingRouteTable(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_hub_routing_intent", "test")
+	r := VirtualHubRoutingIntentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.routeTable(data, "10.1.0.0/16", false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.routeTable(data, "20.1.0.0/16", true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config:      r.routeTable(data, "10.1.0.0/16", true),
+			ExpectError: regexp.MustCompile("which conflicts with the Routing Policy"),
+		},
+	})
+}
+
 func (r VirtualHubRoutingIntentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := virtualwans.ParseRoutingIntentID(state.ID)
 	if err != nil {
@@ -208,3 +247,77 @@ resource "azurerm_virtual_hub_routing_intent" "test" {
 }
 `, r.template(data), data.RandomInteger)
 }
+
+func (r VirtualHubRoutingIntentResource) duplicateDestinations(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_virtual_hub_routing_intent" "test" {
+  name           = "acctest-routingintent-%d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  routing_policy {
+    name         = "InternetTrafficPolicy"
+    destinations = ["Internet"]
+    next_hop     = azurerm_firewall.test.id
+  }
+
+  routing_policy {
+    name         = "AllTrafficPolicy"
+    destinations = ["Internet", "PrivateTraffic"]
+    next_hop     = azurerm_firewall.test.id
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r VirtualHubRoutingIntentResource) routeTable(data acceptance.TestData, destination string, withRoute bool) string {
+	route := ""
+	if withRoute {
+		route = fmt.Sprintf(`
+  route {
+    name              = "custom"
+    destinations_type = "CIDR"
+    destinations      = ["%s"]
+    next_hop_type     = "ResourceId"
+    next_hop          = azurerm_virtual_hub_connection.test.id
+  }
+`, destination)
+	}
+
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%[2]d"
+  address_space       = ["10.5.0.0/16"]
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+}
+
+resource "azurerm_virtual_hub_connection" "test" {
+  name                      = "acctestvhubconn-%[2]d"
+  virtual_hub_id            = azurerm_virtual_hub.test.id
+  remote_virtual_network_id = azurerm_virtual_network.test.id
+}
+
+resource "azurerm_virtual_hub_routing_intent" "test" {
+  name           = "acctest-routingintent-%[2]d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+
+  routing_policy {
+    name         = "PrivateTrafficPolicy"
+    destinations = ["PrivateTraffic"]
+    next_hop     = azurerm_firewall.test.id
+  }
+}
+
+resource "azurerm_virtual_hub_route_table" "test" {
+  name           = "acctest-RouteTable-%[2]d"
+  virtual_hub_id = azurerm_virtual_hub.test.id
+  labels         = ["Label1"]
+%[3]s
+  depends_on = [azurerm_virtual_hub_routing_intent.test]
+}
+`, r.template(data), data.RandomInteger, route)
+}
@@ -71,15 +71,19 @@ func resourceVirtualNetworkSchema() map[string]*pluginsdk.Schema {
 		"location": commonschema.Location(),
 
 		"address_space": {
-			Type:     pluginsdk.TypeSet,
-			Required: true,
-			MinItems: 1,
+			Type:         pluginsdk.TypeSet,
+			Optional:     true,
+			Computed:     true,
+			MinItems:     1,
+			ExactlyOneOf: []string{"address_space", "ipam_pool_prefix_allocation"},
 			Elem: &pluginsdk.Schema{
 				Type:         pluginsdk.TypeString,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
 		},
 
+		"ipam_pool_prefix_allocation": ipamPoolPrefixAllocationSchema("address_space"),
+
 		// Optional
 		"bgp_community": {
 			Type:         pluginsdk.TypeString,
@@ -393,6 +397,10 @@ func resourceVirtualNetworkRead(d *pluginsdk.ResourceData, meta interface{}) err
 				if err = d.Set("address_space", space.AddressPrefixes); err != nil {
 					return fmt.Errorf("setting `address_space`: %+v", err)
 				}
+
+				if err = d.Set("ipam_pool_prefix_allocation", flattenVirtualNetworkIpamPoolPrefixAllocations(space.IPamPoolPrefixAllocations)); err != nil {
+					return fmt.Errorf("setting `ipam_pool_prefix_allocation`: %+v", err)
+				}
 			}
 
 			if err := d.Set("ddos_protection_plan", flattenVirtualNetworkDDoSProtectionPlan(props)); err != nil {
@@ -462,6 +470,18 @@ func resourceVirtualNetworkUpdate(d *pluginsdk.ResourceData, meta interface{}) e
 		payload.Properties.AddressSpace.AddressPrefixes = utils.ExpandStringSlice(d.Get("address_space").(*pluginsdk.Set).List())
 	}
 
+	if d.HasChange("ipam_pool_prefix_allocation") {
+		if payload.Properties.AddressSpace == nil {
+			payload.Properties.AddressSpace = &virtualnetworks.AddressSpace{}
+		}
+
+		payload.Properties.AddressSpace.IPamPoolPrefixAllocations = expandVirtualNetworkIpamPoolPrefixAllocations(d.Get("ipam_pool_prefix_allocation").([]interface{}))
+		if payload.Properties.AddressSpace.IPamPoolPrefixAllocations != nil {
+			// the address prefixes are allocated by the IPAM Pool, so the previously allocated prefixes must not be sent back
+			payload.Properties.AddressSpace.AddressPrefixes = nil
+		}
+	}
+
 	if d.HasChange("bgp_community") {
 		// nil out the current values in case `bgp_community` has been removed from the config file
 		payload.Properties.BgpCommunities = nil
@@ -784,7 +804,11 @@ func expandVirtualNetworkProperties(ctx context.Context, client virtualnetworks.
 		Subnets:                     &subnets,
 	}
 
-	properties.AddressSpace.AddressPrefixes = utils.ExpandStringSlice(d.Get("address_space").(*pluginsdk.Set).List())
+	if v, ok := d.GetOk("ipam_pool_prefix_allocation"); ok {
+		properties.AddressSpace.IPamPoolPrefixAllocations = expandVirtualNetworkIpamPoolPrefixAllocations(v.([]interface{}))
+	} else {
+		properties.AddressSpace.AddressPrefixes = utils.ExpandStringSlice(d.Get("address_space").(*pluginsdk.Set).List())
+	}
 
 	if v, ok := d.GetOk("ddos_protection_plan"); ok {
 		rawList := v.([]interface{})
@@ -362,6 +362,23 @@ func (r VirtualNetworkResource) Destroy(ctx context.Context, client *clients.Cli
 	return pointer.To(true), nil
 }
 
+func testAccVirtualNetwork_ipamPoolPrefixAllocation(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_virtual_network", "test")
+	r := VirtualNetworkResource{}
+
+	data.ResourceSequentialTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.ipamPoolPrefixAllocation(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("address_space.#").HasValue("1"),
+				check.That(data.ResourceName).Key("ipam_pool_prefix_allocation.0.allocated_address_prefixes.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (VirtualNetworkResource) basic(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
@@ -878,3 +895,32 @@ resource "azurerm_virtual_network" "test" {
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (VirtualNetworkResource) ipamPoolPrefixAllocation(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+%[1]s
+
+resource "azurerm_network_manager_ipam_pool" "test" {
+  name               = "acctest-ipampool-%[2]d"
+  network_manager_id = azurerm_network_manager.test.id
+  location           = azurerm_resource_group.test.location
+  display_name       = "ipampool1"
+  address_prefixes   = ["10.0.0.0/16"]
+}
+
+resource "azurerm_virtual_network" "test" {
+  name                = "acctestvirtnet%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ipam_pool_prefix_allocation {
+    ipam_pool_id           = azurerm_network_manager_ipam_pool.test.id
+    number_of_ip_addresses = "256"
+  }
+}
+`, ManagerIpamPoolResource{}.template(data), data.RandomInteger)
+}
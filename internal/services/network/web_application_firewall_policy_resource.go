@@ -4,6 +4,7 @@
 package network
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -488,6 +489,10 @@ func resourceWebApplicationFirewallPolicy() *pluginsdk.Resource {
 
 			"tags": commonschema.Tags(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+			return validateWebApplicationFirewallPolicyManagedRules(d)
+		}),
 	}
 }
 
@@ -643,6 +648,90 @@ func resourceWebApplicationFirewallPolicyDelete(d *pluginsdk.ResourceData, meta
 	return nil
 }
 
+// validateWebApplicationFirewallPolicyManagedRules checks that the Rule Groups referenced by the overrides and exclusions
+// exist within the selected Rule Set version, and that any rule actions are supported by it, since otherwise these are
+// only rejected by the API some minutes into the apply.
+func validateWebApplicationFirewallPolicyManagedRules(d *pluginsdk.ResourceDiff) error {
+	managedRules := d.Get("managed_rules").([]interface{})
+	if len(managedRules) == 0 || managedRules[0] == nil {
+		return nil
+	}
+	v := managedRules[0].(map[string]interface{})
+
+	for i, item := range v["managed_rule_set"].([]interface{}) {
+		ruleSet, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		ruleSetType := ruleSet["type"].(string)
+		ruleSetVersion := ruleSet["version"].(string)
+		// values which aren't known until apply are left to the API
+		if ruleSetType == "" || ruleSetVersion == "" {
+			continue
+		}
+
+		for j, o := range ruleSet["rule_group_override"].([]interface{}) {
+			override, ok := o.(map[string]interface{})
+			if !ok {
+				continue
+			}
+
+			if ruleGroupName := override["rule_group_name"].(string); ruleGroupName != "" {
+				if err := validate.WebApplicationFirewallPolicyRuleGroupNameForRuleSet(ruleSetType, ruleSetVersion, ruleGroupName); err != nil {
+					return fmt.Errorf("`managed_rules.0.managed_rule_set.%d.rule_group_override.%d.rule_group_name`: %+v", i, j, err)
+				}
+			}
+
+			for k, r := range override["rule"].([]interface{}) {
+				rule, ok := r.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				if action := rule["action"].(string); action != "" {
+					if err := validate.WebApplicationFirewallPolicyRuleActionForRuleSet(ruleSetType, ruleSetVersion, action); err != nil {
+						return fmt.Errorf("`managed_rules.0.managed_rule_set.%d.rule_group_override.%d.rule.%d.action`: %+v", i, j, k, err)
+					}
+				}
+			}
+		}
+	}
+
+	for i, item := range v["exclusion"].([]interface{}) {
+		exclusion, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		for _, e := range exclusion["excluded_rule_set"].([]interface{}) {
+			ruleSet, ok := e.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			ruleSetType := ruleSet["type"].(string)
+			ruleSetVersion := ruleSet["version"].(string)
+			if ruleSetType == "" || ruleSetVersion == "" {
+				continue
+			}
+
+			for j, g := range ruleSet["rule_group"].([]interface{}) {
+				ruleGroup, ok := g.(map[string]interface{})
+				if !ok {
+					continue
+				}
+
+				if ruleGroupName := ruleGroup["rule_group_name"].(string); ruleGroupName != "" {
+					if err := validate.WebApplicationFirewallPolicyRuleGroupNameForRuleSet(ruleSetType, ruleSetVersion, ruleGroupName); err != nil {
+						return fmt.Errorf("`managed_rules.0.exclusion.%d.excluded_rule_set.0.rule_group.%d.rule_group_name`: %+v", i, j, err)
+					}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
 func expandWebApplicationFirewallPolicyWebApplicationFirewallCustomRule(input []interface{}) *[]webapplicationfirewallpolicies.WebApplicationFirewallCustomRule {
 	results := make([]webapplicationfirewallpolicies.WebApplicationFirewallCustomRule, 0)
 	for _, item := range input {
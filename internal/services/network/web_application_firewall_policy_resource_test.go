@@ -6,6 +6,7 @@ package network_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/webapplicationfirewallpolicies"
@@ -434,6 +435,29 @@ func TestAccWebApplicationFirewallPolicy_fileUploadEnforcement(t *testing.T) {
 	})
 }
 
+func TestAccWebApplicationFirewallPolicy_invalidManagedRules(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_web_application_firewall_policy", "test")
+	r := WebApplicationFirewallResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.managedRuleOverride(data, "3.2", "REQUEST-920-PROTOCOL-ENFORCMENT", "Log"),
+			ExpectError: regexp.MustCompile("is not available in the \"OWASP\" Rule Set version \"3.2\""),
+		},
+		{
+			Config:      r.managedRuleOverride(data, "3.1", "REQUEST-920-PROTOCOL-ENFORCEMENT", "Log"),
+			ExpectError: regexp.MustCompile("does not support overriding the action of a rule"),
+		},
+		{
+			Config: r.managedRuleOverride(data, "3.2", "REQUEST-920-PROTOCOL-ENFORCEMENT", "AnomalyScoring"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t WebApplicationFirewallResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := webapplicationfirewallpolicies.ParseApplicationGatewayWebApplicationFirewallPolicyID(state.ID)
 	if err != nil {
@@ -1502,6 +1526,41 @@ resource "azurerm_web_application_firewall_policy" "test" {
 `, data.RandomInteger, data.Locations.Primary)
 }
 
+func (WebApplicationFirewallResource) managedRuleOverride(data acceptance.TestData, version, ruleGroupName, action string) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%[1]d"
+  location = "%[2]s"
+}
+
+resource "azurerm_web_application_firewall_policy" "test" {
+  name                = "acctestwafpolicy-%[1]d"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  managed_rules {
+    managed_rule_set {
+      type    = "OWASP"
+      version = "%[3]s"
+
+      rule_group_override {
+        rule_group_name = "%[4]s"
+        rule {
+          id      = "920300"
+          enabled = true
+          action  = "%[5]s"
+        }
+      }
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, version, ruleGroupName, action)
+}
+
 func (WebApplicationFirewallResource) withManagedRuleSetDRS(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
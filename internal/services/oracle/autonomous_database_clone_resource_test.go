@@ -0,0 +1,194 @@
+// Copyright © 2024, Oracle and/or its affiliates. All rights reserved
+
+package oracle_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/oracledatabase/2024-06-01/autonomousdatabases"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/oracle"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type AdbsCloneResource struct{}
+
+func (a AdbsCloneResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := autonomousdatabases.ParseAutonomousDatabaseID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := client.Oracle.OracleClient.AutonomousDatabases.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving adbs %s: %+v", id, err)
+	}
+	return pointer.To(resp.Model != nil), nil
+}
+
+func TestAdbsCloneResource_full(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.AutonomousDatabaseCloneResource{}.ResourceType(), "test")
+	r := AdbsCloneResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.clone(data, "Full"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("clone_type").HasValue("Full"),
+			),
+		},
+		data.ImportStep("admin_password"),
+	})
+}
+
+func TestAdbsCloneResource_metadata(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.AutonomousDatabaseCloneResource{}.ResourceType(), "test")
+	r := AdbsCloneResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.clone(data, "Metadata"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("clone_type").HasValue("Metadata"),
+			),
+		},
+		data.ImportStep("admin_password"),
+	})
+}
+
+func TestAdbsCloneResource_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.AutonomousDatabaseCloneResource{}.ResourceType(), "test")
+	r := AdbsCloneResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.clone(data, "Full"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password"),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("admin_password"),
+	})
+}
+
+func TestAdbsCloneResource_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, oracle.AutonomousDatabaseCloneResource{}.ResourceType(), "test")
+	r := AdbsCloneResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.clone(data, "Full"),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (a AdbsCloneResource) clone(data acceptance.TestData, cloneType string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_autonomous_database_clone" "test" {
+  name                             = "OFakeClone%[2]d"
+  display_name                     = "OFakeClone%[2]d"
+  resource_group_name              = azurerm_resource_group.test.name
+  location                         = "%[3]s"
+  source_autonomous_database_id    = azurerm_oracle_autonomous_database.test.id
+  clone_type                       = "%[4]s"
+  compute_model                    = "ECPU"
+  compute_count                    = 2
+  license_model                    = "BringYourOwnLicense"
+  backup_retention_period_in_days  = 12
+  auto_scaling_enabled             = false
+  auto_scaling_for_storage_enabled = false
+  mtls_connection_required         = false
+  data_storage_size_in_tbs         = 1
+  db_workload                      = "OLTP"
+  admin_password                   = "TestPass#2024#"
+  db_version                       = "19c"
+  character_set                    = "AL32UTF8"
+  national_character_set           = "AL16UTF16"
+  subnet_id                        = azurerm_subnet.test.id
+  virtual_network_id               = azurerm_virtual_network.test.id
+}
+`, a.template(data), data.RandomInteger, data.Locations.Primary, cloneType)
+}
+
+func (a AdbsCloneResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_autonomous_database_clone" "test" {
+  name                             = "OFakeClone%[2]d"
+  display_name                     = "OFakeClone%[2]d"
+  resource_group_name              = azurerm_resource_group.test.name
+  location                         = "%[3]s"
+  source_autonomous_database_id    = azurerm_oracle_autonomous_database.test.id
+  clone_type                       = "Full"
+  compute_model                    = "ECPU"
+  compute_count                    = 3
+  license_model                    = "BringYourOwnLicense"
+  backup_retention_period_in_days  = 12
+  auto_scaling_enabled             = true
+  auto_scaling_for_storage_enabled = false
+  mtls_connection_required         = false
+  data_storage_size_in_tbs         = 2
+  db_workload                      = "OLTP"
+  admin_password                   = "TestPass#2024#"
+  db_version                       = "19c"
+  character_set                    = "AL32UTF8"
+  national_character_set           = "AL16UTF16"
+  subnet_id                        = azurerm_subnet.test.id
+  virtual_network_id               = azurerm_virtual_network.test.id
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, a.template(data), data.RandomInteger, data.Locations.Primary)
+}
+
+func (a AdbsCloneResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_oracle_autonomous_database_clone" "import" {
+  name                             = azurerm_oracle_autonomous_database_clone.test.name
+  display_name                     = azurerm_oracle_autonomous_database_clone.test.display_name
+  resource_group_name              = azurerm_oracle_autonomous_database_clone.test.resource_group_name
+  location                         = azurerm_oracle_autonomous_database_clone.test.location
+  source_autonomous_database_id    = azurerm_oracle_autonomous_database_clone.test.source_autonomous_database_id
+  clone_type                       = azurerm_oracle_autonomous_database_clone.test.clone_type
+  compute_model                    = azurerm_oracle_autonomous_database_clone.test.compute_model
+  compute_count                    = azurerm_oracle_autonomous_database_clone.test.compute_count
+  license_model                    = azurerm_oracle_autonomous_database_clone.test.license_model
+  backup_retention_period_in_days  = azurerm_oracle_autonomous_database_clone.test.backup_retention_period_in_days
+  auto_scaling_enabled             = azurerm_oracle_autonomous_database_clone.test.auto_scaling_enabled
+  auto_scaling_for_storage_enabled = azurerm_oracle_autonomous_database_clone.test.auto_scaling_for_storage_enabled
+  mtls_connection_required         = azurerm_oracle_autonomous_database_clone.test.mtls_connection_required
+  data_storage_size_in_tbs         = azurerm_oracle_autonomous_database_clone.test.data_storage_size_in_tbs
+  db_workload                      = azurerm_oracle_autonomous_database_clone.test.db_workload
+  admin_password                   = azurerm_oracle_autonomous_database_clone.test.admin_password
+  db_version                       = azurerm_oracle_autonomous_database_clone.test.db_version
+  character_set                    = azurerm_oracle_autonomous_database_clone.test.character_set
+  national_character_set           = azurerm_oracle_autonomous_database_clone.test.national_character_set
+  subnet_id                        = azurerm_oracle_autonomous_database_clone.test.subnet_id
+  virtual_network_id               = azurerm_oracle_autonomous_database_clone.test.virtual_network_id
+}
+`, a.clone(data, "Full"))
+}
+
+func (a AdbsCloneResource) template(data acceptance.TestData) string {
+	return AdbsRegularResource{}.basic(data)
+}
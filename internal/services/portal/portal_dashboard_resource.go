@@ -4,9 +4,12 @@
 package portal
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
+	"strconv"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
@@ -19,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/portal/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
@@ -57,12 +61,100 @@ func resourcePortalDashboard() *pluginsdk.Resource {
 			"tags": commonschema.Tags(),
 
 			"dashboard_properties": {
-				Type:         pluginsdk.TypeString,
-				Required:     true,
-				ValidateFunc: validate.DashboardProperties,
-				StateFunc:    utils.NormalizeJson,
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validate.DashboardProperties,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+				ExactlyOneOf:     []string{"dashboard_properties", "lens"},
+			},
+
+			"lens": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				Computed:     true,
+				ExactlyOneOf: []string{"dashboard_properties", "lens"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"part": {
+							Type:     pluginsdk.TypeList,
+							Optional: true,
+							Elem: &pluginsdk.Resource{
+								Schema: map[string]*pluginsdk.Schema{
+									"x": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+
+									"y": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(0),
+									},
+
+									"column_span": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+
+									"row_span": {
+										Type:         pluginsdk.TypeInt,
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+
+									"metadata": {
+										Type:             pluginsdk.TypeString,
+										Optional:         true,
+										ValidateFunc:     validation.StringIsJSON,
+										StateFunc:        utils.NormalizeJson,
+										DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+									},
+								},
+							},
+						},
+
+						"metadata": {
+							Type:             pluginsdk.TypeString,
+							Optional:         true,
+							ValidateFunc:     validation.StringIsJSON,
+							StateFunc:        utils.NormalizeJson,
+							DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+						},
+					},
+				},
+			},
+
+			"metadata": {
+				Type:             pluginsdk.TypeString,
+				Optional:         true,
+				Computed:         true,
+				ValidateFunc:     validation.StringIsJSON,
+				StateFunc:        utils.NormalizeJson,
+				DiffSuppressFunc: pluginsdk.SuppressJsonDiff,
+				ConflictsWith:    []string{"dashboard_properties"},
 			},
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(func(ctx context.Context, d *pluginsdk.ResourceDiff, _ interface{}) error {
+			// `dashboard_properties` and the structured `lens`/`metadata` fields describe the same data, so when one
+			// of them changes the other must be recalculated from the API
+			if d.HasChange("dashboard_properties") {
+				if err := d.SetNewComputed("lens"); err != nil {
+					return err
+				}
+				return d.SetNewComputed("metadata")
+			}
+
+			if d.HasChanges("lens", "metadata") && d.GetRawConfig().AsValueMap()["dashboard_properties"].IsNull() {
+				return d.SetNewComputed("dashboard_properties")
+			}
+
+			return nil
+		}),
 	}
 }
 
@@ -91,14 +183,22 @@ func resourcePortalDashboardCreateUpdate(d *pluginsdk.ResourceData, meta interfa
 		Tags:     tags.Expand(d.Get("tags").(map[string]interface{})),
 	}
 
-	var dashboardProperties dashboard.DashboardProperties
+	if d.GetRawConfig().AsValueMap()["dashboard_properties"].IsNull() {
+		dashboardProperties, err := expandPortalDashboardProperties(d.Get("lens").([]interface{}), d.Get("metadata").(string))
+		if err != nil {
+			return err
+		}
+		props.Properties = dashboardProperties
+	} else {
+		var dashboardProperties dashboard.DashboardProperties
 
-	dashboardPropsRaw := d.Get("dashboard_properties").(string)
-	if err := json.Unmarshal([]byte(dashboardPropsRaw), &dashboardProperties); err != nil {
-		return fmt.Errorf("parsing JSON: %+v", err)
-	}
+		dashboardPropsRaw := d.Get("dashboard_properties").(string)
+		if err := json.Unmarshal([]byte(dashboardPropsRaw), &dashboardProperties); err != nil {
+			return fmt.Errorf("parsing JSON: %+v", err)
+		}
 
-	props.Properties = &dashboardProperties
+		props.Properties = &dashboardProperties
+	}
 
 	if _, err := client.CreateOrUpdate(ctx, id, props); err != nil {
 		return fmt.Errorf("creating/updating %s %+v", id, err)
@@ -140,6 +240,20 @@ func resourcePortalDashboardRead(d *pluginsdk.ResourceData, meta interface{}) er
 				return fmt.Errorf("parsing JSON for Dashboard Properties: %+v", err)
 			}
 			d.Set("dashboard_properties", string(v))
+
+			lenses, err := flattenPortalDashboardLenses(props.Lenses)
+			if err != nil {
+				return err
+			}
+			if err := d.Set("lens", lenses); err != nil {
+				return fmt.Errorf("setting `lens`: %+v", err)
+			}
+
+			metadata, err := flattenPortalDashboardMetadata(props.Metadata)
+			if err != nil {
+				return fmt.Errorf("flattening `metadata`: %+v", err)
+			}
+			d.Set("metadata", metadata)
 		}
 
 		return tags.FlattenAndSet(d, model.Tags)
@@ -167,3 +281,141 @@ func resourcePortalDashboardDelete(d *pluginsdk.ResourceData, meta interface{})
 
 	return nil
 }
+
+func expandPortalDashboardProperties(input []interface{}, metadataRaw string) (*dashboard.DashboardProperties, error) {
+	lenses := make(map[string]dashboard.DashboardLens)
+	for i, item := range input {
+		lens := dashboard.DashboardLens{
+			Order: int64(i),
+			Parts: make(map[string]dashboard.DashboardParts),
+		}
+
+		// an empty `lens` block is returned as `nil`
+		v, ok := item.(map[string]interface{})
+		if ok {
+			for j, partRaw := range v["part"].([]interface{}) {
+				part := partRaw.(map[string]interface{})
+				dashboardPart := dashboard.DashboardParts{
+					Position: dashboard.DashboardPartsPosition{
+						X:       int64(part["x"].(int)),
+						Y:       int64(part["y"].(int)),
+						ColSpan: int64(part["column_span"].(int)),
+						RowSpan: int64(part["row_span"].(int)),
+					},
+				}
+
+				if metadata := part["metadata"].(string); metadata != "" {
+					var partMetadata interface{}
+					if err := json.Unmarshal([]byte(metadata), &partMetadata); err != nil {
+						return nil, fmt.Errorf("parsing JSON for `metadata` of part %d in lens %d: %+v", j, i, err)
+					}
+					dashboardPart.Metadata = &partMetadata
+				}
+
+				lens.Parts[strconv.Itoa(j)] = dashboardPart
+			}
+
+			if metadata := v["metadata"].(string); metadata != "" {
+				lensMetadata := make(map[string]interface{})
+				if err := json.Unmarshal([]byte(metadata), &lensMetadata); err != nil {
+					return nil, fmt.Errorf("parsing JSON for `metadata` of lens %d: %+v", i, err)
+				}
+				lens.Metadata = &lensMetadata
+			}
+		}
+
+		lenses[strconv.Itoa(i)] = lens
+	}
+
+	output := dashboard.DashboardProperties{
+		Lenses: &lenses,
+	}
+
+	if metadataRaw != "" {
+		metadata := make(map[string]interface{})
+		if err := json.Unmarshal([]byte(metadataRaw), &metadata); err != nil {
+			return nil, fmt.Errorf("parsing JSON for `metadata`: %+v", err)
+		}
+		output.Metadata = &metadata
+	}
+
+	return &output, nil
+}
+
+func flattenPortalDashboardLenses(input *map[string]dashboard.DashboardLens) ([]interface{}, error) {
+	output := make([]interface{}, 0)
+	if input == nil {
+		return output, nil
+	}
+
+	lenses := make([]dashboard.DashboardLens, 0)
+	for _, v := range *input {
+		lenses = append(lenses, v)
+	}
+	sort.SliceStable(lenses, func(i, j int) bool {
+		return lenses[i].Order < lenses[j].Order
+	})
+
+	for i, lens := range lenses {
+		// parts are keyed by their index, which needs to be compared numerically to retain the ordering
+		partKeys := make([]string, 0)
+		for k := range lens.Parts {
+			partKeys = append(partKeys, k)
+		}
+		sort.SliceStable(partKeys, func(i, j int) bool {
+			a, errA := strconv.Atoi(partKeys[i])
+			b, errB := strconv.Atoi(partKeys[j])
+			if errA != nil || errB != nil {
+				return partKeys[i] < partKeys[j]
+			}
+			return a < b
+		})
+
+		parts := make([]interface{}, 0)
+		for _, k := range partKeys {
+			part := lens.Parts[k]
+
+			metadata := ""
+			if part.Metadata != nil {
+				v, err := json.Marshal(part.Metadata)
+				if err != nil {
+					return nil, fmt.Errorf("flattening `metadata` of part %q in lens %d: %+v", k, i, err)
+				}
+				metadata = string(v)
+			}
+
+			parts = append(parts, map[string]interface{}{
+				"x":           int(part.Position.X),
+				"y":           int(part.Position.Y),
+				"column_span": int(part.Position.ColSpan),
+				"row_span":    int(part.Position.RowSpan),
+				"metadata":    metadata,
+			})
+		}
+
+		metadata, err := flattenPortalDashboardMetadata(lens.Metadata)
+		if err != nil {
+			return nil, fmt.Errorf("flattening `metadata` of lens %d: %+v", i, err)
+		}
+
+		output = append(output, map[string]interface{}{
+			"part":     parts,
+			"metadata": metadata,
+		})
+	}
+
+	return output, nil
+}
+
+func flattenPortalDashboardMetadata(input *map[string]interface{}) (string, error) {
+	if input == nil || len(*input) == 0 {
+		return "", nil
+	}
+
+	v, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	return string(v), nil
+}
@@ -60,6 +60,52 @@ func TestAccPortalDashboard_hiddenTitle(t *testing.T) {
 	})
 }
 
+func TestAccPortalDashboard_lens(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_portal_dashboard", "test")
+	r := PortalDashboardResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.lens(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lens.0.part.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.lensUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lens.0.part.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccPortalDashboard_dashboardPropertiesToLens(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_portal_dashboard", "test")
+	r := PortalDashboardResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lens.0.part.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.lensUpdated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("lens.0.part.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (PortalDashboardResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := dashboard.ParseDashboardID(state.ID)
 	if err != nil {
@@ -207,3 +253,115 @@ DASH
 }
 `, data.RandomInteger, data.Locations.Primary)
 }
+
+func (PortalDashboardResource) lens(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_portal_dashboard" "test" {
+  name                = "my-test-dashboard"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  lens {
+    part {
+      x           = 0
+      y           = 0
+      column_span = 3
+      row_span    = 2
+      metadata = jsonencode({
+        inputs = []
+        type   = "Extension/HubsExtension/PartType/MarkdownPart"
+        settings = {
+          content = {
+            settings = {
+              content  = "## This is only a test :)"
+              subtitle = ""
+              title    = "Test MD Tile"
+            }
+          }
+        }
+      })
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (PortalDashboardResource) lensUpdated(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_portal_dashboard" "test" {
+  name                = "my-test-dashboard"
+  resource_group_name = azurerm_resource_group.test.name
+  location            = azurerm_resource_group.test.location
+
+  lens {
+    part {
+      x           = 0
+      y           = 0
+      column_span = 3
+      row_span    = 2
+      metadata = jsonencode({
+        inputs = []
+        type   = "Extension/HubsExtension/PartType/MarkdownPart"
+        settings = {
+          content = {
+            settings = {
+              content  = "## This is only a test :)"
+              subtitle = ""
+              title    = "Test MD Tile"
+            }
+          }
+        }
+      })
+    }
+
+    part {
+      x           = 3
+      y           = 0
+      column_span = 3
+      row_span    = 2
+      metadata = jsonencode({
+        inputs   = []
+        type     = "Extension/HubsExtension/PartType/ClockPart"
+        settings = {}
+      })
+    }
+  }
+
+  metadata = jsonencode({
+    model = {
+      timeRange = {
+        type = "MsPortalFx.Composition.Configuration.ValueTypes.TimeRange"
+        value = {
+          relative = {
+            duration = 24
+            timeUnit = 1
+          }
+        }
+      }
+    }
+  })
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
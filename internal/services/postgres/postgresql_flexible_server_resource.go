@@ -351,30 +351,35 @@ func resourcePostgresqlFlexibleServer() *pluginsdk.Resource {
 		},
 
 		CustomizeDiff: pluginsdk.CustomDiffWithAll(func(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
-			createModeVal := d.Get("create_mode").(string)
+			// `create_mode` can only be changed in-place to `Update`, which is used to perform operations on an existing server
+			if d.HasChange("create_mode") && d.Get("create_mode").(string) != string(servers.CreateModeUpdate) {
+				d.ForceNew("create_mode")
+			}
 
-			if createModeVal == string(servers.CreateModeUpdate) {
-				oldVersionVal, newVersionVal := d.GetChange("version")
+			oldVersionVal, newVersionVal := d.GetChange("version")
+			if !d.HasChange("version") || oldVersionVal.(string) == "" || newVersionVal.(string) == "" {
+				return nil
+			}
 
-				if oldVersionVal != "" && newVersionVal != "" {
-					oldVersion, err := strconv.ParseInt(oldVersionVal.(string), 10, 32)
-					if err != nil {
-						return err
-					}
+			oldVersion, err := strconv.ParseInt(oldVersionVal.(string), 10, 32)
+			if err != nil {
+				return err
+			}
 
-					newVersion, err := strconv.ParseInt(newVersionVal.(string), 10, 32)
-					if err != nil {
-						return err
-					}
+			newVersion, err := strconv.ParseInt(newVersionVal.(string), 10, 32)
+			if err != nil {
+				return err
+			}
 
-					if oldVersion < newVersion {
-						return nil
-					}
-				}
+			// a Major Version Upgrade is performed in-place, however the version of a server can't be downgraded
+			if newVersion < oldVersion {
+				return d.ForceNew("version")
 			}
 
-			d.ForceNew("create_mode")
-			d.ForceNew("version")
+			// Major Version Upgrades aren't supported on Read Replicas, they need to be promoted to a standalone server first
+			if replicationRole := d.Get("replication_role").(string); d.Get("create_mode").(string) == string(servers.CreateModeReplica) && replicationRole != string(servers.ReplicationRoleNone) {
+				return fmt.Errorf("`version` cannot be upgraded from %d to %d on a Read Replica, `replication_role` must be set to `None` to promote the server first", oldVersion, newVersion)
+			}
 
 			return nil
 		}, func(ctx context.Context, diff *pluginsdk.ResourceDiff, v interface{}) error {
@@ -943,9 +948,11 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 		parameters.Properties.CreateMode = &createMode
 	}
 
+	// a Major Version Upgrade has to be performed on its own, prior to any other changes being applied to the server
 	if d.HasChange("version") {
-		version := servers.ServerVersion(d.Get("version").(string))
-		parameters.Properties.Version = &version
+		if err := upgradePostgresqlFlexibleServerMajorVersion(ctx, client, *id, servers.ServerVersion(d.Get("version").(string))); err != nil {
+			return err
+		}
 	}
 
 	if requireUpdateOnLogin {
@@ -987,6 +994,43 @@ func resourcePostgresqlFlexibleServerUpdate(d *pluginsdk.ResourceData, meta inte
 	return resourcePostgresqlFlexibleServerRead(d, meta)
 }
 
+func upgradePostgresqlFlexibleServerMajorVersion(ctx context.Context, client *servers.ServersClient, id servers.FlexibleServerId, version servers.ServerVersion) error {
+	existing, err := client.Get(ctx, id)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if model := existing.Model; model != nil && model.Properties != nil {
+		props := model.Properties
+
+		if state := pointer.From(props.State); state != servers.ServerStateReady {
+			return fmt.Errorf("upgrading the major version of %s: the server must be in the `%s` state but was `%s`", id, servers.ServerStateReady, state)
+		}
+
+		if role := pointer.From(props.ReplicationRole); role == servers.ReplicationRoleAsyncReplica || role == servers.ReplicationRoleGeoAsyncReplica {
+			return fmt.Errorf("upgrading the major version of %s: Major Version Upgrades are not supported on Read Replicas", id)
+		}
+
+		if pointer.From(props.Version) == version {
+			return nil
+		}
+	}
+
+	log.Printf("[DEBUG] Upgrading the major version of %s to %q..", id, version)
+	updateMode := servers.CreateModeForUpdateUpdate
+	parameters := servers.ServerForUpdate{
+		Properties: &servers.ServerPropertiesForUpdate{
+			CreateMode: &updateMode,
+			Version:    &version,
+		},
+	}
+	if err := client.UpdateThenPoll(ctx, id, parameters); err != nil {
+		return fmt.Errorf("upgrading the major version of %s to %q: %+v", id, version, err)
+	}
+
+	return nil
+}
+
 func resourcePostgresqlFlexibleServerDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Postgres.FlexibleServersClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationnetworks"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationpolicies"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationprotecteditems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationprotectionclusters"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationprotectioncontainermappings"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationprotectioncontainers"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationrecoveryplans"
@@ -53,6 +54,7 @@ type Client struct {
 	ContainerMappingClient                    *replicationprotectioncontainermappings.ReplicationProtectionContainerMappingsClient
 	NetworkMappingClient                      *replicationnetworkmappings.ReplicationNetworkMappingsClient
 	ReplicationProtectedItemsClient           *replicationprotecteditems.ReplicationProtectedItemsClient
+	ReplicationProtectionClustersClient       *replicationprotectionclusters.ReplicationProtectionClustersClient
 	ReplicationRecoveryPlansClient            *replicationrecoveryplans.ReplicationRecoveryPlansClient
 	ReplicationNetworksClient                 *replicationnetworks.ReplicationNetworksClient
 	ResourceGuardProxyClient                  *resourceguardproxy.ResourceGuardProxyClient
@@ -142,6 +144,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(replicationMigrationItemsClient.Client, o.Authorizers.ResourceManager)
 
+	replicationProtectionClustersClient, err := replicationprotectionclusters.NewReplicationProtectionClustersClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building ReplicationProtectionClusters client: %+v", err)
+	}
+	o.Configure(replicationProtectionClustersClient.Client, o.Authorizers.ResourceManager)
+
 	replicationRecoveryPlanClient, err := replicationrecoveryplans.NewReplicationRecoveryPlansClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building ReplicationNetworks client: %+v", err)
@@ -189,6 +197,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		ContainerMappingClient:                    containerMappingClient,
 		NetworkMappingClient:                      networkMappingClient,
 		ReplicationProtectedItemsClient:           replicationMigrationItemsClient,
+		ReplicationProtectionClustersClient:       replicationProtectionClustersClient,
 		ReplicationRecoveryPlansClient:            replicationRecoveryPlanClient,
 		ReplicationNetworksClient:                 replicationNetworksClient,
 		ResourceGuardProxyClient:                  &resourceGuardProxyClient,
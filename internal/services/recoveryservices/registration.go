@@ -38,6 +38,7 @@ func (r Registration) Resources() []sdk.Resource {
 		VMWareReplicationPolicyAssociationResource{},
 		VaultGuardProxyResource{},
 		VMWareReplicatedVmResource{},
+		SiteRecoveryReplicationProtectionClusterResource{},
 	}
 }
 
@@ -0,0 +1,277 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationfabrics"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationpolicies"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationprotecteditems"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationprotectionclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SiteRecoveryReplicationProtectionClusterModel struct {
+	Name                        string   `tfschema:"name"`
+	SourceProtectionContainerId string   `tfschema:"source_protection_container_id"`
+	TargetRecoveryFabricId      string   `tfschema:"target_recovery_fabric_id"`
+	TargetProtectionContainerId string   `tfschema:"target_protection_container_id"`
+	ReplicationPolicyId         string   `tfschema:"replication_policy_id"`
+	ReplicatedProtectedItemIds  []string `tfschema:"replicated_protected_item_ids"`
+	ClusterFqdn                 string   `tfschema:"cluster_fqdn"`
+	ClusterNodeFqdns            []string `tfschema:"cluster_node_fqdns"`
+	MultiVmGroupName            string   `tfschema:"multi_vm_group_name"`
+	ProtectionState             string   `tfschema:"protection_state"`
+	ReplicationHealth           string   `tfschema:"replication_health"`
+}
+
+type SiteRecoveryReplicationProtectionClusterResource struct{}
+
+var _ sdk.Resource = SiteRecoveryReplicationProtectionClusterResource{}
+
+func (r SiteRecoveryReplicationProtectionClusterResource) ResourceType() string {
+	return "azurerm_site_recovery_replication_protection_cluster"
+}
+
+func (r SiteRecoveryReplicationProtectionClusterResource) ModelObject() interface{} {
+	return &SiteRecoveryReplicationProtectionClusterModel{}
+}
+
+func (r SiteRecoveryReplicationProtectionClusterResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return replicationprotectionclusters.ValidateReplicationProtectionClusterID
+}
+
+func (r SiteRecoveryReplicationProtectionClusterResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"source_protection_container_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: replicationprotectionclusters.ValidateReplicationProtectionContainerID,
+		},
+
+		"target_recovery_fabric_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: replicationfabrics.ValidateReplicationFabricID,
+		},
+
+		"target_protection_container_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: replicationprotectionclusters.ValidateReplicationProtectionContainerID,
+		},
+
+		"replication_policy_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: replicationpolicies.ValidateReplicationPolicyID,
+		},
+
+		"replicated_protected_item_ids": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			ForceNew: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: replicationprotecteditems.ValidateReplicationProtectedItemID,
+			},
+		},
+
+		"cluster_fqdn": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"cluster_node_fqdns": {
+			Type:     pluginsdk.TypeList,
+			Optional: true,
+			Computed: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"multi_vm_group_name": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r SiteRecoveryReplicationProtectionClusterResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"protection_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"replication_health": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r SiteRecoveryReplicationProtectionClusterResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 120 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.ReplicationProtectionClustersClient
+
+			var model SiteRecoveryReplicationProtectionClusterModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			containerId, err := replicationprotectionclusters.ParseReplicationProtectionContainerID(model.SourceProtectionContainerId)
+			if err != nil {
+				return err
+			}
+
+			id := replicationprotectionclusters.NewReplicationProtectionClusterID(containerId.SubscriptionId, containerId.ResourceGroupName, containerId.VaultName, containerId.ReplicationFabricName, containerId.ReplicationProtectionContainerName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			providerSpecificDetails := replicationprotectionclusters.A2AReplicationProtectionClusterDetails{}
+			if model.MultiVmGroupName != "" {
+				providerSpecificDetails.MultiVMGroupName = pointer.To(model.MultiVmGroupName)
+				providerSpecificDetails.MultiVMGroupCreateOption = pointer.To(replicationprotectionclusters.MultiVMGroupCreateOptionUserSpecified)
+			}
+
+			properties := replicationprotectionclusters.ReplicationProtectionClusterProperties{
+				ClusterProtectedItemIds: pointer.To(model.ReplicatedProtectedItemIds),
+				PolicyId:                pointer.To(model.ReplicationPolicyId),
+				ProviderSpecificDetails: providerSpecificDetails,
+				RecoveryContainerId:     pointer.To(model.TargetProtectionContainerId),
+				RecoveryFabricId:        pointer.To(model.TargetRecoveryFabricId),
+			}
+
+			if model.ClusterFqdn != "" {
+				properties.ClusterFqdn = pointer.To(model.ClusterFqdn)
+			}
+
+			if len(model.ClusterNodeFqdns) > 0 {
+				properties.ClusterNodeFqdns = pointer.To(model.ClusterNodeFqdns)
+			}
+
+			payload := replicationprotectionclusters.ReplicationProtectionCluster{
+				Properties: &properties,
+			}
+
+			if err := client.CreateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SiteRecoveryReplicationProtectionClusterResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.ReplicationProtectionClustersClient
+
+			id, err := replicationprotectionclusters.ParseReplicationProtectionClusterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SiteRecoveryReplicationProtectionClusterModel{
+				Name:                        id.ReplicationProtectionClusterName,
+				SourceProtectionContainerId: replicationprotectionclusters.NewReplicationProtectionContainerID(id.SubscriptionId, id.ResourceGroupName, id.VaultName, id.ReplicationFabricName, id.ReplicationProtectionContainerName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.TargetRecoveryFabricId = handleAzureSdkForGoBug2824(pointer.From(props.RecoveryFabricId))
+					state.TargetProtectionContainerId = handleAzureSdkForGoBug2824(pointer.From(props.RecoveryContainerId))
+					state.ReplicationPolicyId = handleAzureSdkForGoBug2824(pointer.From(props.PolicyId))
+					state.ClusterFqdn = pointer.From(props.ClusterFqdn)
+					state.ClusterNodeFqdns = pointer.From(props.ClusterNodeFqdns)
+					state.ProtectionState = pointer.From(props.ProtectionState)
+					state.ReplicationHealth = pointer.From(props.ReplicationHealth)
+
+					protectedItemIds := make([]string, 0)
+					for _, v := range pointer.From(props.ClusterProtectedItemIds) {
+						protectedItemIds = append(protectedItemIds, handleAzureSdkForGoBug2824(v))
+					}
+					state.ReplicatedProtectedItemIds = protectedItemIds
+
+					if details, ok := props.ProviderSpecificDetails.(replicationprotectionclusters.A2AReplicationProtectionClusterDetails); ok {
+						state.MultiVmGroupName = pointer.From(details.MultiVMGroupName)
+					}
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SiteRecoveryReplicationProtectionClusterResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 120 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.RecoveryServices.ReplicationProtectionClustersClient
+
+			id, err := replicationprotectionclusters.ParseReplicationProtectionClusterID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if err := client.PurgeThenPoll(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package recoveryservices_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/recoveryservicessiterecovery/2024-04-01/replicationprotectionclusters"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type SiteRecoveryReplicationProtectionClusterResource struct {
+	sourceContainerId string
+	targetFabricId    string
+	targetContainerId string
+	policyId          string
+	protectedItemIds  []string
+}
+
+// protecting a cluster requires the cluster nodes to already be replicated with shared disks, which can't be
+// provisioned as part of the test configuration - so these are sourced from the environment.
+func newSiteRecoveryReplicationProtectionClusterResource(t *testing.T) SiteRecoveryReplicationProtectionClusterResource {
+	r := SiteRecoveryReplicationProtectionClusterResource{
+		sourceContainerId: os.Getenv("ARM_TEST_SITE_RECOVERY_SOURCE_PROTECTION_CONTAINER_ID"),
+		targetFabricId:    os.Getenv("ARM_TEST_SITE_RECOVERY_TARGET_FABRIC_ID"),
+		targetContainerId: os.Getenv("ARM_TEST_SITE_RECOVERY_TARGET_PROTECTION_CONTAINER_ID"),
+		policyId:          os.Getenv("ARM_TEST_SITE_RECOVERY_REPLICATION_POLICY_ID"),
+	}
+	if v := os.Getenv("ARM_TEST_SITE_RECOVERY_PROTECTED_ITEM_IDS"); v != "" {
+		r.protectedItemIds = strings.Split(v, ",")
+	}
+
+	if r.sourceContainerId == "" || r.targetFabricId == "" || r.targetContainerId == "" || r.policyId == "" || len(r.protectedItemIds) == 0 {
+		t.Skip("Skipping as ARM_TEST_SITE_RECOVERY_SOURCE_PROTECTION_CONTAINER_ID, ARM_TEST_SITE_RECOVERY_TARGET_FABRIC_ID, ARM_TEST_SITE_RECOVERY_TARGET_PROTECTION_CONTAINER_ID, ARM_TEST_SITE_RECOVERY_REPLICATION_POLICY_ID or ARM_TEST_SITE_RECOVERY_PROTECTED_ITEM_IDS not set")
+	}
+
+	return r
+}
+
+func TestAccSiteRecoveryReplicationProtectionCluster_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_replication_protection_cluster", "test")
+	r := newSiteRecoveryReplicationProtectionClusterResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("multi_vm_group_name").IsSet(),
+			),
+		},
+		data.ImportStep(),
+		{
+			// the values populated by Azure when omitted mustn't cause the cluster to be replaced
+			Config:   r.basic(data),
+			PlanOnly: true,
+		},
+	})
+}
+
+func TestAccSiteRecoveryReplicationProtectionCluster_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_replication_protection_cluster", "test")
+	r := newSiteRecoveryReplicationProtectionClusterResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccSiteRecoveryReplicationProtectionCluster_complete(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_replication_protection_cluster", "test")
+	r := newSiteRecoveryReplicationProtectionClusterResource(t)
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r SiteRecoveryReplicationProtectionClusterResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_site_recovery_replication_protection_cluster" "test" {
+  name                           = "acctest-cluster-%[1]d"
+  source_protection_container_id = "%[2]s"
+  target_recovery_fabric_id      = "%[3]s"
+  target_protection_container_id = "%[4]s"
+  replication_policy_id          = "%[5]s"
+  replicated_protected_item_ids  = ["%[6]s"]
+}
+`, data.RandomInteger, r.sourceContainerId, r.targetFabricId, r.targetContainerId, r.policyId, strings.Join(r.protectedItemIds, `", "`))
+}
+
+func (r SiteRecoveryReplicationProtectionClusterResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_site_recovery_replication_protection_cluster" "import" {
+  name                           = azurerm_site_recovery_replication_protection_cluster.test.name
+  source_protection_container_id = azurerm_site_recovery_replication_protection_cluster.test.source_protection_container_id
+  target_recovery_fabric_id      = azurerm_site_recovery_replication_protection_cluster.test.target_recovery_fabric_id
+  target_protection_container_id = azurerm_site_recovery_replication_protection_cluster.test.target_protection_container_id
+  replication_policy_id          = azurerm_site_recovery_replication_protection_cluster.test.replication_policy_id
+  replicated_protected_item_ids  = azurerm_site_recovery_replication_protection_cluster.test.replicated_protected_item_ids
+}
+`, r.basic(data))
+}
+
+func (r SiteRecoveryReplicationProtectionClusterResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_site_recovery_replication_protection_cluster" "test" {
+  name                           = "acctest-cluster-%[1]d"
+  source_protection_container_id = "%[2]s"
+  target_recovery_fabric_id      = "%[3]s"
+  target_protection_container_id = "%[4]s"
+  replication_policy_id          = "%[5]s"
+  replicated_protected_item_ids  = ["%[6]s"]
+  multi_vm_group_name            = "acctest-group-%[1]d"
+}
+`, data.RandomInteger, r.sourceContainerId, r.targetFabricId, r.targetContainerId, r.policyId, strings.Join(r.protectedItemIds, `", "`))
+}
+
+func (r SiteRecoveryReplicationProtectionClusterResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := replicationprotectionclusters.ParseReplicationProtectionClusterID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := clients.RecoveryServices.ReplicationProtectionClustersClient.Get(ctx, *id)
+	if err != nil {
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return pointer.To(resp.Model != nil), nil
+}
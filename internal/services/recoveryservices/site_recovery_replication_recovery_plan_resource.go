@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
@@ -71,7 +72,10 @@ type ReplicationRecoveryPlanA2ASpecificInputModel struct {
 
 type SiteRecoveryReplicationRecoveryPlanResource struct{}
 
-var _ sdk.ResourceWithUpdate = SiteRecoveryReplicationRecoveryPlanResource{}
+var (
+	_ sdk.ResourceWithUpdate        = SiteRecoveryReplicationRecoveryPlanResource{}
+	_ sdk.ResourceWithCustomizeDiff = SiteRecoveryReplicationRecoveryPlanResource{}
+)
 
 func (r SiteRecoveryReplicationRecoveryPlanResource) ResourceType() string {
 	return "azurerm_site_recovery_replication_recovery_plan"
@@ -318,6 +322,20 @@ func replicationRecoveryPlanA2ASchema() *pluginsdk.Schema {
 	}
 }
 
+func (r SiteRecoveryReplicationRecoveryPlanResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			var model SiteRecoveryReplicationRecoveryPlanModel
+			if err := metadata.DecodeDiff(&model); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return validateRecoveryGroups(model, metadata.ResourceDiff)
+		},
+	}
+}
+
 func (r SiteRecoveryReplicationRecoveryPlanResource) Attributes() map[string]*schema.Schema {
 	return map[string]*schema.Schema{}
 }
@@ -356,17 +374,12 @@ func (r SiteRecoveryReplicationRecoveryPlanResource) Create() sdk.ResourceFunc {
 			// FailoverDeploymentModelClassic is used for other cloud service back up to Azure.
 			deploymentModel := replicationrecoveryplans.FailoverDeploymentModelResourceManager
 
-			groupValue, err := expandRecoveryGroup(model.ShutdownRecoveryGroup, model.FailoverRecoveryGroup, model.BootRecoveryGroup)
-			if err != nil {
-				return fmt.Errorf("expanding recovery group: %+v", err)
-			}
-
 			parameters := replicationrecoveryplans.CreateRecoveryPlanInput{
 				Properties: replicationrecoveryplans.CreateRecoveryPlanInputProperties{
 					PrimaryFabricId:         model.SourceRecoveryFabricId,
 					RecoveryFabricId:        model.TargetRecoveryFabricId,
 					FailoverDeploymentModel: &deploymentModel,
-					Groups:                  groupValue,
+					Groups:                  expandRecoveryGroup(model.ShutdownRecoveryGroup, model.FailoverRecoveryGroup, model.BootRecoveryGroup),
 				},
 			}
 
@@ -475,11 +488,7 @@ func (r SiteRecoveryReplicationRecoveryPlanResource) Update() sdk.ResourceFunc {
 			if metadata.ResourceData.HasChange("boot_recovery_group") ||
 				metadata.ResourceData.HasChange("failover_recovery_group") ||
 				metadata.ResourceData.HasChange("shutdown_recovery_group") {
-				groupValue, err = expandRecoveryGroup(model.ShutdownRecoveryGroup, model.FailoverRecoveryGroup, model.BootRecoveryGroup)
-			}
-
-			if err != nil {
-				return fmt.Errorf("expanding recovery group: %+v", err)
+				groupValue = expandRecoveryGroup(model.ShutdownRecoveryGroup, model.FailoverRecoveryGroup, model.BootRecoveryGroup)
 			}
 
 			parameters := replicationrecoveryplans.UpdateRecoveryPlanInput{
@@ -519,18 +528,12 @@ func (r SiteRecoveryReplicationRecoveryPlanResource) Delete() sdk.ResourceFunc {
 	}
 }
 
-func expandRecoveryGroup(shutdown []GenericRecoveryGroupModel, failover []GenericRecoveryGroupModel, boot []BootRecoveryGroupModel) ([]replicationrecoveryplans.RecoveryPlanGroup, error) {
+func expandRecoveryGroup(shutdown []GenericRecoveryGroupModel, failover []GenericRecoveryGroupModel, boot []BootRecoveryGroupModel) []replicationrecoveryplans.RecoveryPlanGroup {
 	output := make([]replicationrecoveryplans.RecoveryPlanGroup, 0)
 
 	for _, group := range shutdown {
-		preActions, err := expandAction(group.PreAction)
-		if err != nil {
-			return output, err
-		}
-		postActions, err := expandAction(group.PostAction)
-		if err != nil {
-			return output, err
-		}
+		preActions := expandAction(group.PreAction)
+		postActions := expandAction(group.PostAction)
 
 		output = append(output, replicationrecoveryplans.RecoveryPlanGroup{
 			GroupType:         replicationrecoveryplans.RecoveryPlanGroupTypeShutdown,
@@ -540,14 +543,8 @@ func expandRecoveryGroup(shutdown []GenericRecoveryGroupModel, failover []Generi
 	}
 
 	for _, group := range failover {
-		preActions, err := expandAction(group.PreAction)
-		if err != nil {
-			return output, err
-		}
-		postActions, err := expandAction(group.PostAction)
-		if err != nil {
-			return output, err
-		}
+		preActions := expandAction(group.PreAction)
+		postActions := expandAction(group.PostAction)
 
 		output = append(output, replicationrecoveryplans.RecoveryPlanGroup{
 			GroupType:         replicationrecoveryplans.RecoveryPlanGroupTypeFailover,
@@ -564,14 +561,8 @@ func expandRecoveryGroup(shutdown []GenericRecoveryGroupModel, failover []Generi
 			})
 		}
 
-		preActions, err := expandAction(group.PreAction)
-		if err != nil {
-			return output, err
-		}
-		postActions, err := expandAction(group.PostAction)
-		if err != nil {
-			return output, err
-		}
+		preActions := expandAction(group.PreAction)
+		postActions := expandAction(group.PostAction)
 
 		output = append(output, replicationrecoveryplans.RecoveryPlanGroup{
 			GroupType:                 replicationrecoveryplans.RecoveryPlanGroupTypeBoot,
@@ -581,10 +572,10 @@ func expandRecoveryGroup(shutdown []GenericRecoveryGroupModel, failover []Generi
 		})
 	}
 
-	return output, nil
+	return output
 }
 
-func expandAction(input []ActionModel) ([]replicationrecoveryplans.RecoveryPlanAction, error) {
+func expandAction(input []ActionModel) []replicationrecoveryplans.RecoveryPlanAction {
 	output := make([]replicationrecoveryplans.RecoveryPlanAction, 0)
 	for _, action := range input {
 		failoverDirections := make([]replicationrecoveryplans.PossibleOperationsDirections, 0)
@@ -597,10 +588,6 @@ func expandAction(input []ActionModel) ([]replicationrecoveryplans.RecoveryPlanA
 			failoverTypes = append(failoverTypes, replicationrecoveryplans.ReplicationProtectedItemOperation(failoverType))
 		}
 
-		if action.ActionDetailType == "ManualActionDetails" && action.FabricLocation != "" {
-			return nil, fmt.Errorf("`fabric_location` must not be specified for `recovery_group` with `ManualActionDetails` type")
-		}
-
 		output = append(output, replicationrecoveryplans.RecoveryPlanAction{
 			ActionName:         action.Name,
 			FailoverDirections: failoverDirections,
@@ -609,7 +596,105 @@ func expandAction(input []ActionModel) ([]replicationrecoveryplans.RecoveryPlanA
 		})
 	}
 
-	return output, nil
+	return output
+}
+
+// validateRecoveryGroups validates the actions of each recovery group, and that a replicated item is only started by
+// a single boot group.
+// validateRecoveryGroups validates the planned recovery groups, skipping any values which aren't known until apply
+func validateRecoveryGroups(model SiteRecoveryReplicationRecoveryPlanModel, diff *pluginsdk.ResourceDiff) error {
+	// the path of each action is tracked so that the known-ness of its values can be checked
+	type actionWithPath struct {
+		path   string
+		action ActionModel
+	}
+	actions := make([]actionWithPath, 0)
+	addActions := func(prefix string, preActions, postActions []ActionModel) {
+		for i, action := range preActions {
+			actions = append(actions, actionWithPath{path: fmt.Sprintf("%s.pre_action.%d", prefix, i), action: action})
+		}
+		for i, action := range postActions {
+			actions = append(actions, actionWithPath{path: fmt.Sprintf("%s.post_action.%d", prefix, i), action: action})
+		}
+	}
+	for i, group := range model.ShutdownRecoveryGroup {
+		addActions(fmt.Sprintf("shutdown_recovery_group.%d", i), group.PreAction, group.PostAction)
+	}
+	for i, group := range model.FailoverRecoveryGroup {
+		addActions(fmt.Sprintf("failover_recovery_group.%d", i), group.PreAction, group.PostAction)
+	}
+	for i, group := range model.BootRecoveryGroup {
+		addActions(fmt.Sprintf("boot_recovery_group.%d", i), group.PreAction, group.PostAction)
+	}
+
+	for _, v := range actions {
+		valueKnown := func(key string) bool {
+			return diff.NewValueKnown(fmt.Sprintf("%s.%s", v.path, key))
+		}
+		if err := validateAction(v.action, valueKnown); err != nil {
+			return err
+		}
+	}
+
+	bootGroupItems := make(map[string]int)
+	for i, group := range model.BootRecoveryGroup {
+		for j, protectedItem := range group.ReplicatedProtectedItems {
+			// unknown IDs decode as an empty string, so can't be compared until apply
+			if protectedItem == "" || !diff.NewValueKnown(fmt.Sprintf("boot_recovery_group.%d.replicated_protected_items.%d", i, j)) {
+				continue
+			}
+
+			if index, ok := bootGroupItems[strings.ToLower(protectedItem)]; ok {
+				return fmt.Errorf("replicated protected item %q is specified in both `boot_recovery_group.%d` and `boot_recovery_group.%d`", protectedItem, index, i)
+			}
+			bootGroupItems[strings.ToLower(protectedItem)] = i
+		}
+	}
+
+	return nil
+}
+
+// validateAction validates the fields of an action which are required or disallowed by its type. A value which isn't
+// known until apply is treated as specified, since it decodes as an empty string
+func validateAction(action ActionModel, valueKnown func(key string) bool) error {
+	missing := func(key, value string) bool {
+		return value == "" && valueKnown(key)
+	}
+
+	switch action.ActionDetailType {
+	case "AutomationRunbookActionDetails":
+		if missing("runbook_id", action.RunbookId) {
+			return fmt.Errorf("`runbook_id` must be specified for action `%s` with `AutomationRunbookActionDetails` type", action.Name)
+		}
+		if missing("fabric_location", action.FabricLocation) {
+			return fmt.Errorf("`fabric_location` must be specified for action `%s` with `AutomationRunbookActionDetails` type", action.Name)
+		}
+		if action.ManualActionInstruction != "" || action.ScriptPath != "" {
+			return fmt.Errorf("`manual_action_instruction` and `script_path` must not be specified for action `%s` with `AutomationRunbookActionDetails` type", action.Name)
+		}
+	case "ManualActionDetails":
+		if missing("manual_action_instruction", action.ManualActionInstruction) {
+			return fmt.Errorf("`manual_action_instruction` must be specified for action `%s` with `ManualActionDetails` type", action.Name)
+		}
+		if action.FabricLocation != "" {
+			return fmt.Errorf("`fabric_location` must not be specified for `recovery_group` with `ManualActionDetails` type")
+		}
+		if action.RunbookId != "" || action.ScriptPath != "" {
+			return fmt.Errorf("`runbook_id` and `script_path` must not be specified for action `%s` with `ManualActionDetails` type", action.Name)
+		}
+	case "ScriptActionDetails":
+		if missing("script_path", action.ScriptPath) {
+			return fmt.Errorf("`script_path` must be specified for action `%s` with `ScriptActionDetails` type", action.Name)
+		}
+		if missing("fabric_location", action.FabricLocation) {
+			return fmt.Errorf("`fabric_location` must be specified for action `%s` with `ScriptActionDetails` type", action.Name)
+		}
+		if action.RunbookId != "" || action.ManualActionInstruction != "" {
+			return fmt.Errorf("`runbook_id` and `manual_action_instruction` must not be specified for action `%s` with `ScriptActionDetails` type", action.Name)
+		}
+	}
+
+	return nil
 }
 
 func expandA2ASettings(input ReplicationRecoveryPlanA2ASpecificInputModel) *[]replicationrecoveryplans.RecoveryPlanProviderSpecificInput {
@@ -164,6 +164,24 @@ func TestAccSiteRecoveryReplicationRecoveryPlan_withMultiBootGroup(t *testing.T)
 	})
 }
 
+func TestAccSiteRecoveryReplicationRecoveryPlan_bootGroupsWithItemsCreatedTogether(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_replication_recovery_plan", "test")
+	r := SiteRecoveryReplicationRecoveryPlan{}
+
+	// the replicated protected item IDs aren't known at plan time, which mustn't be treated as duplicates
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.bootGroupsWithItemsCreatedTogether(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("boot_recovery_group.0.replicated_protected_items.#").HasValue("1"),
+				check.That(data.ResourceName).Key("boot_recovery_group.1.replicated_protected_items.#").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccSiteRecoveryReplicationRecoveryPlan_wrongActions(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_site_recovery_replication_recovery_plan", "test")
 	r := SiteRecoveryReplicationRecoveryPlan{}
@@ -176,6 +194,30 @@ func TestAccSiteRecoveryReplicationRecoveryPlan_wrongActions(t *testing.T) {
 	})
 }
 
+func TestAccSiteRecoveryReplicationRecoveryPlan_runbookActionWithoutRunbookId(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_replication_recovery_plan", "test")
+	r := SiteRecoveryReplicationRecoveryPlan{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.runbookActionWithoutRunbookId(data),
+			ExpectError: regexp.MustCompile("`runbook_id` must be specified for action `testPreAction` with `AutomationRunbookActionDetails` type"),
+		},
+	})
+}
+
+func TestAccSiteRecoveryReplicationRecoveryPlan_duplicateBootGroupItems(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_site_recovery_replication_recovery_plan", "test")
+	r := SiteRecoveryReplicationRecoveryPlan{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config:      r.duplicateBootGroupItems(data),
+			ExpectError: regexp.MustCompile("is specified in both `boot_recovery_group.0` and `boot_recovery_group.1`"),
+		},
+	})
+}
+
 func (SiteRecoveryReplicationRecoveryPlan) template(data acceptance.TestData) string {
 	tags := ""
 	if strings.HasPrefix(strings.ToLower(data.Client().SubscriptionID), "85b3dbca") {
@@ -676,6 +718,111 @@ resource "azurerm_site_recovery_replication_recovery_plan" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r SiteRecoveryReplicationRecoveryPlan) bootGroupsWithItemsCreatedTogether(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%[1]s
+
+resource "azurerm_network_interface" "second" {
+  name                = "vm2-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  ip_configuration {
+    name                          = "vm2-%[2]d"
+    subnet_id                     = azurerm_subnet.test1.id
+    private_ip_address_allocation = "Dynamic"
+  }
+}
+
+resource "azurerm_virtual_machine" "second" {
+  name                = "vm2-%[2]d"
+  location            = azurerm_resource_group.test.location
+  resource_group_name = azurerm_resource_group.test.name
+
+  vm_size = "Standard_B1s"
+
+  delete_os_disk_on_termination = true
+
+  storage_image_reference {
+    publisher = "OpenLogic"
+    offer     = "CentOS"
+    sku       = "7.5"
+    version   = "latest"
+  }
+
+  storage_os_disk {
+    name              = "disk2-%[2]d"
+    os_type           = "Linux"
+    caching           = "ReadWrite"
+    create_option     = "FromImage"
+    managed_disk_type = "Premium_LRS"
+  }
+
+  os_profile {
+    admin_username = "testadmin"
+    admin_password = "Password1234!"
+    computer_name  = "vm2-%[2]d"
+  }
+
+  os_profile_linux_config {
+    disable_password_authentication = false
+  }
+  network_interface_ids = [azurerm_network_interface.second.id]
+}
+
+resource "azurerm_site_recovery_replicated_vm" "second" {
+  name                                      = "repl2-%[2]d"
+  resource_group_name                       = azurerm_resource_group.test2.name
+  recovery_vault_name                       = azurerm_recovery_services_vault.test.name
+  source_vm_id                              = azurerm_virtual_machine.second.id
+  source_recovery_fabric_name               = azurerm_site_recovery_fabric.test1.name
+  recovery_replication_policy_id            = azurerm_site_recovery_replication_policy.test.id
+  source_recovery_protection_container_name = azurerm_site_recovery_protection_container.test1.name
+
+  target_resource_group_id                = azurerm_resource_group.test2.id
+  target_recovery_fabric_id               = azurerm_site_recovery_fabric.test2.id
+  target_recovery_protection_container_id = azurerm_site_recovery_protection_container.test2.id
+
+  managed_disk {
+    disk_id                    = azurerm_virtual_machine.second.storage_os_disk[0].managed_disk_id
+    staging_storage_account_id = azurerm_storage_account.test.id
+    target_resource_group_id   = azurerm_resource_group.test2.id
+    target_disk_type           = "Premium_LRS"
+    target_replica_disk_type   = "Premium_LRS"
+  }
+
+  network_interface {
+    source_network_interface_id = azurerm_network_interface.second.id
+    target_subnet_name          = "snet-%[2]d_2"
+  }
+
+  depends_on = [
+    azurerm_site_recovery_protection_container_mapping.test,
+    azurerm_site_recovery_network_mapping.test,
+  ]
+}
+
+resource "azurerm_site_recovery_replication_recovery_plan" "test" {
+  name                      = "acctest-%[2]d"
+  recovery_vault_id         = azurerm_recovery_services_vault.test.id
+  source_recovery_fabric_id = azurerm_site_recovery_fabric.test1.id
+  target_recovery_fabric_id = azurerm_site_recovery_fabric.test2.id
+
+  shutdown_recovery_group {}
+
+  failover_recovery_group {}
+
+  boot_recovery_group {
+    replicated_protected_items = [azurerm_site_recovery_replicated_vm.test.id]
+  }
+
+  boot_recovery_group {
+    replicated_protected_items = [azurerm_site_recovery_replicated_vm.second.id]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r SiteRecoveryReplicationRecoveryPlan) wrongActions(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
@@ -707,6 +854,60 @@ resource "azurerm_site_recovery_replication_recovery_plan" "test" {
 `, r.template(data), data.RandomInteger)
 }
 
+func (r SiteRecoveryReplicationRecoveryPlan) runbookActionWithoutRunbookId(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_site_recovery_replication_recovery_plan" "test" {
+  name                      = "acctest-%[2]d"
+  recovery_vault_id         = azurerm_recovery_services_vault.test.id
+  source_recovery_fabric_id = azurerm_site_recovery_fabric.test1.id
+  target_recovery_fabric_id = azurerm_site_recovery_fabric.test2.id
+
+  shutdown_recovery_group {}
+
+  failover_recovery_group {}
+
+  boot_recovery_group {
+    replicated_protected_items = [azurerm_site_recovery_replicated_vm.test.id]
+
+    pre_action {
+      name                 = "testPreAction"
+      type                 = "AutomationRunbookActionDetails"
+      fail_over_directions = ["PrimaryToRecovery"]
+      fail_over_types      = ["TestFailover"]
+      fabric_location      = "Recovery"
+    }
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r SiteRecoveryReplicationRecoveryPlan) duplicateBootGroupItems(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_site_recovery_replication_recovery_plan" "test" {
+  name                      = "acctest-%[2]d"
+  recovery_vault_id         = azurerm_recovery_services_vault.test.id
+  source_recovery_fabric_id = azurerm_site_recovery_fabric.test1.id
+  target_recovery_fabric_id = azurerm_site_recovery_fabric.test2.id
+
+  shutdown_recovery_group {}
+
+  failover_recovery_group {}
+
+  boot_recovery_group {
+    replicated_protected_items = [azurerm_site_recovery_replicated_vm.test.id]
+  }
+
+  boot_recovery_group {
+    replicated_protected_items = [azurerm_site_recovery_replicated_vm.test.id]
+  }
+}
+`, r.template(data), data.RandomInteger)
+}
+
 func (r SiteRecoveryReplicationRecoveryPlan) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := replicationrecoveryplans.ParseReplicationRecoveryPlanID(state.ID)
 	if err != nil {
@@ -23,6 +23,7 @@ type Client struct {
 	DeploymentScriptsClient             *deploymentscripts.DeploymentScriptsClient
 	FeaturesClient                      *features.FeaturesClient
 	LocksClient                         *managementlocks.ManagementLocksClient
+	MarketplaceSaasClient               *MarketplaceSaasClient
 	PrivateLinkAssociationClient        *privatelinkassociation.PrivateLinkAssociationClient
 	ResourceGroupsClient                *resourcegroups.ResourceGroupsClient
 	ResourceManagementPrivateLinkClient *resourcemanagementprivatelink.ResourceManagementPrivateLinkClient
@@ -65,6 +66,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(locksClient.Client, o.Authorizers.ResourceManager)
 
+	marketplaceSaasClient, err := NewMarketplaceSaasClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building MarketplaceSaas client: %+v", err)
+	}
+	o.Configure(marketplaceSaasClient.Client, o.Authorizers.ResourceManager)
+
 	privateLinkAssociationClient, err := privatelinkassociation.NewPrivateLinkAssociationClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building PrivateLinkAssociation client: %+v", err)
@@ -109,6 +116,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		DeploymentScriptsClient:             deploymentScriptsClient,
 		FeaturesClient:                      featuresClient,
 		LocksClient:                         locksClient,
+		MarketplaceSaasClient:               marketplaceSaasClient,
 		PrivateLinkAssociationClient:        privateLinkAssociationClient,
 		ResourceManagementPrivateLinkClient: resourceManagementPrivateLinkClient,
 		ResourceGroupsClient:                resourceGroupsClient,
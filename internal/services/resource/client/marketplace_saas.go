@@ -0,0 +1,169 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/resourcemanager"
+	sdkEnv "github.com/hashicorp/go-azure-sdk/sdk/environments"
+)
+
+// MarketplaceSaasApiVersion is the API Version used for `Microsoft.SaaS/resources` - this is only available as a beta
+// API and has no corresponding SDK, so it's kept to this client rather than being used via the generic Resources API.
+const MarketplaceSaasApiVersion = "2018-03-01-beta"
+
+type MarketplaceSaasClient struct {
+	Client *resourcemanager.Client
+}
+
+func NewMarketplaceSaasClientWithBaseURI(sdkApi sdkEnv.Api) (*MarketplaceSaasClient, error) {
+	c, err := resourcemanager.NewClient(sdkApi, "saas", MarketplaceSaasApiVersion)
+	if err != nil {
+		return nil, fmt.Errorf("instantiating MarketplaceSaasClient: %+v", err)
+	}
+
+	return &MarketplaceSaasClient{
+		Client: c,
+	}, nil
+}
+
+type MarketplaceSaasResource struct {
+	Id         *string                            `json:"id,omitempty"`
+	Location   *string                            `json:"location,omitempty"`
+	Name       *string                            `json:"name,omitempty"`
+	Properties *MarketplaceSaasResourceProperties `json:"properties,omitempty"`
+	Tags       *map[string]string                 `json:"tags,omitempty"`
+}
+
+type MarketplaceSaasResourceProperties struct {
+	AutoRenew              *bool              `json:"autoRenew,omitempty"`
+	OfferId                *string            `json:"offerId,omitempty"`
+	PaymentChannelMetadata *map[string]string `json:"paymentChannelMetadata,omitempty"`
+	PaymentChannelType     *string            `json:"paymentChannelType,omitempty"`
+	PublisherId            *string            `json:"publisherId,omitempty"`
+	Quantity               *int64             `json:"quantity,omitempty"`
+	SaasResourceName       *string            `json:"saasResourceName,omitempty"`
+	SkuId                  *string            `json:"skuId,omitempty"`
+	Status                 *string            `json:"status,omitempty"`
+	TermId                 *string            `json:"termId,omitempty"`
+}
+
+type MarketplaceSaasGetResponse struct {
+	HttpResponse *http.Response
+	Model        *MarketplaceSaasResource
+}
+
+// Get retrieves the SaaS Resource with the specified Resource ID.
+func (c MarketplaceSaasClient) Get(ctx context.Context, id string) (result MarketplaceSaasGetResponse, err error) {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodGet,
+		Path:       id,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return
+	}
+
+	var resp *client.Response
+	resp, err = req.Execute(ctx)
+	if resp != nil {
+		result.HttpResponse = resp.Response
+	}
+	if err != nil {
+		return
+	}
+
+	var model MarketplaceSaasResource
+	result.Model = &model
+	if err = resp.Unmarshal(result.Model); err != nil {
+		return
+	}
+
+	return
+}
+
+// CreateOrUpdateThenPoll creates or updates the SaaS Resource with the specified Resource ID, then polls until the
+// operation has completed.
+func (c MarketplaceSaasClient) CreateOrUpdateThenPoll(ctx context.Context, id string, input MarketplaceSaasResource) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusCreated,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodPut,
+		Path:       id,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	if err := req.Marshal(input); err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing CreateOrUpdate: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after CreateOrUpdate: %+v", err)
+	}
+
+	return nil
+}
+
+// DeleteThenPoll deletes (unsubscribes) the SaaS Resource with the specified Resource ID, then polls until the
+// operation has completed.
+func (c MarketplaceSaasClient) DeleteThenPoll(ctx context.Context, id string) error {
+	opts := client.RequestOptions{
+		ContentType: "application/json; charset=utf-8",
+		ExpectedStatusCodes: []int{
+			http.StatusAccepted,
+			http.StatusNoContent,
+			http.StatusOK,
+		},
+		HttpMethod: http.MethodDelete,
+		Path:       id,
+	}
+
+	req, err := c.Client.NewRequest(ctx, opts)
+	if err != nil {
+		return err
+	}
+
+	resp, err := req.Execute(ctx)
+	if err != nil {
+		return fmt.Errorf("performing Delete: %+v", err)
+	}
+
+	poller, err := resourcemanager.PollerFromResponse(resp, c.Client)
+	if err != nil {
+		return err
+	}
+
+	if err := poller.PollUntilDone(ctx); err != nil {
+		return fmt.Errorf("polling after Delete: %+v", err)
+	}
+
+	return nil
+}
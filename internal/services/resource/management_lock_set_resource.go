@@ -0,0 +1,465 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+// managementLockSetParallelism is the maximum number of Management Lock operations which are in-flight at any one time
+const managementLockSetParallelism = 20
+
+type ManagementLockSetResource struct{}
+
+var (
+	_ sdk.ResourceWithUpdate         = ManagementLockSetResource{}
+	_ sdk.ResourceWithCustomizeDiff  = ManagementLockSetResource{}
+	_ sdk.ResourceWithCustomImporter = ManagementLockSetResource{}
+)
+
+type ManagementLockSetModel struct {
+	Name        string   `tfschema:"name"`
+	ParentScope string   `tfschema:"parent_scope"`
+	Scopes      []string `tfschema:"scopes"`
+	LockLevel   string   `tfschema:"lock_level"`
+	Notes       string   `tfschema:"notes"`
+}
+
+func (r ManagementLockSetResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validate.ManagementLockName,
+		},
+
+		"parent_scope": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.Any(
+				commonids.ValidateSubscriptionID,
+				commonids.ValidateResourceGroupID,
+			),
+		},
+
+		"scopes": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+
+		"lock_level": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ValidateFunc: validation.StringInSlice([]string{
+				string(managementlocks.LockLevelCanNotDelete),
+				string(managementlocks.LockLevelReadOnly),
+			}, false),
+		},
+
+		"notes": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			ValidateFunc: validation.StringLenBetween(0, 512),
+		},
+	}
+}
+
+func (r ManagementLockSetResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r ManagementLockSetResource) ModelObject() interface{} {
+	return &ManagementLockSetModel{}
+}
+
+func (r ManagementLockSetResource) ResourceType() string {
+	return "azurerm_management_lock_set"
+}
+
+func (r ManagementLockSetResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return managementlocks.ValidateScopedLockID
+}
+
+func (r ManagementLockSetResource) CustomImporter() sdk.ResourceRunFunc {
+	return func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+		// the scopes can't be determined from the ID, and adopting every Management Lock with this name would also
+		// adopt Management Locks which are managed elsewhere
+		return fmt.Errorf("%s can't be imported - instead the existing Management Locks should be removed and then managed using this resource, or imported individually using `azurerm_management_lock`", r.ResourceType())
+	}
+}
+
+func (r ManagementLockSetResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			parentScope := diff.Get("parent_scope").(string)
+			if parentScope == "" {
+				return nil
+			}
+
+			for _, v := range diff.Get("scopes").(*pluginsdk.Set).List() {
+				scope, ok := v.(string)
+				if !ok || scope == "" {
+					continue
+				}
+
+				if !strings.HasPrefix(strings.ToLower(scope), strings.ToLower(strings.TrimSuffix(parentScope, "/"))+"/") {
+					return fmt.Errorf("the scope %q must be within the `parent_scope` %q", scope, parentScope)
+				}
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r ManagementLockSetResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.LocksClient
+
+			var config ManagementLockSetModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := managementlocks.NewScopedLockID(config.ParentScope, config.Name)
+
+			if err := checkManagementLockSetLocksDoNotExist(ctx, client, id, config.Scopes); err != nil {
+				return err
+			}
+
+			// the ID is set prior to creating the Management Locks so that any which are created are tracked, should
+			// creating the others fail
+			metadata.SetID(id)
+
+			return reconcileManagementLockSet(ctx, client, config, []string{}, config.Scopes, false)
+		},
+	}
+}
+
+func (r ManagementLockSetResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.LocksClient
+
+			id, err := managementlocks.ParseScopedLockID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var state ManagementLockSetModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			locks := make([]managementlocks.ManagementLockObject, 0)
+			var mu sync.Mutex
+			operations := make([]func() error, 0)
+			for _, scope := range state.Scopes {
+				lockId := managementlocks.NewScopedLockID(scope, id.LockName)
+				operations = append(operations, func() error {
+					resp, err := client.GetByScope(ctx, lockId)
+					if err != nil {
+						if response.WasNotFound(resp.HttpResponse) {
+							return nil
+						}
+						return fmt.Errorf("retrieving %s: %+v", lockId, err)
+					}
+
+					if model := resp.Model; model != nil {
+						// the ID isn't guaranteed to be returned in the same casing, so use the ID which was requested
+						model.Id = pointer.To(lockId.ID())
+						mu.Lock()
+						locks = append(locks, *model)
+						mu.Unlock()
+					}
+					return nil
+				})
+			}
+			if err := runManagementLockSetOperations(operations); err != nil {
+				return err
+			}
+
+			if len(locks) == 0 {
+				return metadata.MarkAsGone(id)
+			}
+
+			output := ManagementLockSetModel{
+				Name:        id.LockName,
+				ParentScope: id.Scope,
+				Scopes:      make([]string, 0),
+				LockLevel:   state.LockLevel,
+				Notes:       state.Notes,
+			}
+			for i, lock := range locks {
+				lockId, err := managementlocks.ParseScopedLockIDInsensitively(pointer.From(lock.Id))
+				if err != nil {
+					return fmt.Errorf("parsing %q: %+v", pointer.From(lock.Id), err)
+				}
+				output.Scopes = append(output.Scopes, lockId.Scope)
+
+				// should any of the Locks differ from the configuration, surface this so that all of the Locks are updated
+				if i == 0 || string(lock.Properties.Level) != state.LockLevel {
+					output.LockLevel = string(lock.Properties.Level)
+				}
+				if i == 0 || pointer.From(lock.Properties.Notes) != state.Notes {
+					output.Notes = pointer.From(lock.Properties.Notes)
+				}
+			}
+
+			return metadata.Encode(&output)
+		},
+	}
+}
+
+func (r ManagementLockSetResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.LocksClient
+
+			var config ManagementLockSetModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id, err := managementlocks.ParseScopedLockID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			oldScopesRaw, _ := metadata.ResourceData.GetChange("scopes")
+			oldScopes := make([]string, 0)
+			existing := make(map[string]struct{})
+			for _, v := range oldScopesRaw.(*pluginsdk.Set).List() {
+				oldScopes = append(oldScopes, v.(string))
+				existing[strings.ToLower(v.(string))] = struct{}{}
+			}
+
+			// Management Locks for the added scopes mustn't already exist, otherwise these would be taken over
+			addedScopes := make([]string, 0)
+			for _, scope := range config.Scopes {
+				if _, ok := existing[strings.ToLower(scope)]; !ok {
+					addedScopes = append(addedScopes, scope)
+				}
+			}
+			if err := checkManagementLockSetLocksDoNotExist(ctx, client, *id, addedScopes); err != nil {
+				return err
+			}
+
+			updateExisting := metadata.ResourceData.HasChanges("lock_level", "notes")
+			return reconcileManagementLockSet(ctx, client, config, oldScopes, config.Scopes, updateExisting)
+		},
+	}
+}
+
+func (r ManagementLockSetResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.LocksClient
+
+			var state ManagementLockSetModel
+			if err := metadata.Decode(&state); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return reconcileManagementLockSet(ctx, client, state, state.Scopes, []string{}, false)
+		},
+	}
+}
+
+// checkManagementLockSetLocksDoNotExist returns an error listing any of the Management Locks for the specified scopes
+// which already exist, since these would otherwise be taken over (and later deleted) by the Management Lock Set
+func checkManagementLockSetLocksDoNotExist(ctx context.Context, client *managementlocks.ManagementLocksClient, id managementlocks.ScopedLockId, scopes []string) error {
+	existing := make([]string, 0)
+	var mu sync.Mutex
+	operations := make([]func() error, 0)
+	for _, scope := range scopes {
+		lockId := managementlocks.NewScopedLockID(scope, id.LockName)
+		operations = append(operations, func() error {
+			resp, err := client.GetByScope(ctx, lockId)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return nil
+				}
+				return fmt.Errorf("checking for presence of existing %s: %+v", lockId, err)
+			}
+
+			mu.Lock()
+			existing = append(existing, lockId.ID())
+			mu.Unlock()
+			return nil
+		})
+	}
+	if err := runManagementLockSetOperations(operations); err != nil {
+		return err
+	}
+
+	if len(existing) > 0 {
+		sort.Strings(existing)
+		return fmt.Errorf("the following Management Locks already exist and must be removed before they can be managed by %s:\n\n* %s", id, strings.Join(existing, "\n* "))
+	}
+
+	return nil
+}
+
+// reconcileManagementLockSet creates the Management Locks for the scopes which have been added, deletes the Management
+// Locks for the scopes which have been removed and (optionally) updates the Management Locks for the remaining scopes
+func reconcileManagementLockSet(ctx context.Context, client *managementlocks.ManagementLocksClient, config ManagementLockSetModel, oldScopes []string, newScopes []string, updateExisting bool) error {
+	existing := make(map[string]struct{})
+	for _, scope := range oldScopes {
+		existing[strings.ToLower(scope)] = struct{}{}
+	}
+
+	payload := managementlocks.ManagementLockObject{
+		Properties: managementlocks.ManagementLockProperties{
+			Level: managementlocks.LockLevel(config.LockLevel),
+			Notes: pointer.To(config.Notes),
+		},
+	}
+
+	operations := make([]func() error, 0)
+	for _, scope := range newScopes {
+		lockId := managementlocks.NewScopedLockID(scope, config.Name)
+		if _, ok := existing[strings.ToLower(scope)]; ok {
+			delete(existing, strings.ToLower(scope))
+			if !updateExisting {
+				continue
+			}
+		}
+
+		operations = append(operations, func() error {
+			return createManagementLockSetLock(ctx, client, lockId, payload)
+		})
+	}
+
+	// anything left over is no longer defined in the configuration
+	for _, scope := range oldScopes {
+		if _, ok := existing[strings.ToLower(scope)]; !ok {
+			continue
+		}
+
+		lockId := managementlocks.NewScopedLockID(scope, config.Name)
+		operations = append(operations, func() error {
+			return deleteManagementLockSetLock(ctx, client, lockId)
+		})
+	}
+
+	return runManagementLockSetOperations(operations)
+}
+
+func createManagementLockSetLock(ctx context.Context, client *managementlocks.ManagementLocksClient, id managementlocks.ScopedLockId, payload managementlocks.ManagementLockObject) error {
+	if _, err := client.CreateOrUpdateByScope(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating/updating %s: %+v", id, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context was missing a deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Target: []string{
+			"OK",
+		},
+		Refresh:                   managementLockStateRefreshFunc(ctx, client, id),
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 12,
+		Timeout:                   time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to finish create replication", id)
+	}
+
+	return nil
+}
+
+func deleteManagementLockSetLock(ctx context.Context, client *managementlocks.ManagementLocksClient, id managementlocks.ScopedLockId) error {
+	if resp, err := client.DeleteByScope(ctx, id); err != nil {
+		// the scope may have been removed already, in which case so has the Lock
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context was missing a deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Target: []string{
+			"NotFound",
+		},
+		Refresh:                   managementLockStateRefreshFunc(ctx, client, id),
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 12,
+		Timeout:                   time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to finish delete replication", id)
+	}
+
+	return nil
+}
+
+// runManagementLockSetOperations runs the specified operations, with up to managementLockSetParallelism running
+// concurrently, returning all of the errors which occurred
+func runManagementLockSetOperations(operations []func() error) error {
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	errs := make([]error, 0)
+	semaphore := make(chan struct{}, managementLockSetParallelism)
+
+	for _, operation := range operations {
+		wg.Add(1)
+		semaphore <- struct{}{}
+		go func(op func() error) {
+			defer func() {
+				<-semaphore
+				wg.Done()
+			}()
+
+			if err := op(); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(operation)
+	}
+
+	wg.Wait()
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,184 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type ManagementLockSetResource struct{}
+
+func TestAccManagementLockSet_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_lock_set", "test")
+	r := ManagementLockSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scopes.#").HasValue("2"),
+			),
+		},
+	})
+}
+
+func TestAccManagementLockSet_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_lock_set", "test")
+	r := ManagementLockSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scopes.#").HasValue("3"),
+				check.That(data.ResourceName).Key("lock_level").HasValue("ReadOnly"),
+			),
+		},
+		{
+			Config: r.removedScope(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("scopes.#").HasValue("1"),
+			),
+		},
+	})
+}
+
+func TestAccManagementLockSet_addedScopeWithExistingLock(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_management_lock_set", "test")
+	r := ManagementLockSetResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.existingLock(data, r.basic(data)),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config:      r.existingLock(data, r.complete(data)),
+			ExpectError: regexp.MustCompile("the following Management Locks already exist"),
+		},
+	})
+}
+
+func (ManagementLockSetResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := managementlocks.ParseScopedLockID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	exists := false
+	for k, v := range state.Attributes {
+		if k == "scopes.#" || !strings.HasPrefix(k, "scopes.") {
+			continue
+		}
+
+		lockId := managementlocks.NewScopedLockID(v, id.LockName)
+		resp, err := client.Resource.LocksClient.GetByScope(ctx, lockId)
+		if err != nil {
+			if response.WasNotFound(resp.HttpResponse) {
+				return pointer.To(false), nil
+			}
+			return nil, fmt.Errorf("retrieving %s: %+v", lockId, err)
+		}
+		exists = true
+	}
+
+	return pointer.To(exists), nil
+}
+
+func (r ManagementLockSetResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_lock_set" "test" {
+  name         = "acctestlock-%d"
+  parent_scope = data.azurerm_subscription.current.id
+  lock_level   = "CanNotDelete"
+  scopes = [
+    azurerm_resource_group.test[0].id,
+    azurerm_resource_group.test[1].id,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ManagementLockSetResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_lock_set" "test" {
+  name         = "acctestlock-%d"
+  parent_scope = data.azurerm_subscription.current.id
+  lock_level   = "ReadOnly"
+  notes        = "Locked by Terraform"
+  scopes       = azurerm_resource_group.test[*].id
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ManagementLockSetResource) removedScope(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_lock_set" "test" {
+  name         = "acctestlock-%d"
+  parent_scope = data.azurerm_subscription.current.id
+  lock_level   = "ReadOnly"
+  notes        = "Locked by Terraform"
+  scopes = [
+    azurerm_resource_group.test[2].id,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r ManagementLockSetResource) existingLock(data acceptance.TestData, config string) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_management_lock" "existing" {
+  name       = "acctestlock-%d"
+  scope      = azurerm_resource_group.test[2].id
+  lock_level = "CanNotDelete"
+}
+`, config, data.RandomInteger)
+}
+
+func (ManagementLockSetResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_subscription" "current" {}
+
+resource "azurerm_resource_group" "test" {
+  count    = 3
+  name     = "acctestRG-lockset-%d-${count.index}"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
@@ -0,0 +1,269 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	resourceClient "github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/client"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type MarketplaceSaasSubscriptionResource struct{}
+
+var _ sdk.ResourceWithUpdate = MarketplaceSaasSubscriptionResource{}
+
+type MarketplaceSaasSubscriptionModel struct {
+	Name              string            `tfschema:"name"`
+	ResourceGroupName string            `tfschema:"resource_group_name"`
+	PublisherId       string            `tfschema:"publisher_id"`
+	OfferId           string            `tfschema:"offer_id"`
+	PlanId            string            `tfschema:"plan_id"`
+	TermId            string            `tfschema:"term_id"`
+	Quantity          int64             `tfschema:"quantity"`
+	AutoRenewEnabled  bool              `tfschema:"auto_renew_enabled"`
+	Status            string            `tfschema:"status"`
+	Tags              map[string]string `tfschema:"tags"`
+}
+
+func (r MarketplaceSaasSubscriptionResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringLenBetween(1, 50),
+		},
+
+		"resource_group_name": commonschema.ResourceGroupName(),
+
+		"publisher_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"offer_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"plan_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"term_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"quantity": {
+			Type:         pluginsdk.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+
+		"auto_renew_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r MarketplaceSaasSubscriptionResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"status": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r MarketplaceSaasSubscriptionResource) ModelObject() interface{} {
+	return &MarketplaceSaasSubscriptionModel{}
+}
+
+func (r MarketplaceSaasSubscriptionResource) ResourceType() string {
+	return "azurerm_marketplace_saas_subscription"
+}
+
+func (r MarketplaceSaasSubscriptionResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.MarketplaceSaasSubscriptionID
+}
+
+func (r MarketplaceSaasSubscriptionResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.MarketplaceSaasClient
+
+			var config MarketplaceSaasSubscriptionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			id := parse.NewMarketplaceSaasSubscriptionID(metadata.Client.Account.SubscriptionId, config.ResourceGroupName, config.Name)
+
+			existing, err := client.Get(ctx, id.ID())
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			payload := expandMarketplaceSaasSubscription(config, metadata.Client.Account.SubscriptionId)
+			if err := client.CreateOrUpdateThenPoll(ctx, id.ID(), payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r MarketplaceSaasSubscriptionResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.MarketplaceSaasClient
+
+			id, err := parse.MarketplaceSaasSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, id.ID())
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := MarketplaceSaasSubscriptionModel{
+				Name:              id.ResourceName,
+				ResourceGroupName: id.ResourceGroup,
+			}
+
+			if model := resp.Model; model != nil {
+				state.Tags = pointer.From(model.Tags)
+
+				if props := model.Properties; props != nil {
+					// a SaaS subscription which has been cancelled remains retrievable, but can't be reactivated
+					status := pointer.From(props.Status)
+					if status == "Unsubscribed" {
+						return metadata.MarkAsGone(id)
+					}
+					state.Status = status
+
+					state.PublisherId = pointer.From(props.PublisherId)
+					state.OfferId = pointer.From(props.OfferId)
+					state.PlanId = pointer.From(props.SkuId)
+					state.TermId = pointer.From(props.TermId)
+					state.AutoRenewEnabled = pointer.From(props.AutoRenew)
+					state.Quantity = pointer.From(props.Quantity)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r MarketplaceSaasSubscriptionResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.MarketplaceSaasClient
+
+			id, err := parse.MarketplaceSaasSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config MarketplaceSaasSubscriptionModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			// changing the plan, quantity or renewal setting is done by re-submitting the SaaS subscription
+			payload := expandMarketplaceSaasSubscription(config, id.SubscriptionId)
+			if err := client.CreateOrUpdateThenPoll(ctx, id.ID(), payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r MarketplaceSaasSubscriptionResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Resource.MarketplaceSaasClient
+
+			id, err := parse.MarketplaceSaasSubscriptionID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			// deleting the SaaS resource cancels (unsubscribes) the Marketplace subscription
+			if err := client.DeleteThenPoll(ctx, id.ID()); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandMarketplaceSaasSubscription(input MarketplaceSaasSubscriptionModel, subscriptionId string) resourceClient.MarketplaceSaasResource {
+	properties := resourceClient.MarketplaceSaasResourceProperties{
+		SaasResourceName:   pointer.To(input.Name),
+		PublisherId:        pointer.To(input.PublisherId),
+		OfferId:            pointer.To(input.OfferId),
+		SkuId:              pointer.To(input.PlanId),
+		AutoRenew:          pointer.To(input.AutoRenewEnabled),
+		PaymentChannelType: pointer.To("SubscriptionDelegated"),
+		PaymentChannelMetadata: pointer.To(map[string]string{
+			"AzureSubscriptionId": subscriptionId,
+		}),
+	}
+	if input.TermId != "" {
+		properties.TermId = pointer.To(input.TermId)
+	}
+	if input.Quantity > 0 {
+		properties.Quantity = pointer.To(input.Quantity)
+	}
+
+	return resourceClient.MarketplaceSaasResource{
+		Location:   pointer.To("global"),
+		Properties: &properties,
+		Tags:       pointer.To(input.Tags),
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package resource_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type MarketplaceSaasSubscriptionResource struct{}
+
+func TestAccMarketplaceSaasSubscription_basic(t *testing.T) {
+	if os.Getenv("ARM_TEST_SAAS_PUBLISHER_ID") == "" || os.Getenv("ARM_TEST_SAAS_OFFER_ID") == "" || os.Getenv("ARM_TEST_SAAS_PLAN_ID") == "" {
+		t.Skipf("Skipping as one of ARM_TEST_SAAS_PUBLISHER_ID, ARM_TEST_SAAS_OFFER_ID or ARM_TEST_SAAS_PLAN_ID is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_saas_subscription", "test")
+	r := MarketplaceSaasSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccMarketplaceSaasSubscription_requiresImport(t *testing.T) {
+	if os.Getenv("ARM_TEST_SAAS_PUBLISHER_ID") == "" || os.Getenv("ARM_TEST_SAAS_OFFER_ID") == "" || os.Getenv("ARM_TEST_SAAS_PLAN_ID") == "" {
+		t.Skipf("Skipping as one of ARM_TEST_SAAS_PUBLISHER_ID, ARM_TEST_SAAS_OFFER_ID or ARM_TEST_SAAS_PLAN_ID is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_saas_subscription", "test")
+	r := MarketplaceSaasSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccMarketplaceSaasSubscription_update(t *testing.T) {
+	if os.Getenv("ARM_TEST_SAAS_PUBLISHER_ID") == "" || os.Getenv("ARM_TEST_SAAS_OFFER_ID") == "" || os.Getenv("ARM_TEST_SAAS_PLAN_ID") == "" {
+		t.Skipf("Skipping as one of ARM_TEST_SAAS_PUBLISHER_ID, ARM_TEST_SAAS_OFFER_ID or ARM_TEST_SAAS_PLAN_ID is not set")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_marketplace_saas_subscription", "test")
+	r := MarketplaceSaasSubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("auto_renew_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r MarketplaceSaasSubscriptionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.MarketplaceSaasSubscriptionID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Resource.MarketplaceSaasClient.Get(ctx, id.ID())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r MarketplaceSaasSubscriptionResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-saas-%d"
+  location = "%s"
+}
+`, data.RandomInteger, data.Locations.Primary)
+}
+
+func (r MarketplaceSaasSubscriptionResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_saas_subscription" "test" {
+  name                = "acctest-saas-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_id        = "%s"
+  offer_id            = "%s"
+  plan_id             = "%s"
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_SAAS_PUBLISHER_ID"), os.Getenv("ARM_TEST_SAAS_OFFER_ID"), os.Getenv("ARM_TEST_SAAS_PLAN_ID"))
+}
+
+func (r MarketplaceSaasSubscriptionResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_saas_subscription" "import" {
+  name                = azurerm_marketplace_saas_subscription.test.name
+  resource_group_name = azurerm_marketplace_saas_subscription.test.resource_group_name
+  publisher_id        = azurerm_marketplace_saas_subscription.test.publisher_id
+  offer_id            = azurerm_marketplace_saas_subscription.test.offer_id
+  plan_id             = azurerm_marketplace_saas_subscription.test.plan_id
+}
+`, r.basic(data))
+}
+
+func (r MarketplaceSaasSubscriptionResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_marketplace_saas_subscription" "test" {
+  name                = "acctest-saas-%d"
+  resource_group_name = azurerm_resource_group.test.name
+  publisher_id        = "%s"
+  offer_id            = "%s"
+  plan_id             = "%s"
+  auto_renew_enabled  = false
+
+  tags = {
+    ENV = "Test"
+  }
+}
+`, r.template(data), data.RandomInteger, os.Getenv("ARM_TEST_SAAS_PUBLISHER_ID"), os.Getenv("ARM_TEST_SAAS_OFFER_ID"), os.Getenv("ARM_TEST_SAAS_PLAN_ID"))
+}
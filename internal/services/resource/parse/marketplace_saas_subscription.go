@@ -0,0 +1,73 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type MarketplaceSaasSubscriptionId struct {
+	SubscriptionId string
+	ResourceGroup  string
+	ResourceName   string
+}
+
+func NewMarketplaceSaasSubscriptionID(subscriptionId, resourceGroup, resourceName string) MarketplaceSaasSubscriptionId {
+	return MarketplaceSaasSubscriptionId{
+		SubscriptionId: subscriptionId,
+		ResourceGroup:  resourceGroup,
+		ResourceName:   resourceName,
+	}
+}
+
+func (id MarketplaceSaasSubscriptionId) String() string {
+	segments := []string{
+		fmt.Sprintf("Resource Name %q", id.ResourceName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Marketplace Saas Subscription", segmentsStr)
+}
+
+func (id MarketplaceSaasSubscriptionId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.SaaS/resources/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.ResourceName)
+}
+
+// MarketplaceSaasSubscriptionID parses a MarketplaceSaasSubscription ID into an MarketplaceSaasSubscriptionId struct
+func MarketplaceSaasSubscriptionID(input string) (*MarketplaceSaasSubscriptionId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an MarketplaceSaasSubscription ID: %+v", input, err)
+	}
+
+	resourceId := MarketplaceSaasSubscriptionId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.ResourceName, err = id.PopSegment("resources"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
@@ -4,6 +4,7 @@
 package resource
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"log"
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/resources/2020-05-01/managementlocks"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/resource/parse"
@@ -26,6 +28,10 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/utils"
 )
 
+// resourceGroupDeletionLockName is the name of the CanNotDelete Management Lock which is managed by the
+// Resource Group when `deletion_lock_enabled` is set
+const resourceGroupDeletionLockName = "terraform-deletion-lock"
+
 func resourceResourceGroup() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceResourceGroupCreateUpdate,
@@ -56,6 +62,18 @@ func resourceResourceGroup() *pluginsdk.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringIsNotEmpty,
 			},
+
+			// NOTE: intentionally not defaulted, when unset the value of the provider feature is used
+			"prevent_deletion_if_contains_resources": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+			},
+
+			"deletion_lock_enabled": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
 		},
 	}
 }
@@ -149,6 +167,19 @@ func resourceResourceGroupCreateUpdate(d *pluginsdk.ResourceData, meta interface
 	// but needs to be fixed (resourcegroups -> resourceGroups)
 	d.SetId(*resp.ID)
 
+	if d.HasChange("deletion_lock_enabled") {
+		lockId := resourceGroupDeletionLockId(parse.NewResourceGroupID(meta.(*clients.Client).Account.SubscriptionId, name))
+		if d.Get("deletion_lock_enabled").(bool) {
+			if err := createResourceGroupDeletionLock(ctx, meta.(*clients.Client).Resource.LocksClient, lockId); err != nil {
+				return err
+			}
+		} else {
+			if err := deleteResourceGroupDeletionLock(ctx, meta.(*clients.Client).Resource.LocksClient, lockId); err != nil {
+				return err
+			}
+		}
+	}
+
 	return resourceResourceGroupRead(d, meta)
 }
 
@@ -176,6 +207,21 @@ func resourceResourceGroupRead(d *pluginsdk.ResourceData, meta interface{}) erro
 	d.Set("name", resp.Name)
 	d.Set("location", location.NormalizeNilable(resp.Location))
 	d.Set("managed_by", pointer.From(resp.ManagedBy))
+
+	// looking up the lock requires an additional API call and permission, so is only done when the lock is managed
+	if resourceGroupDeletionLockLookupRequired(d) {
+		lockId := resourceGroupDeletionLockId(*id)
+		lockExists, err := resourceGroupDeletionLockExists(ctx, meta.(*clients.Client).Resource.LocksClient, lockId)
+		if err != nil {
+			return err
+		}
+		if lockExists != nil {
+			d.Set("deletion_lock_enabled", *lockExists)
+		} else {
+			log.Printf("[WARN] unable to determine whether %s exists due to insufficient permissions - leaving `deletion_lock_enabled` unchanged", lockId)
+		}
+	}
+
 	return tags.FlattenAndSet(d, resp.Tags)
 }
 
@@ -189,8 +235,28 @@ func resourceResourceGroupDelete(d *pluginsdk.ResourceData, meta interface{}) er
 		return err
 	}
 
+	// the lock is intentionally not removed here, since it's there to prevent the Resource Group from being deleted
+	if d.Get("deletion_lock_enabled").(bool) {
+		lockId := resourceGroupDeletionLockId(*id)
+		lockExists, err := resourceGroupDeletionLockExists(ctx, meta.(*clients.Client).Resource.LocksClient, lockId)
+		if err != nil {
+			return err
+		}
+		if pointer.From(lockExists) {
+			return fmt.Errorf("deleting %s: the Resource Group is protected by the Management Lock %q - `deletion_lock_enabled` must be set to `false` and applied before the Resource Group can be deleted", *id, lockId.LockName)
+		}
+	}
+
+	// the value specified on the Resource Group takes precedence over the provider feature
+	preventDeletionIfContainsResources := meta.(*clients.Client).Features.ResourceGroup.PreventDeletionIfContainsResources
+	if rawState := d.GetRawState(); !rawState.IsNull() {
+		if v := rawState.GetAttr("prevent_deletion_if_contains_resources"); !v.IsNull() && v.IsKnown() {
+			preventDeletionIfContainsResources = v.True()
+		}
+	}
+
 	// conditionally check for nested resources and error if they exist
-	if meta.(*clients.Client).Features.ResourceGroup.PreventDeletionIfContainsResources {
+	if preventDeletionIfContainsResources {
 		resourceClient := meta.(*clients.Client).Resource.ResourcesClient
 		// Resource groups sometimes hold on to resource information after the resources have been deleted. We'll retry this check to account for that eventual consistency.
 		err = pluginsdk.Retry(10*time.Minute, func() *pluginsdk.RetryError {
@@ -269,7 +335,10 @@ provider "azurerm" {
   }
 }
 
-When that feature flag is set, Terraform will skip checking for any Resources within the Resource Group and
+Alternatively this behaviour can be disabled for this Resource Group only by setting the field
+'prevent_deletion_if_contains_resources' to 'false' on the 'azurerm_resource_group' resource.
+
+When this behaviour is disabled, Terraform will skip checking for any Resources within the Resource Group and
 delete this using the Azure API directly (which will clear up any nested resources).
 
 More information on the 'features' block can be found in the documentation:
@@ -277,3 +346,109 @@ https://registry.terraform.io/providers/hashicorp/azurerm/latest/docs/guides/fea
 `, name, strings.Join(formattedResourceUris, "\n"))
 	return errors.New(strings.ReplaceAll(message, "'", "`"))
 }
+
+func resourceGroupDeletionLockId(id parse.ResourceGroupId) managementlocks.ScopedLockId {
+	return managementlocks.NewScopedLockID(id.ID(), resourceGroupDeletionLockName)
+}
+
+// resourceGroupDeletionLockLookupRequired returns whether the deletion lock is enabled in either the configuration or
+// the state, or whether the state doesn't yet contain a value (e.g. when importing)
+func resourceGroupDeletionLockLookupRequired(d *pluginsdk.ResourceData) bool {
+	if d.Get("deletion_lock_enabled").(bool) {
+		return true
+	}
+
+	if rawConfig := d.GetRawConfig(); !rawConfig.IsNull() {
+		if v := rawConfig.GetAttr("deletion_lock_enabled"); v.IsKnown() && !v.IsNull() && v.True() {
+			return true
+		}
+	}
+
+	if d.IsNewResource() {
+		return false
+	}
+
+	rawState := d.GetRawState()
+	return rawState.IsNull() || rawState.GetAttr("deletion_lock_enabled").IsNull()
+}
+
+// resourceGroupDeletionLockExists returns whether the deletion lock exists, or `nil` when this can't be determined
+// because the caller doesn't have permission to read Management Locks
+func resourceGroupDeletionLockExists(ctx context.Context, client *managementlocks.ManagementLocksClient, id managementlocks.ScopedLockId) (*bool, error) {
+	resp, err := client.GetByScope(ctx, id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		if response.WasForbidden(resp.HttpResponse) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(resp.Model != nil && resp.Model.Properties.Level == managementlocks.LockLevelCanNotDelete), nil
+}
+
+func createResourceGroupDeletionLock(ctx context.Context, client *managementlocks.ManagementLocksClient, id managementlocks.ScopedLockId) error {
+	payload := managementlocks.ManagementLockObject{
+		Properties: managementlocks.ManagementLockProperties{
+			Level: managementlocks.LockLevelCanNotDelete,
+			Notes: pointer.To("Managed by Terraform to prevent the deletion of this Resource Group"),
+		},
+	}
+
+	if _, err := client.CreateOrUpdateByScope(ctx, id, payload); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context was missing a deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Target: []string{
+			"OK",
+		},
+		Refresh:                   managementLockStateRefreshFunc(ctx, client, id),
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 12,
+		Timeout:                   time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to finish create replication", id)
+	}
+
+	return nil
+}
+
+func deleteResourceGroupDeletionLock(ctx context.Context, client *managementlocks.ManagementLocksClient, id managementlocks.ScopedLockId) error {
+	if resp, err := client.DeleteByScope(ctx, id); err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return nil
+		}
+
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context was missing a deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Target: []string{
+			"NotFound",
+		},
+		Refresh:                   managementLockStateRefreshFunc(ctx, client, id),
+		MinTimeout:                10 * time.Second,
+		ContinuousTargetOccurence: 12,
+		Timeout:                   time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to finish delete replication", id)
+	}
+
+	return nil
+}
@@ -123,6 +123,68 @@ func TestAccResourceGroup_withNestedItemsAndFeatureFlag(t *testing.T) {
 	})
 }
 
+func TestAccResourceGroup_withNestedItemsAndResourceOverride(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+	r := ResourceGroupResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withPreventDeletionOverride(data, false, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.createNetworkOutsideTerraform(fmt.Sprintf("acctestvnet-%d", data.RandomInteger))),
+			),
+		},
+		data.ImportStep("prevent_deletion_if_contains_resources"),
+		{
+			// the value on the resource takes precedence over the feature flag, so this should error
+			Config:      r.withPreventDeletionOverride(data, false, true),
+			Destroy:     true,
+			ExpectError: regexp.MustCompile("This feature is intended to avoid the unintentional destruction"),
+		},
+		{
+			Config: r.withPreventDeletionOverride(data, true, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			// with the override disabled we should delete the RG and the Network, even though the feature is enabled
+			Config:  r.withPreventDeletionOverride(data, true, false),
+			Destroy: true,
+		},
+	})
+}
+
+func TestAccResourceGroup_deletionLock(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_resource_group", "test")
+	r := ResourceGroupResource{}
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicConfig(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.deletionLock(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("deletion_lock_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.deletionLock(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("deletion_lock_enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func (t ResourceGroupResource) Destroy(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	// NOTE: Due to the Resource Group resource still using the old Azure SDK and sourcing the Resource Group ID
 	// from the Azure API, we need to support both `resourceGroups` and the legacy `resourcegroups` value here
@@ -239,6 +301,40 @@ resource "azurerm_resource_group" "test" {
 `, featureFlagEnabled, data.RandomInteger, data.Locations.Primary)
 }
 
+func (t ResourceGroupResource) withPreventDeletionOverride(data acceptance.TestData, featureFlagEnabled, preventDeletion bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {
+    resource_group {
+      prevent_deletion_if_contains_resources = %t
+    }
+  }
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  prevent_deletion_if_contains_resources = %t
+}
+`, featureFlagEnabled, data.RandomInteger, data.Locations.Primary, preventDeletion)
+}
+
+func (t ResourceGroupResource) deletionLock(data acceptance.TestData, enabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+
+  deletion_lock_enabled = %t
+}
+`, data.RandomInteger, data.Locations.Primary, enabled)
+}
+
 func (t ResourceGroupResource) withTagsConfig(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
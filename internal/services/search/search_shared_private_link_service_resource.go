@@ -6,13 +6,16 @@ package search
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/network/2024-05-01/privateendpoints"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/services"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/search/2024-06-01-preview/sharedprivatelinkresources"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/azure"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/network/privateendpointconnections"
 	networkValidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/network/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
@@ -22,17 +25,19 @@ import (
 type SharedPrivateLinkServiceResource struct{}
 
 var (
-	_ sdk.Resource           = SharedPrivateLinkServiceResource{}
-	_ sdk.ResourceWithUpdate = SharedPrivateLinkServiceResource{}
+	_ sdk.Resource                  = SharedPrivateLinkServiceResource{}
+	_ sdk.ResourceWithUpdate        = SharedPrivateLinkServiceResource{}
+	_ sdk.ResourceWithCustomizeDiff = SharedPrivateLinkServiceResource{}
 )
 
 type SharedPrivateLinkServiceModel struct {
-	Name             string `tfschema:"name"`
-	SearchServiceId  string `tfschema:"search_service_id"`
-	SubResourceName  string `tfschema:"subresource_name"`
-	TargetResourceId string `tfschema:"target_resource_id"`
-	RequestMessage   string `tfschema:"request_message"`
-	Status           string `tfschema:"status"`
+	Name               string `tfschema:"name"`
+	SearchServiceId    string `tfschema:"search_service_id"`
+	SubResourceName    string `tfschema:"subresource_name"`
+	TargetResourceId   string `tfschema:"target_resource_id"`
+	RequestMessage     string `tfschema:"request_message"`
+	AutoApproveEnabled bool   `tfschema:"auto_approve_enabled"`
+	Status             string `tfschema:"status"`
 }
 
 func (r SharedPrivateLinkServiceResource) Arguments() map[string]*pluginsdk.Schema {
@@ -70,6 +75,12 @@ func (r SharedPrivateLinkServiceResource) Arguments() map[string]*pluginsdk.Sche
 			Optional:     true,
 			ValidateFunc: validation.StringIsNotEmpty,
 		},
+
+		"auto_approve_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  false,
+		},
 	}
 }
 
@@ -120,6 +131,16 @@ func (r SharedPrivateLinkServiceResource) Create() sdk.ResourceFunc {
 				return metadata.ResourceRequiresImport(r.ResourceType(), id)
 			}
 
+			// the Private Endpoint is created in a subscription managed by Azure Search, so its ID isn't known upfront - instead
+			// the Private Endpoints already connected to the target resource are recorded, so that the new one can be identified
+			var existingPrivateEndpointIds map[string]struct{}
+			if model.AutoApproveEnabled {
+				existingPrivateEndpointIds, err = listSharedPrivateLinkServiceTargetPrivateEndpointIds(ctx, metadata, model.TargetResourceId)
+				if err != nil {
+					return err
+				}
+			}
+
 			parameters := sharedprivatelinkresources.SharedPrivateLinkResource{
 				Properties: &sharedprivatelinkresources.SharedPrivateLinkResourceProperties{
 					GroupId:               utils.String(model.SubResourceName),
@@ -136,6 +157,13 @@ func (r SharedPrivateLinkServiceResource) Create() sdk.ResourceFunc {
 			}
 
 			metadata.SetID(id)
+
+			if model.AutoApproveEnabled {
+				if err := approveSharedPrivateLinkServiceConnection(ctx, metadata, id, model, existingPrivateEndpointIds); err != nil {
+					return err
+				}
+			}
+
 			return nil
 		},
 		Timeout: 60 * time.Minute,
@@ -186,6 +214,9 @@ func (r SharedPrivateLinkServiceResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			// this is a provider-side setting, so it's persisted from the configuration
+			state.AutoApproveEnabled = metadata.ResourceData.Get("auto_approve_enabled").(bool)
+
 			return metadata.Encode(state)
 		},
 		Timeout: 5 * time.Minute,
@@ -217,6 +248,7 @@ func (r SharedPrivateLinkServiceResource) Update() sdk.ResourceFunc {
 					return fmt.Errorf("updating %s: %+v", *id, err)
 				}
 			}
+
 			return nil
 		},
 		Timeout: 60 * time.Minute,
@@ -240,3 +272,147 @@ func (r SharedPrivateLinkServiceResource) Delete() sdk.ResourceFunc {
 		Timeout: 60 * time.Minute,
 	}
 }
+
+func (r SharedPrivateLinkServiceResource) CustomizeDiff() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			diff := metadata.ResourceDiff
+
+			if !diff.Get("auto_approve_enabled").(bool) {
+				return nil
+			}
+
+			// the Private Endpoint Connection can only be identified whilst the Shared Private Link is being created
+			if diff.Id() != "" && diff.HasChange("auto_approve_enabled") {
+				if err := diff.ForceNew("auto_approve_enabled"); err != nil {
+					return err
+				}
+			}
+
+			if targetResourceId := diff.Get("target_resource_id").(string); targetResourceId != "" {
+				if _, errs := privateendpointconnections.ValidateTargetResourceID(targetResourceId, "target_resource_id"); len(errs) > 0 {
+					return fmt.Errorf("`auto_approve_enabled` is not supported for this target resource: %+v", errs[0])
+				}
+			}
+
+			return nil
+		},
+		Timeout: 5 * time.Minute,
+	}
+}
+
+// listSharedPrivateLinkServiceTargetPrivateEndpointIds returns the (normalized) IDs of the Private Endpoints which are
+// connected to the target resource
+func listSharedPrivateLinkServiceTargetPrivateEndpointIds(ctx context.Context, metadata sdk.ResourceMetaData, targetResourceId string) (map[string]struct{}, error) {
+	connections, err := metadata.Client.Network.PrivateEndpointConnectionsClient.List(ctx, targetResourceId)
+	if err != nil {
+		return nil, err
+	}
+
+	out := make(map[string]struct{})
+	for _, connection := range connections {
+		privateEndpointId, err := privateendpoints.ParsePrivateEndpointIDInsensitively(connection.PrivateEndpointId)
+		if err != nil {
+			continue
+		}
+		out[privateEndpointId.ID()] = struct{}{}
+	}
+
+	return out, nil
+}
+
+// approveSharedPrivateLinkServiceConnection approves the Private Endpoint Connection on the target resource which was
+// requested by the Shared Private Link, and then waits for the Shared Private Link to report that it's been approved.
+func approveSharedPrivateLinkServiceConnection(ctx context.Context, metadata sdk.ResourceMetaData, id sharedprivatelinkresources.SharedPrivateLinkResourceId, model SharedPrivateLinkServiceModel, existingPrivateEndpointIds map[string]struct{}) error {
+	client := metadata.Client.Search.SearchSharedPrivateLinkResourceClient
+	connectionsClient := metadata.Client.Network.PrivateEndpointConnectionsClient
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	var connectionId privateendpointconnections.PrivateEndpointConnectionId
+	err := pluginsdk.Retry(time.Until(deadline), func() *pluginsdk.RetryError {
+		connections, err := connectionsClient.List(ctx, model.TargetResourceId)
+		if err != nil {
+			return pluginsdk.NonRetryableError(err)
+		}
+
+		matches := make([]privateendpointconnections.Connection, 0)
+		for _, connection := range connections {
+			if matchesSharedPrivateLinkServiceConnection(connection, model.SubResourceName, existingPrivateEndpointIds) {
+				matches = append(matches, connection)
+			}
+		}
+
+		switch len(matches) {
+		case 0:
+			return pluginsdk.RetryableError(fmt.Errorf("waiting for the Private Endpoint Connection for %s to be created on %q", id, model.TargetResourceId))
+		case 1:
+			connectionId = privateendpointconnections.NewPrivateEndpointConnectionID(model.TargetResourceId, matches[0].Name)
+			return nil
+		default:
+			return pluginsdk.NonRetryableError(fmt.Errorf("found %d new Private Endpoint Connections pending approval on %q, so the connection for %s can't be identified - Private Endpoints connecting to the same resource should be created one at a time when `auto_approve_enabled` is set", len(matches), model.TargetResourceId, id))
+		}
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := connectionsClient.UpdateStatus(ctx, connectionId, privateendpointconnections.StatusApproved, "Approved by Terraform"); err != nil {
+		return fmt.Errorf("approving the Private Endpoint Connection for %s: %+v", id, err)
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{string(sharedprivatelinkresources.SharedPrivateLinkResourceStatusPending)},
+		Target:  []string{string(sharedprivatelinkresources.SharedPrivateLinkResourceStatusApproved)},
+		Refresh: func() (interface{}, string, error) {
+			resp, err := client.Get(ctx, id, sharedprivatelinkresources.GetOperationOptions{})
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			status := ""
+			if resp.Model != nil && resp.Model.Properties != nil && resp.Model.Properties.Status != nil {
+				status = string(*resp.Model.Properties.Status)
+			}
+			return resp, status, nil
+		},
+		MinTimeout: 10 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for %s to be approved: %+v", id, err)
+	}
+
+	return nil
+}
+
+// matchesSharedPrivateLinkServiceConnection returns whether the connection is pending approval for a Private Endpoint
+// which wasn't connected to the target resource before the Shared Private Link was created
+func matchesSharedPrivateLinkServiceConnection(connection privateendpointconnections.Connection, subResourceName string, existingPrivateEndpointIds map[string]struct{}) bool {
+	if connection.Name == "" || !strings.EqualFold(connection.Status, privateendpointconnections.StatusPending) {
+		return false
+	}
+
+	privateEndpointId, err := privateendpoints.ParsePrivateEndpointIDInsensitively(connection.PrivateEndpointId)
+	if err != nil {
+		return false
+	}
+	if _, exists := existingPrivateEndpointIds[privateEndpointId.ID()]; exists {
+		return false
+	}
+
+	// not every Resource Provider returns the sub-resources the connection is for
+	if len(connection.GroupIds) > 0 {
+		for _, groupId := range connection.GroupIds {
+			if strings.EqualFold(groupId, subResourceName) {
+				return true
+			}
+		}
+		return false
+	}
+
+	return true
+}
@@ -47,6 +47,21 @@ func TestAccSearchSharedPrivateLinkServiceResource_requiresImport(t *testing.T)
 	})
 }
 
+func TestAccSearchSharedPrivateLinkServiceResource_autoApprove(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_search_shared_private_link_service", "test")
+	r := SearchSharedPrivateLinkServiceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.autoApprove(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("status").HasValue("Approved")),
+		},
+		data.ImportStep("auto_approve_enabled"),
+	})
+}
+
 func (r SearchSharedPrivateLinkServiceResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := sharedprivatelinkresources.ParseSharedPrivateLinkResourceID(state.ID)
 	if err != nil {
@@ -76,6 +91,22 @@ resource "azurerm_search_shared_private_link_service" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r SearchSharedPrivateLinkServiceResource) autoApprove(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_search_shared_private_link_service" "test" {
+  name                 = "acctest%d"
+  search_service_id    = azurerm_search_service.test.id
+  subresource_name     = "blob"
+  target_resource_id   = azurerm_storage_account.test.id
+  request_message      = "please approve"
+  auto_approve_enabled = true
+}
+`, template, data.RandomInteger)
+}
+
 func (r SearchSharedPrivateLinkServiceResource) requiresImport(data acceptance.TestData) string {
 	template := SearchSharedPrivateLinkServiceResource{}.basic(data)
 	return fmt.Sprintf(`
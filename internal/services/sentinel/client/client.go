@@ -13,6 +13,12 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/watchlistitems"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2022-11-01/watchlists"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/alertrules"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/contentpackages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/contentproductpackages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/workspacemanagerassignments"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/workspacemanagerconfigurations"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/workspacemanagergroups"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/workspacemanagermember"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/common"
 	securityinsight "github.com/jackofallops/kermit/sdk/securityinsights/2022-10-01-preview/securityinsights"
 )
@@ -28,6 +34,13 @@ type Client struct {
 	AnalyticsSettingsClient  *securityinsight.SecurityMLAnalyticsSettingsClient
 	ThreatIntelligenceClient *securityinsight.ThreatIntelligenceIndicatorClient
 	MetadataClient           *metadata.MetadataClient
+
+	ContentPackagesClient                *contentpackages.ContentPackagesClient
+	ContentProductPackagesClient         *contentproductpackages.ContentProductPackagesClient
+	WorkspaceManagerAssignmentsClient    *workspacemanagerassignments.WorkspaceManagerAssignmentsClient
+	WorkspaceManagerConfigurationsClient *workspacemanagerconfigurations.WorkspaceManagerConfigurationsClient
+	WorkspaceManagerGroupsClient         *workspacemanagergroups.WorkspaceManagerGroupsClient
+	WorkspaceManagerMembersClient        *workspacemanagermember.WorkspaceManagerMemberClient
 }
 
 func NewClient(o *common.ClientOptions) (*Client, error) {
@@ -79,6 +92,42 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	}
 	o.Configure(metadataClient.Client, o.Authorizers.ResourceManager)
 
+	contentPackagesClient, err := contentpackages.NewContentPackagesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Content Packages Client: %+v", err)
+	}
+	o.Configure(contentPackagesClient.Client, o.Authorizers.ResourceManager)
+
+	contentProductPackagesClient, err := contentproductpackages.NewContentProductPackagesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Content Product Packages Client: %+v", err)
+	}
+	o.Configure(contentProductPackagesClient.Client, o.Authorizers.ResourceManager)
+
+	workspaceManagerAssignmentsClient, err := workspacemanagerassignments.NewWorkspaceManagerAssignmentsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Workspace Manager Assignments Client: %+v", err)
+	}
+	o.Configure(workspaceManagerAssignmentsClient.Client, o.Authorizers.ResourceManager)
+
+	workspaceManagerConfigurationsClient, err := workspacemanagerconfigurations.NewWorkspaceManagerConfigurationsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Workspace Manager Configurations Client: %+v", err)
+	}
+	o.Configure(workspaceManagerConfigurationsClient.Client, o.Authorizers.ResourceManager)
+
+	workspaceManagerGroupsClient, err := workspacemanagergroups.NewWorkspaceManagerGroupsClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Workspace Manager Groups Client: %+v", err)
+	}
+	o.Configure(workspaceManagerGroupsClient.Client, o.Authorizers.ResourceManager)
+
+	workspaceManagerMembersClient, err := workspacemanagermember.NewWorkspaceManagerMemberClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building Workspace Manager Members Client: %+v", err)
+	}
+	o.Configure(workspaceManagerMembersClient.Client, o.Authorizers.ResourceManager)
+
 	return &Client{
 		AlertRulesClient:         alertRulesClient,
 		AlertRuleTemplatesClient: &alertRuleTemplatesClient,
@@ -90,5 +139,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		AnalyticsSettingsClient:  &analyticsSettingsClient,
 		ThreatIntelligenceClient: &threatIntelligenceClient,
 		MetadataClient:           metadataClient,
+
+		ContentPackagesClient:                contentPackagesClient,
+		ContentProductPackagesClient:         contentProductPackagesClient,
+		WorkspaceManagerAssignmentsClient:    workspaceManagerAssignmentsClient,
+		WorkspaceManagerConfigurationsClient: workspaceManagerConfigurationsClient,
+		WorkspaceManagerGroupsClient:         workspaceManagerGroupsClient,
+		WorkspaceManagerMembersClient:        workspaceManagerMembersClient,
 	}, nil
 }
@@ -66,6 +66,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 func (r Registration) Resources() []sdk.Resource {
 	return []sdk.Resource{
 		AlertRuleThreatIntelligenceResource{},
+		AlertRuleScheduledTemplateDeploymentResource{},
 		WatchlistResource{},
 		WatchlistItemResource{},
 		DataConnectorAwsS3Resource{},
@@ -82,5 +83,10 @@ func (r Registration) Resources() []sdk.Resource {
 		MetadataResource{},
 		AlertRuleAnomalyDuplicateResource{},
 		ThreatIntelligenceIndicator{},
+		ContentPackageResource{},
+		WorkspaceManagerAssignmentResource{},
+		WorkspaceManagerConfigurationResource{},
+		WorkspaceManagerGroupResource{},
+		WorkspaceManagerMemberResource{},
 	}
 }
@@ -0,0 +1,325 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/alertrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type AlertRuleScheduledTemplateDeploymentModel struct {
+	Name                     string `tfschema:"name"`
+	LogAnalyticsWorkspaceId  string `tfschema:"log_analytics_workspace_id"`
+	TemplateContent          string `tfschema:"template_content"`
+	Enabled                  bool   `tfschema:"enabled"`
+	AlertRuleTemplateGuid    string `tfschema:"alert_rule_template_guid"`
+	AlertRuleTemplateVersion string `tfschema:"alert_rule_template_version"`
+	DisplayName              string `tfschema:"display_name"`
+	Severity                 string `tfschema:"severity"`
+}
+
+type AlertRuleScheduledTemplateDeploymentResource struct{}
+
+var (
+	_ sdk.ResourceWithCustomImporter = AlertRuleScheduledTemplateDeploymentResource{}
+	_ sdk.ResourceWithUpdate         = AlertRuleScheduledTemplateDeploymentResource{}
+)
+
+func (r AlertRuleScheduledTemplateDeploymentResource) ModelObject() interface{} {
+	return &AlertRuleScheduledTemplateDeploymentModel{}
+}
+
+func (r AlertRuleScheduledTemplateDeploymentResource) ResourceType() string {
+	return "azurerm_sentinel_alert_rule_scheduled_template_deployment"
+}
+
+func (r AlertRuleScheduledTemplateDeploymentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return alertrules.ValidateAlertRuleID
+}
+
+func (r AlertRuleScheduledTemplateDeploymentResource) CustomImporter() sdk.ResourceRunFunc {
+	return importSentinelAlertRuleForTypedSdk(alertrules.AlertRuleKindScheduled)
+}
+
+func (r AlertRuleScheduledTemplateDeploymentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+
+		"template_content": {
+			Type:             pluginsdk.TypeString,
+			Required:         true,
+			ValidateFunc:     validateScheduledAlertRuleTemplateContent,
+			DiffSuppressFunc: suppressScheduledAlertRuleTemplateContentDiff,
+		},
+
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r AlertRuleScheduledTemplateDeploymentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"alert_rule_template_guid": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"alert_rule_template_version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+
+		"severity": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r AlertRuleScheduledTemplateDeploymentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.AlertRulesClient
+
+			var model AlertRuleScheduledTemplateDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return err
+			}
+
+			id := alertrules.NewAlertRuleID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			props, err := normalizeScheduledAlertRuleTemplate(model.TemplateContent)
+			if err != nil {
+				return fmt.Errorf("parsing `template_content`: %+v", err)
+			}
+			props.Enabled = model.Enabled
+
+			param := alertrules.ScheduledAlertRule{
+				Properties: props,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, id, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r AlertRuleScheduledTemplateDeploymentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.AlertRulesClient
+
+			id, err := alertrules.ParseAlertRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if err := assertAlertRuleKind(resp.Model, alertrules.AlertRuleKindScheduled); err != nil {
+				return fmt.Errorf("asserting alert rule of %s: %+v", id, err)
+			}
+
+			state := AlertRuleScheduledTemplateDeploymentModel{
+				Name:                    id.RuleId,
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if rule, ok := resp.Model.(alertrules.ScheduledAlertRule); ok {
+				if props := rule.Properties; props != nil {
+					state.Enabled = props.Enabled
+					state.AlertRuleTemplateGuid = pointer.From(props.AlertRuleTemplateName)
+					state.AlertRuleTemplateVersion = pointer.From(props.TemplateVersion)
+					state.DisplayName = props.DisplayName
+					state.Severity = string(pointer.From(props.Severity))
+
+					// the template content isn't returned by the API in the format it was provided, differences in
+					// formatting are suppressed by `suppressScheduledAlertRuleTemplateContentDiff`
+					templateContent, err := flattenScheduledAlertRuleTemplateContent(*props)
+					if err != nil {
+						return fmt.Errorf("flattening `template_content`: %+v", err)
+					}
+					state.TemplateContent = templateContent
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r AlertRuleScheduledTemplateDeploymentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.AlertRulesClient
+
+			id, err := alertrules.ParseAlertRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model AlertRuleScheduledTemplateDeploymentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			existing, err := client.Get(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			if err := assertAlertRuleKind(existing.Model, alertrules.AlertRuleKindScheduled); err != nil {
+				return fmt.Errorf("asserting alert rule of %s: %+v", id, err)
+			}
+
+			props, err := normalizeScheduledAlertRuleTemplate(model.TemplateContent)
+			if err != nil {
+				return fmt.Errorf("parsing `template_content`: %+v", err)
+			}
+			props.Enabled = model.Enabled
+
+			param := alertrules.ScheduledAlertRule{
+				Properties: props,
+			}
+
+			if _, err := client.CreateOrUpdate(ctx, *id, param); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r AlertRuleScheduledTemplateDeploymentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.AlertRulesClient
+
+			id, err := alertrules.ParseAlertRuleID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func validateScheduledAlertRuleTemplateContent(i interface{}, k string) (warnings []string, errors []error) {
+	v, ok := i.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %q to be string", k))
+		return
+	}
+
+	if _, err := normalizeScheduledAlertRuleTemplate(v); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid Scheduled Alert Rule template: %+v", k, err))
+	}
+
+	return
+}
+
+// suppressScheduledAlertRuleTemplateContentDiff suppresses the diff when both templates result in the same Alert Rule,
+// for example when only the formatting has changed or a YAML template has been converted into JSON
+func suppressScheduledAlertRuleTemplateContentDiff(_, old, new string, _ *pluginsdk.ResourceData) bool {
+	oldProps, err := normalizeScheduledAlertRuleTemplate(old)
+	if err != nil {
+		return false
+	}
+
+	newProps, err := normalizeScheduledAlertRuleTemplate(new)
+	if err != nil {
+		return false
+	}
+
+	return reflect.DeepEqual(oldProps, newProps)
+}
+
+func flattenScheduledAlertRuleTemplateContent(input alertrules.ScheduledAlertRuleProperties) (string, error) {
+	// `enabled` is managed via the top-level property and `lastModifiedUtc` is read-only
+	input.LastModifiedUtc = nil
+
+	raw, err := json.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	var properties map[string]interface{}
+	if err := json.Unmarshal(raw, &properties); err != nil {
+		return "", err
+	}
+	delete(properties, "enabled")
+
+	output, err := json.Marshal(properties)
+	if err != nil {
+		return "", err
+	}
+
+	return string(output), nil
+}
@@ -0,0 +1,207 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/alertrules"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type AlertRuleScheduledTemplateDeploymentResource struct{}
+
+func TestAccAlertRuleScheduledTemplateDeployment_yaml(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled_template_deployment", "test")
+	r := AlertRuleScheduledTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.yaml(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("display_name").HasValue("Rare subscription-level operations in Azure"),
+				check.That(data.ResourceName).Key("severity").HasValue("Low"),
+			),
+		},
+		data.ImportStep("template_content"),
+	})
+}
+
+func TestAccAlertRuleScheduledTemplateDeployment_armTemplate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled_template_deployment", "test")
+	r := AlertRuleScheduledTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.armTemplate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("template_content"),
+	})
+}
+
+func TestAccAlertRuleScheduledTemplateDeployment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled_template_deployment", "test")
+	r := AlertRuleScheduledTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.yaml(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("template_content"),
+		{
+			Config: r.armTemplate(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+				check.That(data.ResourceName).Key("severity").HasValue("High"),
+			),
+		},
+		data.ImportStep("template_content"),
+		{
+			Config: r.yaml(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("template_content"),
+	})
+}
+
+func TestAccAlertRuleScheduledTemplateDeployment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_alert_rule_scheduled_template_deployment", "test")
+	r := AlertRuleScheduledTemplateDeploymentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.yaml(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r AlertRuleScheduledTemplateDeploymentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client := clients.Sentinel.AlertRulesClient
+
+	id, err := alertrules.ParseAlertRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r AlertRuleScheduledTemplateDeploymentResource) yaml(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled_template_deployment" "test" {
+  name                       = "acctest-SentinelAlertRule-Template-%d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  template_content           = <<YAML
+id: 0b9ae89d-8cad-461c-808f-0494f70ad5c4
+name: Rare subscription-level operations in Azure
+description: |
+  This query looks for a few sensitive subscription-level events.
+severity: Low
+requiredDataConnectors:
+  - connectorId: AzureActivity
+    dataTypes:
+      - AzureActivity
+queryFrequency: 1d
+queryPeriod: 14d
+triggerOperator: gt
+triggerThreshold: 0
+tactics:
+  - CredentialAccess
+  - Persistence
+relevantTechniques:
+  - T1003
+  - T1098
+query: |
+  AzureActivity
+  | where OperationNameValue has_any ("Microsoft.Authorization/roleAssignments/write")
+  | extend AccountName = Caller
+entityMappings:
+  - entityType: Account
+    fieldMappings:
+      - identifier: Name
+        columnName: AccountName
+version: 2.0.2
+kind: Scheduled
+YAML
+}
+`, SentinelAlertRuleScheduledResource{}.template(data), data.RandomInteger)
+}
+
+func (r AlertRuleScheduledTemplateDeploymentResource) armTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled_template_deployment" "test" {
+  name                       = "acctest-SentinelAlertRule-Template-%d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  enabled                    = false
+  template_content = jsonencode({
+    "$schema"      = "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#"
+    contentVersion = "1.0.0.0"
+    resources = [
+      {
+        type = "Microsoft.OperationalInsights/workspaces/providers/alertRules"
+        kind = "Scheduled"
+        properties = {
+          displayName         = "Updated Rule"
+          description         = "Updated from an exported ARM Template"
+          severity            = "High"
+          query               = "AzureActivity | take 10"
+          queryFrequency      = "PT1H"
+          queryPeriod         = "PT1H"
+          triggerOperator     = "GreaterThan"
+          triggerThreshold    = 5
+          suppressionDuration = "PT1H"
+          suppressionEnabled  = false
+          tactics             = ["Collection"]
+        }
+      }
+    ]
+  })
+}
+`, SentinelAlertRuleScheduledResource{}.template(data), data.RandomInteger)
+}
+
+func (r AlertRuleScheduledTemplateDeploymentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_scheduled_template_deployment" "import" {
+  name                       = azurerm_sentinel_alert_rule_scheduled_template_deployment.test.name
+  log_analytics_workspace_id = azurerm_sentinel_alert_rule_scheduled_template_deployment.test.log_analytics_workspace_id
+  template_content           = azurerm_sentinel_alert_rule_scheduled_template_deployment.test.template_content
+}
+`, r.yaml(data))
+}
@@ -0,0 +1,212 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/alertrules"
+	"gopkg.in/yaml.v3"
+)
+
+// scheduledAlertRuleTemplateDurationRegex matches the short-hand durations (e.g. `5h`, `14d`) used within the
+// Analytic Rule templates published in the Azure Sentinel repository
+var scheduledAlertRuleTemplateDurationRegex = regexp.MustCompile(`^([0-9]+)([mhdMHD])$`)
+
+// normalizeScheduledAlertRuleTemplate parses a Scheduled Analytic Rule template, which can either be the YAML/JSON
+// template format used within the Azure Sentinel repository, an ARM Template exported from the Azure Portal or the
+// raw properties of the Alert Rule, into the Scheduled Alert Rule properties accepted by the API.
+func normalizeScheduledAlertRuleTemplate(input string) (*alertrules.ScheduledAlertRuleProperties, error) {
+	// YAML is a superset of JSON, so both formats can be parsed in the same way
+	var raw map[string]interface{}
+	if err := yaml.Unmarshal([]byte(input), &raw); err != nil {
+		return nil, fmt.Errorf("parsing template: %+v", err)
+	}
+	if raw == nil {
+		return nil, fmt.Errorf("the template was empty")
+	}
+
+	if v, ok := raw["resources"]; ok {
+		resources, ok := v.([]interface{})
+		if !ok || len(resources) != 1 {
+			return nil, fmt.Errorf("an ARM Template must contain exactly one Alert Rule within `resources`")
+		}
+		resource, ok := resources[0].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("the resource within the ARM Template was not an object")
+		}
+		raw = resource
+	}
+
+	if v, ok := raw["kind"]; ok {
+		if kind := fmt.Sprintf("%v", v); !strings.EqualFold(kind, string(alertrules.AlertRuleKindScheduled)) {
+			return nil, fmt.Errorf("expected an Alert Rule of kind %q but got %q", string(alertrules.AlertRuleKindScheduled), kind)
+		}
+	}
+
+	properties := raw
+	if v, ok := raw["properties"]; ok {
+		if properties, ok = v.(map[string]interface{}); !ok {
+			return nil, fmt.Errorf("`properties` was not an object")
+		}
+	} else {
+		properties = normalizeScheduledAlertRuleTemplateKeys(raw)
+	}
+
+	for _, key := range []string{"queryFrequency", "queryPeriod", "suppressionDuration"} {
+		if v, ok := properties[key].(string); ok {
+			properties[key] = normalizeScheduledAlertRuleTemplateDuration(v)
+		}
+	}
+
+	if v, ok := properties["severity"].(string); ok {
+		properties["severity"] = normalizeScheduledAlertRuleTemplateValue(v, alertrules.PossibleValuesForAlertSeverity())
+	}
+
+	if v, ok := properties["triggerOperator"].(string); ok {
+		properties["triggerOperator"] = normalizeScheduledAlertRuleTemplateTriggerOperator(v)
+	}
+
+	if v, ok := properties["tactics"].([]interface{}); ok {
+		tactics := make([]interface{}, 0)
+		for _, tactic := range v {
+			tactics = append(tactics, normalizeScheduledAlertRuleTemplateValue(fmt.Sprintf("%v", tactic), alertrules.PossibleValuesForAttackTactic()))
+		}
+		properties["tactics"] = tactics
+	}
+
+	// Sub-techniques (e.g. `T1078.004`) have to be specified separately from the parent technique
+	if v, ok := properties["techniques"].([]interface{}); ok {
+		techniques := make([]interface{}, 0)
+		subTechniques := make([]interface{}, 0)
+		if existing, ok := properties["subTechniques"].([]interface{}); ok {
+			subTechniques = existing
+		}
+
+		seen := make(map[string]bool)
+		for _, item := range v {
+			technique := fmt.Sprintf("%v", item)
+			if parent, _, found := strings.Cut(technique, "."); found {
+				subTechniques = append(subTechniques, technique)
+				technique = parent
+			}
+			if !seen[technique] {
+				seen[technique] = true
+				techniques = append(techniques, technique)
+			}
+		}
+
+		properties["techniques"] = techniques
+		if len(subTechniques) > 0 {
+			properties["subTechniques"] = subTechniques
+		}
+	}
+
+	// fields which are returned by the API but which can't be set
+	delete(properties, "lastModifiedUtc")
+
+	if _, ok := properties["suppressionDuration"]; !ok {
+		properties["suppressionDuration"] = "PT5H"
+	}
+
+	encoded, err := json.Marshal(properties)
+	if err != nil {
+		return nil, fmt.Errorf("encoding template: %+v", err)
+	}
+
+	var output alertrules.ScheduledAlertRuleProperties
+	if err := json.Unmarshal(encoded, &output); err != nil {
+		return nil, fmt.Errorf("decoding template into a Scheduled Alert Rule: %+v", err)
+	}
+
+	if output.DisplayName == "" {
+		return nil, fmt.Errorf("the template must specify a display name (`name` or `displayName`)")
+	}
+	if output.Query == nil || *output.Query == "" {
+		return nil, fmt.Errorf("the template must specify a `query`")
+	}
+
+	return &output, nil
+}
+
+// normalizeScheduledAlertRuleTemplateKeys maps the keys used within the Azure Sentinel repository templates to the
+// keys used by the API, other keys (e.g. `requiredDataConnectors`) are ignored when the template is decoded
+func normalizeScheduledAlertRuleTemplateKeys(input map[string]interface{}) map[string]interface{} {
+	output := make(map[string]interface{})
+	for k, v := range input {
+		output[k] = v
+	}
+
+	if _, ok := input["displayName"]; !ok {
+		output["displayName"] = input["name"]
+	}
+	delete(output, "name")
+
+	if v, ok := input["id"]; ok {
+		if _, ok := input["alertRuleTemplateName"]; !ok {
+			output["alertRuleTemplateName"] = v
+		}
+		delete(output, "id")
+	}
+
+	if v, ok := input["version"]; ok {
+		if _, ok := input["templateVersion"]; !ok {
+			output["templateVersion"] = fmt.Sprintf("%v", v)
+		}
+		delete(output, "version")
+	}
+
+	if v, ok := input["relevantTechniques"]; ok {
+		if _, ok := input["techniques"]; !ok {
+			output["techniques"] = v
+		}
+		delete(output, "relevantTechniques")
+	}
+
+	return output
+}
+
+func normalizeScheduledAlertRuleTemplateDuration(input string) string {
+	matches := scheduledAlertRuleTemplateDurationRegex.FindStringSubmatch(input)
+	if len(matches) != 3 {
+		return input
+	}
+
+	switch strings.ToLower(matches[2]) {
+	case "d":
+		return fmt.Sprintf("P%sD", matches[1])
+	case "h":
+		return fmt.Sprintf("PT%sH", matches[1])
+	default:
+		return fmt.Sprintf("PT%sM", matches[1])
+	}
+}
+
+func normalizeScheduledAlertRuleTemplateTriggerOperator(input string) string {
+	switch strings.ToLower(input) {
+	case "gt":
+		return string(alertrules.TriggerOperatorGreaterThan)
+	case "lt":
+		return string(alertrules.TriggerOperatorLessThan)
+	case "eq":
+		return string(alertrules.TriggerOperatorEqual)
+	case "ne":
+		return string(alertrules.TriggerOperatorNotEqual)
+	}
+
+	return normalizeScheduledAlertRuleTemplateValue(input, alertrules.PossibleValuesForTriggerOperator())
+}
+
+func normalizeScheduledAlertRuleTemplateValue(input string, possibleValues []string) string {
+	for _, v := range possibleValues {
+		if strings.EqualFold(input, v) {
+			return v
+		}
+	}
+
+	return input
+}
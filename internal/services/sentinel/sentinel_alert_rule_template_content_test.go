@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/alertrules"
+)
+
+func TestNormalizeScheduledAlertRuleTemplate(t *testing.T) {
+	testData := []struct {
+		name     string
+		input    string
+		expected *alertrules.ScheduledAlertRuleProperties
+		error    bool
+	}{
+		{
+			name: "sentinel repository yaml template",
+			input: `
+id: 0b9ae89d-8cad-461c-808f-0494f70ad5c4
+name: Rare subscription-level operations in Azure
+description: |
+  This query looks for a few sensitive subscription-level events.
+severity: low
+requiredDataConnectors:
+  - connectorId: AzureActivity
+    dataTypes:
+      - AzureActivity
+queryFrequency: 1d
+queryPeriod: 14d
+triggerOperator: gt
+triggerThreshold: 0
+tactics:
+  - CredentialAccess
+  - persistence
+relevantTechniques:
+  - T1003
+  - T1098.001
+  - T1098.003
+query: |
+  AzureActivity | take 1
+entityMappings:
+  - entityType: Account
+    fieldMappings:
+      - identifier: Name
+        columnName: Caller
+version: 2.0.2
+kind: Scheduled
+`,
+			expected: &alertrules.ScheduledAlertRuleProperties{
+				AlertRuleTemplateName: pointer.To("0b9ae89d-8cad-461c-808f-0494f70ad5c4"),
+				Description:           pointer.To("This query looks for a few sensitive subscription-level events.\n"),
+				DisplayName:           "Rare subscription-level operations in Azure",
+				EntityMappings: &[]alertrules.EntityMapping{
+					{
+						EntityType: pointer.To(alertrules.EntityMappingTypeAccount),
+						FieldMappings: &[]alertrules.FieldMapping{
+							{
+								Identifier: pointer.To("Name"),
+								ColumnName: pointer.To("Caller"),
+							},
+						},
+					},
+				},
+				Query:               pointer.To("AzureActivity | take 1\n"),
+				QueryFrequency:      pointer.To("P1D"),
+				QueryPeriod:         pointer.To("P14D"),
+				Severity:            pointer.To(alertrules.AlertSeverityLow),
+				SubTechniques:       &[]string{"T1098.001", "T1098.003"},
+				SuppressionDuration: "PT5H",
+				Tactics:             &[]alertrules.AttackTactic{alertrules.AttackTacticCredentialAccess, alertrules.AttackTacticPersistence},
+				Techniques:          &[]string{"T1003", "T1098"},
+				TemplateVersion:     pointer.To("2.0.2"),
+				TriggerOperator:     pointer.To(alertrules.TriggerOperatorGreaterThan),
+				TriggerThreshold:    pointer.To(int64(0)),
+			},
+		},
+		{
+			name: "arm template",
+			input: `{
+  "$schema": "https://schema.management.azure.com/schemas/2019-04-01/deploymentTemplate.json#",
+  "contentVersion": "1.0.0.0",
+  "resources": [
+    {
+      "type": "Microsoft.OperationalInsights/workspaces/providers/alertRules",
+      "kind": "Scheduled",
+      "properties": {
+        "displayName": "Example",
+        "severity": "High",
+        "enabled": true,
+        "query": "AzureActivity",
+        "queryFrequency": "PT1H",
+        "queryPeriod": "PT1H",
+        "triggerOperator": "GreaterThan",
+        "triggerThreshold": 5,
+        "suppressionDuration": "PT1H",
+        "suppressionEnabled": false,
+        "lastModifiedUtc": "2024-01-01T00:00:00Z"
+      }
+    }
+  ]
+}`,
+			expected: &alertrules.ScheduledAlertRuleProperties{
+				DisplayName:         "Example",
+				Enabled:             true,
+				Query:               pointer.To("AzureActivity"),
+				QueryFrequency:      pointer.To("PT1H"),
+				QueryPeriod:         pointer.To("PT1H"),
+				Severity:            pointer.To(alertrules.AlertSeverityHigh),
+				SuppressionDuration: "PT1H",
+				TriggerOperator:     pointer.To(alertrules.TriggerOperatorGreaterThan),
+				TriggerThreshold:    pointer.To(int64(5)),
+			},
+		},
+		{
+			name:  "wrong kind",
+			input: `{"kind": "NRT", "name": "Example", "query": "AzureActivity"}`,
+			error: true,
+		},
+		{
+			name:  "missing query",
+			input: `{"name": "Example"}`,
+			error: true,
+		},
+		{
+			name: "multiple resources in arm template",
+			input: `{"resources": [
+  {"kind": "Scheduled", "properties": {"displayName": "a", "query": "a"}},
+  {"kind": "Scheduled", "properties": {"displayName": "b", "query": "b"}}
+]}`,
+			error: true,
+		},
+		{
+			name:  "invalid content",
+			input: `{`,
+			error: true,
+		},
+	}
+
+	for _, v := range testData {
+		t.Logf("[DEBUG] Testing %q", v.name)
+
+		actual, err := normalizeScheduledAlertRuleTemplate(v.input)
+		if err != nil {
+			if v.error {
+				continue
+			}
+			t.Fatalf("expected no error for %q but got: %+v", v.name, err)
+		}
+		if v.error {
+			t.Fatalf("expected an error for %q but didn't get one", v.name)
+		}
+
+		if !reflect.DeepEqual(actual, v.expected) {
+			t.Fatalf("expected %+v for %q but got %+v", *v.expected, v.name, *actual)
+		}
+	}
+}
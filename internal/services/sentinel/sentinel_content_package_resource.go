@@ -0,0 +1,216 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/contentpackages"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/contentproductpackages"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type ContentPackageResource struct{}
+
+var _ sdk.Resource = ContentPackageResource{}
+
+type ContentPackageModel struct {
+	LogAnalyticsWorkspaceId string `tfschema:"log_analytics_workspace_id"`
+	ContentId               string `tfschema:"content_id"`
+	ContentKind             string `tfschema:"content_kind"`
+	ContentProductId        string `tfschema:"content_product_id"`
+	DisplayName             string `tfschema:"display_name"`
+	Version                 string `tfschema:"version"`
+}
+
+func (r ContentPackageResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+		"content_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+	}
+}
+
+func (r ContentPackageResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"content_kind": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"content_product_id": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"display_name": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"version": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r ContentPackageResource) ResourceType() string {
+	return "azurerm_sentinel_content_package"
+}
+
+func (r ContentPackageResource) ModelObject() interface{} {
+	return &ContentPackageModel{}
+}
+
+func (r ContentPackageResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return contentpackages.ValidateContentPackageID
+}
+
+func (r ContentPackageResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+			productPackagesClient := metadata.Client.Sentinel.ContentProductPackagesClient
+
+			var model ContentPackageModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			id := contentpackages.NewContentPackageID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.ContentId)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the details of the package being installed are taken from the package available within the Content Hub
+			productWorkspaceId := contentproductpackages.NewWorkspaceID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName)
+			options := contentproductpackages.ProductPackagesListOperationOptions{
+				Filter: pointer.To(fmt.Sprintf("properties/contentId eq '%s'", model.ContentId)),
+			}
+			products, err := productPackagesClient.ProductPackagesListComplete(ctx, productWorkspaceId, options)
+			if err != nil {
+				return fmt.Errorf("retrieving Content Hub package %q for %s: %+v", model.ContentId, id, err)
+			}
+
+			var product *contentproductpackages.ProductPackageProperties
+			for _, item := range products.Items {
+				if item.Properties != nil && pointer.From(item.Properties.ContentId) == model.ContentId {
+					product = item.Properties
+					break
+				}
+			}
+			if product == nil {
+				return fmt.Errorf("the Content Hub package %q was not found for %s", model.ContentId, workspaceId)
+			}
+
+			param := contentpackages.PackageModel{
+				Properties: &contentpackages.PackageBaseProperties{
+					ContentId:            product.ContentId,
+					ContentProductId:     product.ContentProductId,
+					ContentSchemaVersion: product.ContentSchemaVersion,
+					Description:          product.Description,
+					DisplayName:          product.DisplayName,
+					PublisherDisplayName: product.PublisherDisplayName,
+					Version:              product.Version,
+				},
+			}
+
+			if product.ContentKind != nil {
+				param.Properties.ContentKind = pointer.To(contentpackages.PackageKind(*product.ContentKind))
+			}
+
+			if _, err = client.ContentPackageInstall(ctx, id, param); err != nil {
+				return fmt.Errorf("installing %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r ContentPackageResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			id, err := contentpackages.ParseContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := ContentPackageModel{
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+				ContentId:               id.PackageId,
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.ContentKind = string(pointer.From(props.ContentKind))
+					state.ContentProductId = pointer.From(props.ContentProductId)
+					state.DisplayName = pointer.From(props.DisplayName)
+					state.Version = pointer.From(props.Version)
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r ContentPackageResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.ContentPackagesClient
+
+			id, err := contentpackages.ParseContentPackageID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.ContentPackageUninstall(ctx, *id); err != nil {
+				return fmt.Errorf("uninstalling %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
@@ -0,0 +1,324 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/workspacemanagerassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type WorkspaceManagerAssignmentResource struct{}
+
+var _ sdk.ResourceWithUpdate = WorkspaceManagerAssignmentResource{}
+
+type WorkspaceManagerAssignmentModel struct {
+	Name                     string   `tfschema:"name"`
+	LogAnalyticsWorkspaceId  string   `tfschema:"log_analytics_workspace_id"`
+	TargetGroupName          string   `tfschema:"target_group_name"`
+	ResourceIds              []string `tfschema:"resource_ids"`
+	LastJobEndTime           string   `tfschema:"last_job_end_time"`
+	LastJobProvisioningState string   `tfschema:"last_job_provisioning_state"`
+}
+
+func (r WorkspaceManagerAssignmentResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+		"target_group_name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"resource_ids": {
+			Type:     pluginsdk.TypeSet,
+			Required: true,
+			MinItems: 1,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r WorkspaceManagerAssignmentResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"last_job_end_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+		"last_job_provisioning_state": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r WorkspaceManagerAssignmentResource) ResourceType() string {
+	return "azurerm_sentinel_workspace_manager_assignment"
+}
+
+func (r WorkspaceManagerAssignmentResource) ModelObject() interface{} {
+	return &WorkspaceManagerAssignmentModel{}
+}
+
+func (r WorkspaceManagerAssignmentResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workspacemanagerassignments.ValidateWorkspaceManagerAssignmentID
+}
+
+func (r WorkspaceManagerAssignmentResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.WorkspaceManagerAssignmentsClient
+
+			var model WorkspaceManagerAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			id := workspacemanagerassignments.NewWorkspaceManagerAssignmentID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			param := workspacemanagerassignments.WorkspaceManagerAssignment{
+				Properties: &workspacemanagerassignments.WorkspaceManagerAssignmentProperties{
+					TargetResourceName: model.TargetGroupName,
+					Items:              expandWorkspaceManagerAssignmentItems(model.ResourceIds),
+				},
+			}
+
+			if _, err = client.CreateOrUpdate(ctx, id, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			if err := runWorkspaceManagerAssignmentJob(ctx, client, id); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WorkspaceManagerAssignmentResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.WorkspaceManagerAssignmentsClient
+
+			id, err := workspacemanagerassignments.ParseWorkspaceManagerAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := WorkspaceManagerAssignmentModel{
+				Name:                    id.WorkspaceManagerAssignmentName,
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.TargetGroupName = props.TargetResourceName
+					state.ResourceIds = flattenWorkspaceManagerAssignmentItems(props.Items)
+					state.LastJobEndTime = pointer.From(props.LastJobEndTime)
+					state.LastJobProvisioningState = string(pointer.From(props.LastJobProvisioningState))
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WorkspaceManagerAssignmentResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.WorkspaceManagerAssignmentsClient
+
+			id, err := workspacemanagerassignments.ParseWorkspaceManagerAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model WorkspaceManagerAssignmentModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			param := workspacemanagerassignments.WorkspaceManagerAssignment{
+				Properties: &workspacemanagerassignments.WorkspaceManagerAssignmentProperties{
+					TargetResourceName: model.TargetGroupName,
+					Items:              expandWorkspaceManagerAssignmentItems(model.ResourceIds),
+				},
+			}
+
+			if _, err = client.CreateOrUpdate(ctx, *id, param); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			if err := runWorkspaceManagerAssignmentJob(ctx, client, *id); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WorkspaceManagerAssignmentResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.WorkspaceManagerAssignmentsClient
+
+			id, err := workspacemanagerassignments.ParseWorkspaceManagerAssignmentID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+// runWorkspaceManagerAssignmentJob triggers a Job which deploys the items of the Assignment to each member of the
+// target group, and then waits for it to complete
+func runWorkspaceManagerAssignmentJob(ctx context.Context, client *workspacemanagerassignments.WorkspaceManagerAssignmentsClient, id workspacemanagerassignments.WorkspaceManagerAssignmentId) error {
+	resp, err := client.WorkspaceManagerAssignmentJobsCreate(ctx, id)
+	if err != nil {
+		return fmt.Errorf("creating Job for %s: %+v", id, err)
+	}
+	if resp.Model == nil || resp.Model.Name == nil {
+		return fmt.Errorf("creating Job for %s: `name` was nil", id)
+	}
+
+	jobId := workspacemanagerassignments.NewJobID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName, id.WorkspaceManagerAssignmentName, *resp.Model.Name)
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context had no deadline")
+	}
+
+	stateConf := &pluginsdk.StateChangeConf{
+		Pending: []string{string(workspacemanagerassignments.ProvisioningStateInProgress)},
+		Target:  []string{string(workspacemanagerassignments.ProvisioningStateSucceeded)},
+		Refresh: func() (interface{}, string, error) {
+			log.Printf("[DEBUG] Checking the status of %s..", jobId)
+
+			resp, err := client.WorkspaceManagerAssignmentJobsGet(ctx, jobId)
+			if err != nil {
+				return nil, "", fmt.Errorf("retrieving %s: %+v", jobId, err)
+			}
+
+			if resp.Model == nil || resp.Model.Properties == nil {
+				return resp, string(workspacemanagerassignments.ProvisioningStateInProgress), nil
+			}
+
+			props := resp.Model.Properties
+			state := pointer.From(props.ProvisioningState)
+			if state == workspacemanagerassignments.ProvisioningStateFailed || state == workspacemanagerassignments.ProvisioningStateCanceled {
+				return resp, string(state), fmt.Errorf("%s finished with state %q: %s", jobId, state, flattenWorkspaceManagerAssignmentJobErrors(props))
+			}
+			if state == "" {
+				state = workspacemanagerassignments.ProvisioningStateInProgress
+			}
+
+			return resp, string(state), nil
+		},
+		MinTimeout: 15 * time.Second,
+		Timeout:    time.Until(deadline),
+	}
+
+	if _, err := stateConf.WaitForStateContext(ctx); err != nil {
+		return fmt.Errorf("waiting for the Job of %s to complete: %+v", id, err)
+	}
+
+	return nil
+}
+
+func flattenWorkspaceManagerAssignmentJobErrors(input *workspacemanagerassignments.JobProperties) string {
+	errors := make([]string, 0)
+	if input.ErrorMessage != nil {
+		errors = append(errors, *input.ErrorMessage)
+	}
+
+	for _, item := range pointer.From(input.Items) {
+		for _, e := range pointer.From(item.Errors) {
+			errors = append(errors, fmt.Sprintf("%s (member %q): %s", pointer.From(item.ResourceId), e.MemberResourceName, e.ErrorMessage))
+		}
+	}
+
+	return strings.Join(errors, "; ")
+}
+
+func expandWorkspaceManagerAssignmentItems(input []string) []workspacemanagerassignments.AssignmentItem {
+	output := make([]workspacemanagerassignments.AssignmentItem, 0)
+	for _, v := range input {
+		output = append(output, workspacemanagerassignments.AssignmentItem{
+			ResourceId: pointer.To(v),
+		})
+	}
+	return output
+}
+
+func flattenWorkspaceManagerAssignmentItems(input []workspacemanagerassignments.AssignmentItem) []string {
+	output := make([]string, 0)
+	for _, v := range input {
+		if v.ResourceId != nil {
+			output = append(output, *v.ResourceId)
+		}
+	}
+	return output
+}
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/workspacemanagerassignments"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WorkspaceManagerAssignmentResource struct{}
+
+func TestAccWorkspaceManagerAssignment_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_workspace_manager_assignment", "test")
+	r := WorkspaceManagerAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("last_job_provisioning_state").HasValue("Succeeded"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWorkspaceManagerAssignment_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_workspace_manager_assignment", "test")
+	r := WorkspaceManagerAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.update(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("resource_ids.#").HasValue("2"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWorkspaceManagerAssignment_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_workspace_manager_assignment", "test")
+	r := WorkspaceManagerAssignmentResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r WorkspaceManagerAssignmentResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client := clients.Sentinel.WorkspaceManagerAssignmentsClient
+
+	id, err := workspacemanagerassignments.ParseWorkspaceManagerAssignmentID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r WorkspaceManagerAssignmentResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_workspace_manager_assignment" "test" {
+  name                       = "acctest-wma-%d"
+  log_analytics_workspace_id = azurerm_sentinel_workspace_manager_group.test.log_analytics_workspace_id
+  target_group_name          = azurerm_sentinel_workspace_manager_group.test.name
+  resource_ids               = [azurerm_sentinel_alert_rule_ms_security_incident.first.id]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r WorkspaceManagerAssignmentResource) update(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_workspace_manager_assignment" "test" {
+  name                       = "acctest-wma-%d"
+  log_analytics_workspace_id = azurerm_sentinel_workspace_manager_group.test.log_analytics_workspace_id
+  target_group_name          = azurerm_sentinel_workspace_manager_group.test.name
+  resource_ids = [
+    azurerm_sentinel_alert_rule_ms_security_incident.first.id,
+    azurerm_sentinel_alert_rule_ms_security_incident.second.id,
+  ]
+}
+`, r.template(data), data.RandomInteger)
+}
+
+func (r WorkspaceManagerAssignmentResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_workspace_manager_assignment" "import" {
+  name                       = azurerm_sentinel_workspace_manager_assignment.test.name
+  log_analytics_workspace_id = azurerm_sentinel_workspace_manager_assignment.test.log_analytics_workspace_id
+  target_group_name          = azurerm_sentinel_workspace_manager_assignment.test.target_group_name
+  resource_ids               = azurerm_sentinel_workspace_manager_assignment.test.resource_ids
+}
+`, r.basic(data))
+}
+
+func (r WorkspaceManagerAssignmentResource) template(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_alert_rule_ms_security_incident" "first" {
+  name                       = "acctest-SentinelAlertRule-MSI-first-%[2]d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  product_filter             = "Microsoft Cloud App Security"
+  display_name               = "first rule"
+  severity_filter            = ["High"]
+}
+
+resource "azurerm_sentinel_alert_rule_ms_security_incident" "second" {
+  name                       = "acctest-SentinelAlertRule-MSI-second-%[2]d"
+  log_analytics_workspace_id = azurerm_sentinel_log_analytics_workspace_onboarding.test.workspace_id
+  product_filter             = "Azure Security Center"
+  display_name               = "second rule"
+  severity_filter            = ["High"]
+}
+`, WorkspaceManagerGroupResource{}.basic(data), data.RandomInteger)
+}
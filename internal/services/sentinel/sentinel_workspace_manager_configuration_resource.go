@@ -0,0 +1,202 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/workspacemanagerconfigurations"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type WorkspaceManagerConfigurationResource struct{}
+
+var _ sdk.ResourceWithUpdate = WorkspaceManagerConfigurationResource{}
+
+type WorkspaceManagerConfigurationModel struct {
+	Name                    string `tfschema:"name"`
+	LogAnalyticsWorkspaceId string `tfschema:"log_analytics_workspace_id"`
+	Enabled                 bool   `tfschema:"enabled"`
+}
+
+func (r WorkspaceManagerConfigurationResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+	}
+}
+
+func (r WorkspaceManagerConfigurationResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WorkspaceManagerConfigurationResource) ResourceType() string {
+	return "azurerm_sentinel_workspace_manager_configuration"
+}
+
+func (r WorkspaceManagerConfigurationResource) ModelObject() interface{} {
+	return &WorkspaceManagerConfigurationModel{}
+}
+
+func (r WorkspaceManagerConfigurationResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workspacemanagerconfigurations.ValidateWorkspaceManagerConfigurationID
+}
+
+func (r WorkspaceManagerConfigurationResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.WorkspaceManagerConfigurationsClient
+
+			var model WorkspaceManagerConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			id := workspacemanagerconfigurations.NewWorkspaceManagerConfigurationID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			param := workspacemanagerconfigurations.WorkspaceManagerConfiguration{
+				Properties: &workspacemanagerconfigurations.WorkspaceManagerConfigurationProperties{
+					Mode: expandWorkspaceManagerConfigurationMode(model.Enabled),
+				},
+			}
+
+			if _, err = client.CreateOrUpdate(ctx, id, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r WorkspaceManagerConfigurationResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.WorkspaceManagerConfigurationsClient
+
+			id, err := workspacemanagerconfigurations.ParseWorkspaceManagerConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := WorkspaceManagerConfigurationModel{
+				Name:                    id.WorkspaceManagerConfigurationName,
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					state.Enabled = props.Mode == workspacemanagerconfigurations.ModeEnabled
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WorkspaceManagerConfigurationResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.WorkspaceManagerConfigurationsClient
+
+			id, err := workspacemanagerconfigurations.ParseWorkspaceManagerConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var model WorkspaceManagerConfigurationModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			param := workspacemanagerconfigurations.WorkspaceManagerConfiguration{
+				Properties: &workspacemanagerconfigurations.WorkspaceManagerConfigurationProperties{
+					Mode: expandWorkspaceManagerConfigurationMode(model.Enabled),
+				},
+			}
+
+			if _, err = client.CreateOrUpdate(ctx, *id, param); err != nil {
+				return fmt.Errorf("updating %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r WorkspaceManagerConfigurationResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.WorkspaceManagerConfigurationsClient
+
+			id, err := workspacemanagerconfigurations.ParseWorkspaceManagerConfigurationID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandWorkspaceManagerConfigurationMode(enabled bool) workspacemanagerconfigurations.Mode {
+	if enabled {
+		return workspacemanagerconfigurations.ModeEnabled
+	}
+	return workspacemanagerconfigurations.ModeDisabled
+}
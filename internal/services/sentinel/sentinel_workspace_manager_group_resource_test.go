@@ -0,0 +1,138 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/workspacemanagergroups"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WorkspaceManagerGroupResource struct{}
+
+func TestAccWorkspaceManagerGroup_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_workspace_manager_group", "test")
+	r := WorkspaceManagerGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWorkspaceManagerGroup_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_workspace_manager_group", "test")
+	r := WorkspaceManagerGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWorkspaceManagerGroup_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_workspace_manager_group", "test")
+	r := WorkspaceManagerGroupResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r WorkspaceManagerGroupResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client := clients.Sentinel.WorkspaceManagerGroupsClient
+
+	id, err := workspacemanagergroups.ParseWorkspaceManagerGroupID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r WorkspaceManagerGroupResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_workspace_manager_group" "test" {
+  name                       = "acctest-wmg-%d"
+  log_analytics_workspace_id = azurerm_sentinel_workspace_manager_member.test.log_analytics_workspace_id
+  display_name               = "acctest group"
+  member_names               = [azurerm_sentinel_workspace_manager_member.test.name]
+}
+`, WorkspaceManagerMemberResource{}.basic(data), data.RandomInteger)
+}
+
+func (r WorkspaceManagerGroupResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_workspace_manager_group" "test" {
+  name                       = "acctest-wmg-%d"
+  log_analytics_workspace_id = azurerm_sentinel_workspace_manager_member.test.log_analytics_workspace_id
+  display_name               = "acctest group updated"
+  description                = "acctest description"
+  member_names               = [azurerm_sentinel_workspace_manager_member.test.name]
+}
+`, WorkspaceManagerMemberResource{}.basic(data), data.RandomInteger)
+}
+
+func (r WorkspaceManagerGroupResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_workspace_manager_group" "import" {
+  name                       = azurerm_sentinel_workspace_manager_group.test.name
+  log_analytics_workspace_id = azurerm_sentinel_workspace_manager_group.test.log_analytics_workspace_id
+  display_name               = azurerm_sentinel_workspace_manager_group.test.display_name
+  member_names               = azurerm_sentinel_workspace_manager_group.test.member_names
+}
+`, r.basic(data))
+}
@@ -0,0 +1,185 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/operationalinsights/2020-08-01/workspaces"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/workspacemanagermember"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type WorkspaceManagerMemberResource struct{}
+
+var _ sdk.Resource = WorkspaceManagerMemberResource{}
+
+type WorkspaceManagerMemberModel struct {
+	Name                    string `tfschema:"name"`
+	LogAnalyticsWorkspaceId string `tfschema:"log_analytics_workspace_id"`
+	TargetWorkspaceId       string `tfschema:"target_workspace_id"`
+	TargetWorkspaceTenantId string `tfschema:"target_workspace_tenant_id"`
+}
+
+func (r WorkspaceManagerMemberResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"log_analytics_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+		"target_workspace_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: workspaces.ValidateWorkspaceID,
+		},
+		"target_workspace_tenant_id": {
+			Type:         pluginsdk.TypeString,
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.IsUUID,
+		},
+	}
+}
+
+func (r WorkspaceManagerMemberResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r WorkspaceManagerMemberResource) ResourceType() string {
+	return "azurerm_sentinel_workspace_manager_member"
+}
+
+func (r WorkspaceManagerMemberResource) ModelObject() interface{} {
+	return &WorkspaceManagerMemberModel{}
+}
+
+func (r WorkspaceManagerMemberResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return workspacemanagermember.ValidateWorkspaceManagerMemberID
+}
+
+func (r WorkspaceManagerMemberResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.WorkspaceManagerMembersClient
+
+			var model WorkspaceManagerMemberModel
+			if err := metadata.Decode(&model); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			workspaceId, err := workspaces.ParseWorkspaceID(model.LogAnalyticsWorkspaceId)
+			if err != nil {
+				return fmt.Errorf("parsing Log Analytics Workspace ID: %w", err)
+			}
+
+			id := workspacemanagermember.NewWorkspaceManagerMemberID(workspaceId.SubscriptionId, workspaceId.ResourceGroupName, workspaceId.WorkspaceName, model.Name)
+
+			existing, err := client.Get(ctx, id)
+			if err != nil {
+				if !response.WasNotFound(existing.HttpResponse) {
+					return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+				}
+			}
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			// the member workspace is within the tenant being authenticated against unless otherwise specified
+			tenantId := model.TargetWorkspaceTenantId
+			if tenantId == "" {
+				tenantId = metadata.Client.Account.TenantId
+			}
+
+			param := workspacemanagermember.WorkspaceManagerMember{
+				Properties: &workspacemanagermember.WorkspaceManagerMemberProperties{
+					TargetWorkspaceResourceId: model.TargetWorkspaceId,
+					TargetWorkspaceTenantId:   tenantId,
+				},
+			}
+
+			if _, err = client.CreateOrUpdate(ctx, id, param); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r WorkspaceManagerMemberResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.WorkspaceManagerMembersClient
+
+			id, err := workspacemanagermember.ParseWorkspaceManagerMemberID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.Get(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := WorkspaceManagerMemberModel{
+				Name:                    id.WorkspaceManagerMemberName,
+				LogAnalyticsWorkspaceId: workspaces.NewWorkspaceID(id.SubscriptionId, id.ResourceGroupName, id.WorkspaceName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				if props := model.Properties; props != nil {
+					targetWorkspaceId, err := workspaces.ParseWorkspaceIDInsensitively(props.TargetWorkspaceResourceId)
+					if err != nil {
+						return err
+					}
+					state.TargetWorkspaceId = targetWorkspaceId.ID()
+					state.TargetWorkspaceTenantId = props.TargetWorkspaceTenantId
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r WorkspaceManagerMemberResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Sentinel.WorkspaceManagerMembersClient
+
+			id, err := workspacemanagermember.ParseWorkspaceManagerMemberID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			if _, err := client.Delete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", id, err)
+			}
+
+			return nil
+		},
+	}
+}
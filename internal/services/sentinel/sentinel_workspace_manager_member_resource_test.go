@@ -0,0 +1,94 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sentinel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/securityinsights/2023-12-01-preview/workspacemanagermember"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type WorkspaceManagerMemberResource struct{}
+
+func TestAccWorkspaceManagerMember_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_workspace_manager_member", "test")
+	r := WorkspaceManagerMemberResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("target_workspace_tenant_id").IsUUID(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccWorkspaceManagerMember_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_sentinel_workspace_manager_member", "test")
+	r := WorkspaceManagerMemberResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func (r WorkspaceManagerMemberResource) Exists(ctx context.Context, clients *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	client := clients.Sentinel.WorkspaceManagerMembersClient
+
+	id, err := workspacemanagermember.ParseWorkspaceManagerMemberID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Get(ctx, *id)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", *id, err)
+	}
+
+	return utils.Bool(true), nil
+}
+
+func (r WorkspaceManagerMemberResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_workspace_manager_member" "test" {
+  name                       = "acctest-wmm-%d"
+  log_analytics_workspace_id = azurerm_sentinel_workspace_manager_configuration.test.log_analytics_workspace_id
+  target_workspace_id        = azurerm_sentinel_log_analytics_workspace_onboarding.member.workspace_id
+}
+`, WorkspaceManagerConfigurationResource{}.basic(data, true), data.RandomInteger)
+}
+
+func (r WorkspaceManagerMemberResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_sentinel_workspace_manager_member" "import" {
+  name                       = azurerm_sentinel_workspace_manager_member.test.name
+  log_analytics_workspace_id = azurerm_sentinel_workspace_manager_member.test.log_analytics_workspace_id
+  target_workspace_id        = azurerm_sentinel_workspace_manager_member.test.target_workspace_id
+}
+`, r.basic(data))
+}
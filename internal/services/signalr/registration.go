@@ -26,6 +26,7 @@ func (r Registration) Resources() []sdk.Resource {
 		CustomCertWebPubsubResource{},
 		CustomCertSignalrServiceResource{},
 		WebPubSubSocketIOResource{},
+		SignalRServiceReplicaResource{},
 	}
 }
 
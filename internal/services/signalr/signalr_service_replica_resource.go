@@ -0,0 +1,329 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package signalr
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/location"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/signalr/2024-03-01/signalr"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type SignalRServiceReplicaResource struct{}
+
+var _ sdk.ResourceWithUpdate = SignalRServiceReplicaResource{}
+
+type SignalRServiceReplicaModel struct {
+	Name                  string                          `tfschema:"name"`
+	SignalRServiceId      string                          `tfschema:"signalr_service_id"`
+	Location              string                          `tfschema:"location"`
+	Sku                   []SignalRServiceReplicaSkuModel `tfschema:"sku"`
+	RegionEndpointEnabled bool                            `tfschema:"region_endpoint_enabled"`
+	Tags                  map[string]string               `tfschema:"tags"`
+}
+
+type SignalRServiceReplicaSkuModel struct {
+	Name     string `tfschema:"name"`
+	Capacity int64  `tfschema:"capacity"`
+}
+
+func (r SignalRServiceReplicaResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:     pluginsdk.TypeString,
+			Required: true,
+			ForceNew: true,
+			ValidateFunc: validation.StringMatch(
+				regexp.MustCompile(`^[a-zA-Z0-9][a-zA-Z0-9-]{1,61}[a-zA-Z0-9]$`),
+				"The name must be between 3 and 63 characters long, can only contain letters, numbers and hyphens, and must start and end with a letter or number.",
+			),
+		},
+
+		"signalr_service_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: signalr.ValidateSignalRID,
+		},
+
+		"location": commonschema.Location(),
+
+		"sku": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"name": {
+						Type:     pluginsdk.TypeString,
+						Required: true,
+						// replicas are only supported for the Premium tier
+						ValidateFunc: validation.StringInSlice([]string{
+							"Premium_P1",
+							"Premium_P2",
+						}, false),
+					},
+
+					"capacity": {
+						Type:     pluginsdk.TypeInt,
+						Required: true,
+						ValidateFunc: validation.IntInSlice([]int{
+							1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 20, 30, 40, 50, 60, 70, 80, 90, 100, 200,
+							300, 400, 500, 600, 700, 800, 900, 1000,
+						}),
+					},
+				},
+			},
+		},
+
+		"region_endpoint_enabled": {
+			Type:     pluginsdk.TypeBool,
+			Optional: true,
+			Default:  true,
+		},
+
+		"tags": commonschema.Tags(),
+	}
+}
+
+func (r SignalRServiceReplicaResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{}
+}
+
+func (r SignalRServiceReplicaResource) ModelObject() interface{} {
+	return &SignalRServiceReplicaModel{}
+}
+
+func (r SignalRServiceReplicaResource) ResourceType() string {
+	return "azurerm_signalr_service_replica"
+}
+
+func (r SignalRServiceReplicaResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return signalr.ValidateReplicaID
+}
+
+func (r SignalRServiceReplicaResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.SignalRClient
+
+			var config SignalRServiceReplicaModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			signalRServiceId, err := signalr.ParseSignalRID(config.SignalRServiceId)
+			if err != nil {
+				return err
+			}
+
+			id := signalr.NewReplicaID(signalRServiceId.SubscriptionId, signalRServiceId.ResourceGroupName, signalRServiceId.SignalRName, config.Name)
+
+			locks.ByID(signalRServiceId.ID())
+			defer locks.UnlockByID(signalRServiceId.ID())
+
+			existing, err := client.ReplicasGet(ctx, id)
+			if err != nil && !response.WasNotFound(existing.HttpResponse) {
+				return fmt.Errorf("checking for presence of existing %s: %+v", id, err)
+			}
+
+			if !response.WasNotFound(existing.HttpResponse) {
+				return metadata.ResourceRequiresImport(r.ResourceType(), id)
+			}
+
+			service, err := client.Get(ctx, *signalRServiceId)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *signalRServiceId, err)
+			}
+
+			if model := service.Model; model != nil {
+				if model.Sku == nil || !strings.HasPrefix(model.Sku.Name, "Premium_") {
+					return fmt.Errorf("replicas can only be created for a SignalR Service with a `Premium` sku")
+				}
+
+				if location.Normalize(model.Location) == location.Normalize(config.Location) {
+					return fmt.Errorf("the `location` of %s must be different to the location of the SignalR Service (%q)", id, location.Normalize(model.Location))
+				}
+			}
+
+			payload := signalr.Replica{
+				Location: location.Normalize(config.Location),
+				Properties: &signalr.ReplicaProperties{
+					RegionEndpointEnabled: pointer.To(expandSignalRServiceReplicaRegionEndpointEnabled(config.RegionEndpointEnabled)),
+				},
+				Sku:  expandSignalRServiceReplicaSku(config.Sku),
+				Tags: pointer.To(config.Tags),
+			}
+
+			if err := client.ReplicasCreateOrUpdateThenPoll(ctx, id, payload); err != nil {
+				return fmt.Errorf("creating %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r SignalRServiceReplicaResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.SignalRClient
+
+			id, err := signalr.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			resp, err := client.ReplicasGet(ctx, *id)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			state := SignalRServiceReplicaModel{
+				Name:             id.ReplicaName,
+				SignalRServiceId: signalr.NewSignalRID(id.SubscriptionId, id.ResourceGroupName, id.SignalRName).ID(),
+			}
+
+			if model := resp.Model; model != nil {
+				state.Location = location.Normalize(model.Location)
+				state.Sku = flattenSignalRServiceReplicaSku(model.Sku)
+				state.Tags = pointer.From(model.Tags)
+
+				state.RegionEndpointEnabled = true
+				if props := model.Properties; props != nil {
+					state.RegionEndpointEnabled = !strings.EqualFold(pointer.From(props.RegionEndpointEnabled), "Disabled")
+				}
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r SignalRServiceReplicaResource) Update() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.SignalRClient
+
+			id, err := signalr.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			var config SignalRServiceReplicaModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			signalRServiceId := signalr.NewSignalRID(id.SubscriptionId, id.ResourceGroupName, id.SignalRName)
+			locks.ByID(signalRServiceId.ID())
+			defer locks.UnlockByID(signalRServiceId.ID())
+
+			existing, err := client.ReplicasGet(ctx, *id)
+			if err != nil {
+				return fmt.Errorf("retrieving %s: %+v", *id, err)
+			}
+
+			if existing.Model == nil {
+				return fmt.Errorf("retrieving %s: `model` was nil", *id)
+			}
+
+			payload := *existing.Model
+			if payload.Properties == nil {
+				payload.Properties = &signalr.ReplicaProperties{}
+			}
+
+			if metadata.ResourceData.HasChange("sku") {
+				payload.Sku = expandSignalRServiceReplicaSku(config.Sku)
+			}
+
+			if metadata.ResourceData.HasChange("region_endpoint_enabled") {
+				payload.Properties.RegionEndpointEnabled = pointer.To(expandSignalRServiceReplicaRegionEndpointEnabled(config.RegionEndpointEnabled))
+			}
+
+			if metadata.ResourceData.HasChange("tags") {
+				payload.Tags = pointer.To(config.Tags)
+			}
+
+			if err := client.ReplicasUpdateThenPoll(ctx, *id, payload); err != nil {
+				return fmt.Errorf("updating %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func (r SignalRServiceReplicaResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 60 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.SignalR.SignalRClient
+
+			id, err := signalr.ParseReplicaID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			signalRServiceId := signalr.NewSignalRID(id.SubscriptionId, id.ResourceGroupName, id.SignalRName)
+			locks.ByID(signalRServiceId.ID())
+			defer locks.UnlockByID(signalRServiceId.ID())
+
+			if _, err := client.ReplicasDelete(ctx, *id); err != nil {
+				return fmt.Errorf("deleting %s: %+v", *id, err)
+			}
+
+			return nil
+		},
+	}
+}
+
+func expandSignalRServiceReplicaSku(input []SignalRServiceReplicaSkuModel) *signalr.ResourceSku {
+	if len(input) == 0 {
+		return nil
+	}
+
+	return &signalr.ResourceSku{
+		Name:     input[0].Name,
+		Capacity: pointer.To(input[0].Capacity),
+	}
+}
+
+func flattenSignalRServiceReplicaSku(input *signalr.ResourceSku) []SignalRServiceReplicaSkuModel {
+	if input == nil {
+		return []SignalRServiceReplicaSkuModel{}
+	}
+
+	return []SignalRServiceReplicaSkuModel{
+		{
+			Name:     input.Name,
+			Capacity: pointer.From(input.Capacity),
+		},
+	}
+}
+
+func expandSignalRServiceReplicaRegionEndpointEnabled(input bool) string {
+	if input {
+		return "Enabled"
+	}
+	return "Disabled"
+}
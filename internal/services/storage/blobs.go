@@ -6,17 +6,20 @@ package storage
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
 	"fmt"
 	"io"
+	"log"
 	"os"
 	"runtime"
 	"strings"
 	"sync"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/jackofallops/giovanni/storage/2023-11-03/blob/blobs"
 )
 
@@ -28,6 +31,7 @@ type BlobUpload struct {
 	ContainerName string
 
 	BlobType        string
+	BlockSizeInMB   int
 	CacheControl    string
 	ContentType     string
 	ContentMD5      string
@@ -162,6 +166,16 @@ func (sbu BlobUpload) uploadBlockBlob(ctx context.Context) error {
 	}
 	defer file.Close()
 
+	info, err := file.Stat()
+	if err != nil {
+		return fmt.Errorf("Could not stat file %q: %s", file.Name(), err)
+	}
+
+	// files larger than a single block are uploaded as separate blocks in parallel, and then committed together
+	if blockSize := sbu.blockSizeInBytes(); info.Size() > blockSize {
+		return sbu.blockUploadFromSource(ctx, file, info.Size(), blockSize)
+	}
+
 	input := blobs.PutBlockBlobInput{
 		ContentType: pointer.To(sbu.ContentType),
 		MetaData:    sbu.MetaData,
@@ -400,6 +414,207 @@ func (sbu BlobUpload) blobPageUploadWorker(ctx context.Context, uploadCtx blobPa
 	}
 }
 
+const (
+	defaultBlockSize int64 = 4 * 1024 * 1024
+	maxBlockCount    int64 = 50000
+
+	// each worker holds a full block in memory whilst it's uploaded, so the number of workers is limited
+	// such that the blocks being uploaded at any one time don't exceed this
+	maxBlockUploadMemory int64 = 1024 * 1024 * 1024
+
+	// the number of attempts made to upload a single block before the upload is failed
+	blockUploadAttempts = 3
+)
+
+type storageBlobBlock struct {
+	id         string
+	contentMD5 string
+	section    *io.SectionReader
+}
+
+func (sbu BlobUpload) blockSizeInBytes() int64 {
+	if sbu.BlockSizeInMB > 0 {
+		return int64(sbu.BlockSizeInMB) * 1024 * 1024
+	}
+	return defaultBlockSize
+}
+
+func (sbu BlobUpload) blockUploadFromSource(ctx context.Context, file io.ReaderAt, fileSize int64, blockSize int64) error {
+	workerCount := min(sbu.Parallelism*runtime.NumCPU(), int(max(maxBlockUploadMemory/blockSize, 1)))
+
+	blockList, contentMD5, err := sbu.storageBlobBlockSplit(file, fileSize, blockSize)
+	if err != nil {
+		return fmt.Errorf("splitting source file %q into blocks: %s", sbu.Source, err)
+	}
+
+	// verify the source file before any of it is uploaded, rather than committing a blob with unexpected contents
+	if sbu.ContentMD5 != "" && sbu.ContentMD5 != contentMD5 {
+		return fmt.Errorf("the MD5 of source file %q (%q) does not match `content_md5` (%q)", sbu.Source, contentMD5, sbu.ContentMD5)
+	}
+
+	// blocks which were uploaded by a previous attempt remain uncommitted on the blob, as the block IDs
+	// contain the MD5 of the block's contents, any with a matching ID and size don't need to be uploaded again
+	uploadedBlocks, err := sbu.uncommittedBlocks(ctx)
+	if err != nil {
+		return err
+	}
+
+	blocks := make(chan storageBlobBlock, len(blockList))
+	errors := make(chan error, len(blockList))
+	wg := &sync.WaitGroup{}
+
+	for _, block := range blockList {
+		if size, ok := uploadedBlocks[block.id]; ok && size == block.section.Size() {
+			continue
+		}
+		wg.Add(1)
+		blocks <- block
+	}
+	close(blocks)
+	log.Printf("[DEBUG] Uploading %d of %d blocks for Blob %q (Container %q)", len(blocks), len(blockList), sbu.BlobName, sbu.ContainerName)
+
+	for i := 0; i < workerCount; i++ {
+		go sbu.blobBlockUploadWorker(ctx, blobBlockUploadContext{
+			blocks: blocks,
+			errors: errors,
+			wg:     wg,
+		})
+	}
+
+	wg.Wait()
+
+	if len(errors) > 0 {
+		return fmt.Errorf("while uploading source file %q: %s", sbu.Source, <-errors)
+	}
+
+	blockIds := make([]blobs.BlockID, 0, len(blockList))
+	for _, block := range blockList {
+		blockIds = append(blockIds, blobs.BlockID{Value: block.id})
+	}
+
+	input := blobs.PutBlockListInput{
+		BlockList: blobs.BlockList{
+			LatestBlockIDs: blockIds,
+		},
+		ContentType: pointer.To(sbu.ContentType),
+		MetaData:    sbu.MetaData,
+	}
+	if sbu.ContentMD5 != "" {
+		input.ContentMD5 = pointer.To(sbu.ContentMD5)
+	}
+	if sbu.EncryptionScope != "" {
+		input.EncryptionScope = pointer.To(sbu.EncryptionScope)
+	}
+	if _, err := sbu.Client.PutBlockList(ctx, sbu.ContainerName, sbu.BlobName, input); err != nil {
+		return fmt.Errorf("PutBlockList: %s", err)
+	}
+
+	return nil
+}
+
+func (sbu BlobUpload) storageBlobBlockSplit(file io.ReaderAt, fileSize int64, blockSize int64) ([]storageBlobBlock, string, error) {
+	blockCount := (fileSize + blockSize - 1) / blockSize
+	if blockCount > maxBlockCount {
+		return nil, "", fmt.Errorf("the file would be split into %d blocks, which exceeds the maximum of %d blocks - `block_size_in_mb` should be increased", blockCount, maxBlockCount)
+	}
+
+	fileHash := md5.New()
+	blocks := make([]storageBlobBlock, 0, blockCount)
+	for i := int64(0); i < blockCount; i++ {
+		section := io.NewSectionReader(file, i*blockSize, min(blockSize, fileSize-i*blockSize))
+
+		blockHash := md5.New()
+		if _, err := io.Copy(io.MultiWriter(blockHash, fileHash), section); err != nil {
+			return nil, "", fmt.Errorf("reading block at offset %d: %s", i*blockSize, err)
+		}
+
+		// all Block IDs within a blob must be the same length
+		hash := blockHash.Sum(nil)
+		id := fmt.Sprintf("%05d-%s", i, hex.EncodeToString(hash))
+		blocks = append(blocks, storageBlobBlock{
+			id:         base64.StdEncoding.EncodeToString([]byte(id)),
+			contentMD5: base64.StdEncoding.EncodeToString(hash),
+			section:    section,
+		})
+	}
+
+	return blocks, base64.StdEncoding.EncodeToString(fileHash.Sum(nil)), nil
+}
+
+func (sbu BlobUpload) uncommittedBlocks(ctx context.Context) (map[string]int64, error) {
+	output := make(map[string]int64)
+
+	input := blobs.GetBlockListInput{
+		BlockListType: blobs.Uncommitted,
+	}
+	resp, err := sbu.Client.GetBlockList(ctx, sbu.ContainerName, sbu.BlobName, input)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return output, nil
+		}
+		return nil, fmt.Errorf("retrieving uncommitted blocks: %s", err)
+	}
+
+	for _, block := range resp.UncommittedBlocks.Blocks {
+		output[block.Name] = block.Size
+	}
+
+	return output, nil
+}
+
+type blobBlockUploadContext struct {
+	blocks chan storageBlobBlock
+	errors chan error
+	wg     *sync.WaitGroup
+}
+
+func (sbu BlobUpload) blobBlockUploadWorker(ctx context.Context, uploadCtx blobBlockUploadContext) {
+	for block := range uploadCtx.blocks {
+		if err := sbu.uploadBlock(ctx, block); err != nil {
+			uploadCtx.errors <- err
+		}
+		uploadCtx.wg.Done()
+	}
+}
+
+func (sbu BlobUpload) uploadBlock(ctx context.Context, block storageBlobBlock) error {
+	chunk := make([]byte, block.section.Size())
+	if _, err := block.section.ReadAt(chunk, 0); err != nil && err != io.EOF {
+		return fmt.Errorf("reading source file %q for block %q: %s", sbu.Source, block.id, err)
+	}
+
+	input := blobs.PutBlockInput{
+		BlockID:    block.id,
+		Content:    chunk,
+		ContentMD5: pointer.To(block.contentMD5),
+	}
+	if sbu.EncryptionScope != "" {
+		input.EncryptionScope = pointer.To(sbu.EncryptionScope)
+	}
+
+	var err error
+	for attempt := 1; attempt <= blockUploadAttempts; attempt++ {
+		var resp blobs.PutBlockResponse
+		if resp, err = sbu.Client.PutBlock(ctx, sbu.ContainerName, sbu.BlobName, input); err == nil {
+			// the service returns the MD5 of the block it received, which must match the block which was read
+			received := ""
+			if resp.HttpResponse != nil {
+				received = resp.HttpResponse.Header.Get("Content-MD5")
+			}
+			if received == "" || received == block.contentMD5 {
+				return nil
+			}
+			err = fmt.Errorf("the MD5 of the uploaded block (%q) does not match the source (%q)", received, block.contentMD5)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		log.Printf("[DEBUG] Attempt %d of %d to upload block %q for file %q failed: %s", attempt, blockUploadAttempts, block.id, sbu.Source, err)
+	}
+
+	return fmt.Errorf("writing block %q for file %q: %s", block.id, sbu.Source, err)
+}
+
 func convertHexToBase64Encoding(str string) (string, error) {
 	data, err := hex.DecodeString(str)
 	if err != nil {
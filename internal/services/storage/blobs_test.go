@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"bytes"
+	"crypto/md5"
+	"encoding/base64"
+	"testing"
+)
+
+func TestStorageBlobBlockSplit(t *testing.T) {
+	testcases := []struct {
+		Name           string
+		FileSize       int64
+		BlockSize      int64
+		ExpectedBlocks int
+		ExpectError    bool
+	}{
+		{
+			Name:           "Exact Multiple",
+			FileSize:       4096,
+			BlockSize:      1024,
+			ExpectedBlocks: 4,
+		},
+		{
+			Name:           "Trailing Partial Block",
+			FileSize:       4097,
+			BlockSize:      1024,
+			ExpectedBlocks: 5,
+		},
+		{
+			Name:        "Too Many Blocks",
+			FileSize:    maxBlockCount + 1,
+			BlockSize:   1,
+			ExpectError: true,
+		},
+	}
+
+	for _, tc := range testcases {
+		content := bytes.Repeat([]byte("a"), int(tc.FileSize))
+		blocks, contentMD5, err := BlobUpload{}.storageBlobBlockSplit(bytes.NewReader(content), tc.FileSize, tc.BlockSize)
+		if tc.ExpectError {
+			if err == nil {
+				t.Errorf("expected an error for %s but didn't get one", tc.Name)
+			}
+			continue
+		}
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %+v", tc.Name, err)
+		}
+
+		if len(blocks) != tc.ExpectedBlocks {
+			t.Errorf("expected %d blocks for %s but got %d", tc.ExpectedBlocks, tc.Name, len(blocks))
+		}
+
+		sum := md5.Sum(content)
+		if expected := base64.StdEncoding.EncodeToString(sum[:]); contentMD5 != expected {
+			t.Errorf("expected the content MD5 for %s to be %q but got %q", tc.Name, expected, contentMD5)
+		}
+
+		total := int64(0)
+		for _, block := range blocks {
+			if len(block.id) != len(blocks[0].id) {
+				t.Errorf("expected all block IDs for %s to be the same length", tc.Name)
+			}
+			total += block.section.Size()
+		}
+		if total != tc.FileSize {
+			t.Errorf("expected the blocks for %s to total %d bytes but got %d", tc.Name, tc.FileSize, total)
+		}
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 
 	storage_v2023_01_01 "github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2024-01-01/fileshares"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/cloudendpointresource"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/registeredserverresource"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storagesync/2020-03-01/serverendpointresource"
@@ -24,6 +25,9 @@ type Client struct {
 	StorageDomainSuffix string
 
 	ResourceManager *storage_v2023_01_01.Client
+	// FileSharesClient uses a newer API Version than the ResourceManager clients, since this exposes the
+	// provisioned IOPS and bandwidth of a Share
+	FileSharesClient *fileshares.FileSharesClient
 	// TODO: import the Storage Sync Meta Client and use that
 	SyncCloudEndpointsClient   *cloudendpointresource.CloudEndpointResourceClient
 	SyncGroupsClient           *syncgroupresource.SyncGroupResourceClient
@@ -50,6 +54,12 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 		return nil, fmt.Errorf("building ResourceManager clients: %+v", err)
 	}
 
+	fileSharesClient, err := fileshares.NewFileSharesClientWithBaseURI(o.Environment.ResourceManager)
+	if err != nil {
+		return nil, fmt.Errorf("building FileShares client: %+v", err)
+	}
+	o.Configure(fileSharesClient.Client, o.Authorizers.ResourceManager)
+
 	syncCloudEndpointsClient, err := cloudendpointresource.NewCloudEndpointResourceClientWithBaseURI(o.Environment.ResourceManager)
 	if err != nil {
 		return nil, fmt.Errorf("building CloudEndpoint client: %+v", err)
@@ -84,6 +94,7 @@ func NewClient(o *common.ClientOptions) (*Client, error) {
 	// (which should fix #2977) when the storage clients have been moved in here
 	client := Client{
 		ResourceManager:            resourceManager,
+		FileSharesClient:           fileSharesClient,
 		SyncCloudEndpointsClient:   syncCloudEndpointsClient,
 		SyncRegisteredServerClient: syncRegisteredServersClient,
 		SyncServerEndpointsClient:  syncServerEndpointClient,
@@ -0,0 +1,60 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package custompollers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2024-01-01/fileshares"
+	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
+)
+
+var _ pollers.PollerType = storageShareRestorePoller{}
+
+type storageShareRestorePoller struct {
+	id     fileshares.ShareId
+	client *fileshares.FileSharesClient
+}
+
+// NewStorageShareRestorePoller polls until a Share which has been restored from a soft-deleted version is available.
+func NewStorageShareRestorePoller(client *fileshares.FileSharesClient, id fileshares.ShareId) *storageShareRestorePoller {
+	return &storageShareRestorePoller{
+		id:     id,
+		client: client,
+	}
+}
+
+func (p storageShareRestorePoller) Poll(ctx context.Context) (*pollers.PollResult, error) {
+	inProgress := &pollers.PollResult{
+		PollInterval: 5 * time.Second,
+		Status:       pollers.PollingStatusInProgress,
+	}
+
+	// the Restore API returns before the Share is available again, during which time it either isn't found
+	// or is still reported as deleted
+	resp, err := p.client.Get(ctx, p.id, fileshares.DefaultGetOperationOptions())
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return inProgress, nil
+		}
+
+		return &pollers.PollResult{
+			PollInterval: 5 * time.Second,
+			Status:       pollers.PollingStatusFailed,
+		}, fmt.Errorf("retrieving %s: %+v", p.id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil && pointer.From(model.Properties.Deleted) {
+		return inProgress, nil
+	}
+
+	return &pollers.PollResult{
+		PollInterval: 5 * time.Second,
+		Status:       pollers.PollingStatusSucceeded,
+	}, nil
+}
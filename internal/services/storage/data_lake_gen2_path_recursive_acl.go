@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/go-azure-sdk/sdk/client"
+	"github.com/hashicorp/go-azure-sdk/sdk/odata"
+	"github.com/jackofallops/giovanni/storage/2023-11-03/datalakestore/paths"
+)
+
+// TODO: move this into Giovanni
+
+type dataLakeGen2RecursiveAccessControlInput struct {
+	ACL               string
+	Mode              string
+	BatchSize         int
+	ContinueOnFailure bool
+}
+
+type dataLakeGen2RecursiveAccessControlResult struct {
+	DirectoriesSuccessful int64                                     `json:"directoriesSuccessful"`
+	FilesSuccessful       int64                                     `json:"filesSuccessful"`
+	FailureCount          int64                                     `json:"failureCount"`
+	FailedEntries         []dataLakeGen2RecursiveAccessControlEntry `json:"failedEntries"`
+}
+
+type dataLakeGen2RecursiveAccessControlEntry struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	ErrorMessage string `json:"errorMessage"`
+}
+
+// setDataLakeGen2AccessControlRecursive applies the ACL to the Path and everything beneath it. The service processes
+// at most `BatchSize` entries per request and returns a continuation token until the whole tree has been updated,
+// which means deep directory trees are updated across many short requests rather than a single long-running one.
+func setDataLakeGen2AccessControlRecursive(ctx context.Context, pathsClient *paths.Client, fileSystemName, path string, input dataLakeGen2RecursiveAccessControlInput) error {
+	var directories, files, failureCount int64
+	failedEntries := make([]dataLakeGen2RecursiveAccessControlEntry, 0)
+
+	continuation := ""
+	for batch := 1; ; batch++ {
+		opts := client.RequestOptions{
+			ContentType: "application/json; charset=utf-8",
+			ExpectedStatusCodes: []int{
+				http.StatusOK,
+			},
+			HttpMethod: http.MethodPatch,
+			OptionsObject: setAccessControlRecursiveOptions{
+				input:        input,
+				continuation: continuation,
+			},
+			Path: fmt.Sprintf("/%s/%s", fileSystemName, path),
+		}
+
+		req, err := pathsClient.Client.NewRequest(ctx, opts)
+		if err != nil {
+			return fmt.Errorf("building request: %+v", err)
+		}
+
+		resp, err := req.Execute(ctx)
+		if err != nil {
+			return fmt.Errorf("executing request for batch %d: %+v", batch, err)
+		}
+
+		var result dataLakeGen2RecursiveAccessControlResult
+		if err := resp.Unmarshal(&result); err != nil {
+			return fmt.Errorf("unmarshaling response for batch %d: %+v", batch, err)
+		}
+
+		directories += result.DirectoriesSuccessful
+		files += result.FilesSuccessful
+		failureCount += result.FailureCount
+		failedEntries = append(failedEntries, result.FailedEntries...)
+		log.Printf("[DEBUG] Recursive ACL for Path %q in File System %q: batch %d applied to %d directories and %d files so far (%d failures)", path, fileSystemName, batch, directories, files, failureCount)
+
+		// unless `forceFlag` is set, the service stops at the first batch containing a failure
+		if result.FailureCount > 0 && !input.ContinueOnFailure {
+			return fmt.Errorf("applying the ACL failed for %d entries: %s", result.FailureCount, formatDataLakeGen2RecursiveAccessControlFailures(result.FailedEntries))
+		}
+
+		continuation = resp.Header.Get("x-ms-continuation")
+		if continuation == "" {
+			break
+		}
+	}
+
+	log.Printf("[DEBUG] Recursive ACL for Path %q in File System %q applied to %d directories and %d files", path, fileSystemName, directories, files)
+
+	// when continuing on failure the remaining batches are still applied, but the failures must still be surfaced
+	if failureCount > 0 {
+		return fmt.Errorf("applying the ACL failed for %d entries (applied to %d directories and %d files): %s", failureCount, directories, files, formatDataLakeGen2RecursiveAccessControlFailures(failedEntries))
+	}
+
+	return nil
+}
+
+func formatDataLakeGen2RecursiveAccessControlFailures(input []dataLakeGen2RecursiveAccessControlEntry) string {
+	// the failures can span many batches, so only the first few are included to keep the error readable
+	const maxFailures = 10
+
+	failures := make([]string, 0)
+	for i, entry := range input {
+		if i == maxFailures {
+			failures = append(failures, fmt.Sprintf("and %d more", len(input)-maxFailures))
+			break
+		}
+		failures = append(failures, fmt.Sprintf("%s %q: %s", entry.Type, entry.Name, entry.ErrorMessage))
+	}
+
+	return strings.Join(failures, ", ")
+}
+
+type setAccessControlRecursiveOptions struct {
+	input        dataLakeGen2RecursiveAccessControlInput
+	continuation string
+}
+
+func (s setAccessControlRecursiveOptions) ToHeaders() *client.Headers {
+	headers := &client.Headers{}
+	headers.Append("x-ms-acl", s.input.ACL)
+	return headers
+}
+
+func (s setAccessControlRecursiveOptions) ToOData() *odata.Query {
+	return nil
+}
+
+func (s setAccessControlRecursiveOptions) ToQuery() *client.QueryParams {
+	out := &client.QueryParams{}
+	out.Append("action", "setAccessControlRecursive")
+	out.Append("mode", s.input.Mode)
+	out.Append("forceFlag", strconv.FormatBool(s.input.ContinueOnFailure))
+	if s.input.BatchSize > 0 {
+		out.Append("maxRecords", strconv.Itoa(s.input.BatchSize))
+	}
+	if s.continuation != "" {
+		out.Append("continuation", s.continuation)
+	}
+	return out
+}
@@ -0,0 +1,85 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StorageAccountManagementPolicyRuleId struct {
+	SubscriptionId       string
+	ResourceGroup        string
+	StorageAccountName   string
+	ManagementPolicyName string
+	RuleName             string
+}
+
+func NewStorageAccountManagementPolicyRuleID(subscriptionId, resourceGroup, storageAccountName, managementPolicyName, ruleName string) StorageAccountManagementPolicyRuleId {
+	return StorageAccountManagementPolicyRuleId{
+		SubscriptionId:       subscriptionId,
+		ResourceGroup:        resourceGroup,
+		StorageAccountName:   storageAccountName,
+		ManagementPolicyName: managementPolicyName,
+		RuleName:             ruleName,
+	}
+}
+
+func (id StorageAccountManagementPolicyRuleId) String() string {
+	segments := []string{
+		fmt.Sprintf("Rule Name %q", id.RuleName),
+		fmt.Sprintf("Management Policy Name %q", id.ManagementPolicyName),
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Account Management Policy Rule", segmentsStr)
+}
+
+func (id StorageAccountManagementPolicyRuleId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/managementPolicies/%s/rules/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.ManagementPolicyName, id.RuleName)
+}
+
+// StorageAccountManagementPolicyRuleID parses a StorageAccountManagementPolicyRule ID into an StorageAccountManagementPolicyRuleId struct
+func StorageAccountManagementPolicyRuleID(input string) (*StorageAccountManagementPolicyRuleId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an StorageAccountManagementPolicyRule ID: %+v", input, err)
+	}
+
+	resourceId := StorageAccountManagementPolicyRuleId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.ManagementPolicyName, err = id.PopSegment("managementPolicies"); err != nil {
+		return nil, err
+	}
+	if resourceId.RuleName, err = id.PopSegment("rules"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
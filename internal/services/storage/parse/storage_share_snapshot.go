@@ -0,0 +1,91 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package parse
+
+// NOTE: this file is generated via 'go:generate' - manual changes will be overwritten
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/resourceids"
+)
+
+type StorageShareSnapshotId struct {
+	SubscriptionId     string
+	ResourceGroup      string
+	StorageAccountName string
+	FileServiceName    string
+	ShareName          string
+	SnapshotName       string
+}
+
+func NewStorageShareSnapshotID(subscriptionId, resourceGroup, storageAccountName, fileServiceName, shareName, snapshotName string) StorageShareSnapshotId {
+	return StorageShareSnapshotId{
+		SubscriptionId:     subscriptionId,
+		ResourceGroup:      resourceGroup,
+		StorageAccountName: storageAccountName,
+		FileServiceName:    fileServiceName,
+		ShareName:          shareName,
+		SnapshotName:       snapshotName,
+	}
+}
+
+func (id StorageShareSnapshotId) String() string {
+	segments := []string{
+		fmt.Sprintf("Snapshot Name %q", id.SnapshotName),
+		fmt.Sprintf("Share Name %q", id.ShareName),
+		fmt.Sprintf("File Service Name %q", id.FileServiceName),
+		fmt.Sprintf("Storage Account Name %q", id.StorageAccountName),
+		fmt.Sprintf("Resource Group %q", id.ResourceGroup),
+	}
+	segmentsStr := strings.Join(segments, " / ")
+	return fmt.Sprintf("%s: (%s)", "Storage Share Snapshot", segmentsStr)
+}
+
+func (id StorageShareSnapshotId) ID() string {
+	fmtString := "/subscriptions/%s/resourceGroups/%s/providers/Microsoft.Storage/storageAccounts/%s/fileServices/%s/shares/%s/snapshots/%s"
+	return fmt.Sprintf(fmtString, id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.FileServiceName, id.ShareName, id.SnapshotName)
+}
+
+// StorageShareSnapshotID parses a StorageShareSnapshot ID into an StorageShareSnapshotId struct
+func StorageShareSnapshotID(input string) (*StorageShareSnapshotId, error) {
+	id, err := resourceids.ParseAzureResourceID(input)
+	if err != nil {
+		return nil, fmt.Errorf("parsing %q as an StorageShareSnapshot ID: %+v", input, err)
+	}
+
+	resourceId := StorageShareSnapshotId{
+		SubscriptionId: id.SubscriptionID,
+		ResourceGroup:  id.ResourceGroup,
+	}
+
+	if resourceId.SubscriptionId == "" {
+		return nil, errors.New("ID was missing the 'subscriptions' element")
+	}
+
+	if resourceId.ResourceGroup == "" {
+		return nil, errors.New("ID was missing the 'resourceGroups' element")
+	}
+
+	if resourceId.StorageAccountName, err = id.PopSegment("storageAccounts"); err != nil {
+		return nil, err
+	}
+	if resourceId.FileServiceName, err = id.PopSegment("fileServices"); err != nil {
+		return nil, err
+	}
+	if resourceId.ShareName, err = id.PopSegment("shares"); err != nil {
+		return nil, err
+	}
+	if resourceId.SnapshotName, err = id.PopSegment("snapshots"); err != nil {
+		return nil, err
+	}
+
+	if err := id.ValidateNoEmptySegments(input); err != nil {
+		return nil, err
+	}
+
+	return &resourceId, nil
+}
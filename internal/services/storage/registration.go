@@ -59,6 +59,7 @@ func (r Registration) SupportedResources() map[string]*pluginsdk.Resource {
 		"azurerm_storage_data_lake_gen2_filesystem":    resourceStorageDataLakeGen2FileSystem(),
 		"azurerm_storage_data_lake_gen2_path":          resourceStorageDataLakeGen2Path(),
 		"azurerm_storage_management_policy":            resourceStorageManagementPolicy(),
+		"azurerm_storage_management_policy_rule":       resourceStorageManagementPolicyRule(),
 		"azurerm_storage_object_replication":           resourceStorageObjectReplication(),
 		"azurerm_storage_queue":                        resourceStorageQueue(),
 		"azurerm_storage_share":                        resourceStorageShare(),
@@ -77,6 +78,7 @@ func (r Registration) DataSources() []sdk.DataSource {
 		storageTableDataSource{},
 		storageTableEntitiesDataSource{},
 		storageContainersDataSource{},
+		storageShareSnapshotsDataSource{},
 	}
 }
 
@@ -86,6 +88,7 @@ func (r Registration) Resources() []sdk.Resource {
 		AccountStaticWebsiteResource{},
 		LocalUserResource{},
 		StorageContainerImmutabilityPolicyResource{},
+		StorageShareSnapshotResource{},
 		SyncServerEndpointResource{},
 	}
 }
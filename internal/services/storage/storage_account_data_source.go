@@ -521,6 +521,14 @@ func dataSourceStorageAccount() *pluginsdk.Resource {
 										Type:     pluginsdk.TypeString,
 										Computed: true,
 									},
+									"account_type": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
+									"sam_account_name": {
+										Type:     pluginsdk.TypeString,
+										Computed: true,
+									},
 								},
 							},
 						},
@@ -7,6 +7,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 	"time"
 
@@ -16,6 +17,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/localusers"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
 	computevalidate "github.com/hashicorp/terraform-provider-azurerm/internal/services/compute/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/suppress"
@@ -25,7 +27,10 @@ import (
 
 type LocalUserResource struct{}
 
-var _ sdk.ResourceWithUpdate = LocalUserResource{}
+var (
+	_ sdk.ResourceWithUpdate        = LocalUserResource{}
+	_ sdk.ResourceWithCustomizeDiff = LocalUserResource{}
+)
 
 type PermissionsModel struct {
 	Create bool `tfschema:"create"`
@@ -49,6 +54,7 @@ type LocalUserModel struct {
 	Password           string                  `tfschema:"password"`
 	PermissionScope    []PermissionScopeModel  `tfschema:"permission_scope"`
 	Sid                string                  `tfschema:"sid"`
+	SftpEndpoint       string                  `tfschema:"sftp_endpoint"`
 	SshAuthorizedKey   []SshAuthorizedKeyModel `tfschema:"ssh_authorized_key"`
 	SshKeyEnabled      bool                    `tfschema:"ssh_key_enabled"`
 	SshPasswordEnabled bool                    `tfschema:"ssh_password_enabled"`
@@ -179,6 +185,10 @@ func (r LocalUserResource) Attributes() map[string]*pluginsdk.Schema {
 			Sensitive: true,
 			Computed:  true,
 		},
+		"sftp_endpoint": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
 	}
 }
 
@@ -203,7 +213,13 @@ func (r LocalUserResource) CustomizeDiff() sdk.ResourceFunc {
 					return err
 				}
 			}
-			return nil
+
+			var plan LocalUserModel
+			if err := metadata.DecodeDiff(&plan); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			return r.validateLocalUser(plan, diff)
 		},
 		Timeout: 5 * time.Minute,
 	}
@@ -220,15 +236,6 @@ func (r LocalUserResource) Create() sdk.ResourceFunc {
 				return fmt.Errorf("decoding %+v", err)
 			}
 
-			// Sanity checks on input
-			if plan.SshKeyEnabled != (len(plan.SshAuthorizedKey) != 0) {
-				if plan.SshKeyEnabled {
-					return errors.New("`ssh_authorized_key` should be specified when `ssh_key_enabled` is enabled")
-				} else {
-					return errors.New("`ssh_authorized_key` should not be specified when `ssh_key_enabled` is disabled")
-				}
-			}
-
 			accountId, err := commonids.ParseStorageAccountID(plan.StorageAccountId)
 			if err != nil {
 				return err
@@ -290,7 +297,7 @@ func (r LocalUserResource) Read() sdk.ResourceFunc {
 		Timeout: 5 * time.Minute,
 
 		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
-			client := metadata.Client.Storage.ResourceManager.LocalUsers
+			localUsersClient := metadata.Client.Storage.ResourceManager.LocalUsers
 			id, err := localusers.ParseLocalUserID(metadata.ResourceData.Id())
 			if err != nil {
 				return err
@@ -301,7 +308,7 @@ func (r LocalUserResource) Read() sdk.ResourceFunc {
 				return err
 			}
 
-			existing, err := client.Get(ctx, *id)
+			existing, err := localUsersClient.Get(ctx, *id)
 			if err != nil {
 				if response.WasNotFound(existing.HttpResponse) {
 					return metadata.MarkAsGone(id)
@@ -336,6 +343,23 @@ func (r LocalUserResource) Read() sdk.ResourceFunc {
 				}
 			}
 
+			// SFTP is served from the Blob endpoint of the Storage Account, which differs based on the account's DNS endpoint type
+			account, err := metadata.Client.Storage.FindAccount(ctx, id.SubscriptionId, id.StorageAccountName)
+			if err != nil {
+				return fmt.Errorf("retrieving Storage Account %q for %s: %+v", id.StorageAccountName, id, err)
+			}
+			if account != nil {
+				endpoint, err := account.DataPlaneEndpoint(client.EndpointTypeBlob)
+				if err != nil {
+					return err
+				}
+				u, err := url.Parse(*endpoint)
+				if err != nil {
+					return fmt.Errorf("parsing Blob endpoint %q: %+v", *endpoint, err)
+				}
+				model.SftpEndpoint = u.Hostname()
+			}
+
 			return metadata.Encode(&model)
 		},
 	}
@@ -387,9 +411,9 @@ func (r LocalUserResource) Update() sdk.ResourceFunc {
 				props.HasSshKey = &plan.SshKeyEnabled
 			}
 
-			if metadata.ResourceData.HasChange("ssh_authorized_key") {
-				props.SshAuthorizedKeys = r.expandSSHAuthorizedKeys(plan.SshAuthorizedKey)
-			}
+			// The API doesn't return the SSH authorized keys, so these must always be sent, otherwise they're removed
+			// when any other property is updated. This also allows the keys to be rotated without recreating the user.
+			props.SshAuthorizedKeys = r.expandSSHAuthorizedKeys(plan.SshAuthorizedKey)
 
 			if metadata.ResourceData.HasChange("ssh_password_enabled") {
 				props.HasSshPassword = &plan.SshPasswordEnabled
@@ -447,6 +471,48 @@ func (r LocalUserResource) Delete() sdk.ResourceFunc {
 	}
 }
 
+// validateLocalUser validates the planned Local User, skipping any values which aren't known until apply
+func (r LocalUserResource) validateLocalUser(input LocalUserModel, diff *pluginsdk.ResourceDiff) error {
+	if diff.NewValueKnown("ssh_key_enabled") && diff.NewValueKnown("ssh_authorized_key") && input.SshKeyEnabled != (len(input.SshAuthorizedKey) != 0) {
+		if input.SshKeyEnabled {
+			return errors.New("`ssh_authorized_key` should be specified when `ssh_key_enabled` is enabled")
+		} else {
+			return errors.New("`ssh_authorized_key` should not be specified when `ssh_key_enabled` is disabled")
+		}
+	}
+
+	scopes := make(map[string]struct{})
+	for i, scope := range input.PermissionScope {
+		if !diff.NewValueKnown(fmt.Sprintf("permission_scope.%d.service", i)) || !diff.NewValueKnown(fmt.Sprintf("permission_scope.%d.resource_name", i)) {
+			continue
+		}
+
+		// the `resource_name` is a Container for the `blob` service, or a File Share for the `file` service
+		validateFunc := validate.StorageContainerName
+		if scope.Service == "file" {
+			validateFunc = validate.StorageShareName
+		}
+		if _, errs := validateFunc(scope.ResourceName, "resource_name"); len(errs) > 0 {
+			return fmt.Errorf("`resource_name` %q is invalid for the `%s` service: %+v", scope.ResourceName, scope.Service, errs[0])
+		}
+
+		key := fmt.Sprintf("%s/%s", scope.Service, strings.ToLower(scope.ResourceName))
+		if _, ok := scopes[key]; ok {
+			return fmt.Errorf("only a single `permission_scope` can be specified for the `%s` service with the `resource_name` %q", scope.Service, scope.ResourceName)
+		}
+		scopes[key] = struct{}{}
+
+		if len(scope.Permissions) == 0 {
+			continue
+		}
+		if permissions := scope.Permissions[0]; !permissions.Read && !permissions.Write && !permissions.Delete && !permissions.List && !permissions.Create {
+			return fmt.Errorf("at least one permission must be enabled within the `permission_scope` for the `%s` service with the `resource_name` %q", scope.Service, scope.ResourceName)
+		}
+	}
+
+	return nil
+}
+
 func (r LocalUserResource) expandPermissionScopes(input []PermissionScopeModel) *[]localusers.PermissionScope {
 	if len(input) == 0 {
 		return nil
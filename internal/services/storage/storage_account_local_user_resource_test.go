@@ -117,6 +117,32 @@ func TestAccLocalUser_passwordAndSSHKey(t *testing.T) {
 	})
 }
 
+func TestAccLocalUser_rotateSSHKey(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_account_local_user", "test")
+	r := LocalUserResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.passwordAndSSHKey(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("password").IsNotEmpty(),
+				check.That(data.ResourceName).Key("sftp_endpoint").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("password", "ssh_authorized_key"),
+		{
+			Config: r.passwordAndSSHKeyRotated(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("password").IsNotEmpty(),
+				check.That(data.ResourceName).Key("sftp_endpoint").IsNotEmpty(),
+			),
+		},
+		data.ImportStep("password", "ssh_authorized_key"),
+	})
+}
+
 func TestAccLocalUser_homeDirectory(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_account_local_user", "test")
 	r := LocalUserResource{}
@@ -309,6 +335,24 @@ resource "azurerm_storage_account_local_user" "test" {
 `, template)
 }
 
+func (r LocalUserResource) passwordAndSSHKeyRotated(data acceptance.TestData) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_account_local_user" "test" {
+  name                 = "user"
+  storage_account_id   = azurerm_storage_account.test.id
+  ssh_key_enabled      = true
+  ssh_password_enabled = true
+  ssh_authorized_key {
+    description = "key2"
+    key         = local.second_public_key
+  }
+}
+`, template)
+}
+
 func (r LocalUserResource) requiresImport(data acceptance.TestData) string {
 	template := r.passwordOnly(data)
 	return fmt.Sprintf(`
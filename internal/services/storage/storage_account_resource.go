@@ -196,6 +196,18 @@ func resourceStorageAccount() *pluginsdk.Resource {
 										Optional:     true,
 										ValidateFunc: validation.StringIsNotEmpty,
 									},
+
+									"account_type": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringInSlice(storageaccounts.PossibleValuesForAccountType(), false),
+									},
+
+									"sam_account_name": {
+										Type:         pluginsdk.TypeString,
+										Optional:     true,
+										ValidateFunc: validation.StringIsNotEmpty,
+									},
 								},
 							},
 						},
@@ -2597,6 +2609,12 @@ func expandAccountActiveDirectoryProperties(input []interface{}) *storageaccount
 	if v := m["netbios_domain_name"]; v != "" {
 		output.NetBiosDomainName = utils.String(v.(string))
 	}
+	if v := m["account_type"]; v != "" {
+		output.AccountType = pointer.To(storageaccounts.AccountType(v.(string)))
+	}
+	if v := m["sam_account_name"]; v != "" {
+		output.SamAccountName = utils.String(v.(string))
+	}
 	return output
 }
 
@@ -2610,6 +2628,8 @@ func flattenAccountActiveDirectoryProperties(input *storageaccounts.ActiveDirect
 			"forest_name":         pointer.From(input.ForestName),
 			"netbios_domain_name": pointer.From(input.NetBiosDomainName),
 			"storage_sid":         pointer.From(input.AzureStorageSid),
+			"account_type":        string(pointer.From(input.AccountType)),
+			"sam_account_name":    pointer.From(input.SamAccountName),
 		})
 	}
 	return output
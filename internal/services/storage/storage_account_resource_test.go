@@ -3703,6 +3703,8 @@ resource "azurerm_storage_account" "test" {
       domain_guid         = "13a20c9a-d491-47e6-8a39-299e7a32ea27"
       forest_name         = "adtest2.com"
       netbios_domain_name = "adtest2.com"
+      account_type        = "Computer"
+      sam_account_name    = "adtest2storage"
     }
     default_share_level_permission = "StorageFileDataSmbShareContributor"
   }
@@ -152,7 +152,6 @@ func resourceStorageBlob() *pluginsdk.Resource {
 			},
 
 			"parallelism": {
-				// TODO: @tombuildsstuff - a note this only works for Page blobs
 				Type:         pluginsdk.TypeInt,
 				Optional:     true,
 				Default:      8,
@@ -160,6 +159,13 @@ func resourceStorageBlob() *pluginsdk.Resource {
 				ValidateFunc: validation.IntAtLeast(1),
 			},
 
+			"block_size_in_mb": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntBetween(1, 4000),
+			},
+
 			"metadata": MetaDataComputedSchema(),
 		},
 
@@ -236,6 +242,7 @@ func resourceStorageBlobCreate(d *pluginsdk.ResourceData, meta interface{}) erro
 		Client:        blobsClient,
 
 		BlobType:      d.Get("type").(string),
+		BlockSizeInMB: d.Get("block_size_in_mb").(int),
 		CacheControl:  d.Get("cache_control").(string),
 		ContentType:   d.Get("content_type").(string),
 		ContentMD5:    contentMD5,
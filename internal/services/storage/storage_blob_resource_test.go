@@ -244,6 +244,30 @@ func TestAccStorageBlob_blockFromLocalFile(t *testing.T) {
 	})
 }
 
+func TestAccStorageBlob_blockFromLocalFileWithBlockSize(t *testing.T) {
+	sourceBlob, err := os.CreateTemp("", "")
+	if err != nil {
+		t.Fatalf("Failed to create local source blob file")
+	}
+
+	if err := populateTempFile(sourceBlob); err != nil {
+		t.Fatalf("Error populating temp file: %s", err)
+	}
+	data := acceptance.BuildTestData(t, "azurerm_storage_blob", "test")
+	r := StorageBlobResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.blockFromLocalBlobWithBlockSize(data, sourceBlob.Name()),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				data.CheckWithClient(r.blobMatchesFile(blobs.BlockBlob, sourceBlob.Name())),
+			),
+		},
+		data.ImportStep("block_size_in_mb", "parallelism", "size", "source", "type"),
+	})
+}
+
 func TestAccStorageBlob_blockFromLocalFileWithContentMd5(t *testing.T) {
 	sourceBlob, err := os.CreateTemp("", "")
 	if err != nil {
@@ -918,6 +942,28 @@ resource "azurerm_storage_blob" "test" {
 `, template, fileName)
 }
 
+func (r StorageBlobResource) blockFromLocalBlobWithBlockSize(data acceptance.TestData, fileName string) string {
+	template := r.template(data, "private")
+	return fmt.Sprintf(`
+%s
+
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_blob" "test" {
+  name                   = "example.vhd"
+  storage_account_name   = azurerm_storage_account.test.name
+  storage_container_name = azurerm_storage_container.test.name
+  type                   = "Block"
+  source                 = "%s"
+  content_md5            = "${filemd5("%s")}"
+  block_size_in_mb       = 8
+  parallelism            = 4
+}
+`, template, fileName, fileName)
+}
+
 func (r StorageBlobResource) contentMd5ForLocalFile(data acceptance.TestData, fileName string) string {
 	template := r.template(data, "blob")
 	return fmt.Sprintf(`
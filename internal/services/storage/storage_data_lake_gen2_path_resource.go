@@ -150,6 +150,36 @@ func resourceStorageDataLakeGen2Path() *pluginsdk.Resource {
 					},
 				},
 			},
+
+			"recursive_acl": {
+				Type:         pluginsdk.TypeList,
+				Optional:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"ace"},
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"mode": {
+							Type:         pluginsdk.TypeString,
+							Optional:     true,
+							Default:      "modify",
+							ValidateFunc: validation.StringInSlice([]string{"modify", "set"}, false),
+						},
+
+						"batch_size": {
+							Type:         pluginsdk.TypeInt,
+							Optional:     true,
+							Default:      2000,
+							ValidateFunc: validation.IntBetween(1, 2000),
+						},
+
+						"continue_on_failure_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Optional: true,
+							Default:  false,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -242,6 +272,9 @@ func resourceStorageDataLakeGen2PathCreate(d *pluginsdk.ResourceData, meta inter
 		return fmt.Errorf("creating %s: %v", id, err)
 	}
 
+	// the ID is set prior to applying the access control so that the Path is tracked (and tainted) should this fail
+	d.SetId(id.ID())
+
 	if acl != nil || owner != nil || group != nil {
 		var aclString *string
 		if acl != nil {
@@ -258,7 +291,11 @@ func resourceStorageDataLakeGen2PathCreate(d *pluginsdk.ResourceData, meta inter
 		}
 	}
 
-	d.SetId(id.ID())
+	if v := d.Get("recursive_acl").([]interface{}); len(v) > 0 && acl != nil {
+		if err := setDataLakeGen2AccessControlRecursive(ctx, dataPlanePathsClient, filesystemName, path, expandDataLakeGen2RecursiveAccessControl(v, acl.String())); err != nil {
+			return fmt.Errorf("setting recursive access control for %s: %+v", id, err)
+		}
+	}
 
 	return resourceStorageDataLakeGen2PathRead(d, meta)
 }
@@ -324,6 +361,14 @@ func resourceStorageDataLakeGen2PathUpdate(d *pluginsdk.ResourceData, meta inter
 		}
 	}
 
+	if v := d.Get("recursive_acl").([]interface{}); len(v) > 0 && acl != nil && d.HasChanges("ace", "recursive_acl") {
+		if err := setDataLakeGen2AccessControlRecursive(ctx, dataPlanePathsClient, id.FileSystemName, path, expandDataLakeGen2RecursiveAccessControl(v, acl.String())); err != nil {
+			// the previous values are retained so that applying the access control is retried on the next apply
+			d.Partial(true)
+			return fmt.Errorf("setting recursive access control for %s: %+v", id, err)
+		}
+	}
+
 	return resourceStorageDataLakeGen2PathRead(d, meta)
 }
 
@@ -426,3 +471,13 @@ func resourceStorageDataLakeGen2PathDelete(d *pluginsdk.ResourceData, meta inter
 
 	return nil
 }
+
+func expandDataLakeGen2RecursiveAccessControl(input []interface{}, acl string) dataLakeGen2RecursiveAccessControlInput {
+	raw := input[0].(map[string]interface{})
+	return dataLakeGen2RecursiveAccessControlInput{
+		ACL:               acl,
+		Mode:              raw["mode"].(string),
+		BatchSize:         raw["batch_size"].(int),
+		ContinueOnFailure: raw["continue_on_failure_enabled"].(bool),
+	}
+}
@@ -86,6 +86,28 @@ func TestAccStorageDataLakeGen2Path_withSimpleACL(t *testing.T) {
 	})
 }
 
+func TestAccStorageDataLakeGen2Path_withRecursiveACL(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path", "test")
+	r := StorageDataLakeGen2PathResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.withRecursiveACL(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.withRecursiveACL(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("recursive_acl"),
+	})
+}
+
 func TestAccStorageDataLakeGen2Path_withACLWithSpecificUserAndDefaults(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_storage_data_lake_gen2_path", "test")
 	r := StorageDataLakeGen2PathResource{}
@@ -221,6 +243,59 @@ resource "azurerm_storage_data_lake_gen2_path" "test" {
 `, template)
 }
 
+func (r StorageDataLakeGen2PathResource) withRecursiveACL(data acceptance.TestData, recursive bool) string {
+	template := r.template(data)
+	recursiveAcl := ""
+	if recursive {
+		recursiveAcl = `
+  recursive_acl {
+    mode       = "modify"
+    batch_size = 1
+  }
+`
+	}
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_role_assignment" "storage_blob_owner" {
+  role_definition_name = "Storage Blob Data Owner"
+  scope                = azurerm_resource_group.test.id
+  principal_id         = data.azurerm_client_config.current.object_id
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "testpath"
+  resource           = "directory"
+  ace {
+    type        = "user"
+    permissions = "rwx"
+  }
+  ace {
+    type        = "group"
+    permissions = "r-x"
+  }
+  ace {
+    type        = "other"
+    permissions = "--x"
+  }
+%s
+}
+
+resource "azurerm_storage_data_lake_gen2_path" "child" {
+  storage_account_id = azurerm_storage_account.test.id
+  filesystem_name    = azurerm_storage_data_lake_gen2_filesystem.test.name
+  path               = "${azurerm_storage_data_lake_gen2_path.test.path}/child"
+  resource           = "directory"
+
+  lifecycle {
+    ignore_changes = [ace]
+  }
+}
+`, template, recursiveAcl)
+}
+
 func (r StorageDataLakeGen2PathResource) withSimpleACLUpdated(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
@@ -14,6 +14,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/managementpolicies"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
@@ -52,249 +53,253 @@ func resourceStorageManagementPolicy() *pluginsdk.Resource {
 				Optional: true,
 				MinItems: 1,
 				Elem: &pluginsdk.Resource{
-					Schema: map[string]*pluginsdk.Schema{
-						"name": {
-							Type:         pluginsdk.TypeString,
-							Required:     true,
-							ValidateFunc: validation.StringIsNotEmpty,
+					Schema: storageManagementPolicyRuleSchema(),
+				},
+			},
+		},
+	}
+}
+
+func storageManagementPolicyRuleSchema() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"name": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: validation.StringIsNotEmpty,
+		},
+		"enabled": {
+			Type:     pluginsdk.TypeBool,
+			Required: true,
+		},
+		"filters": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"blob_types": {
+						Type:     pluginsdk.TypeSet,
+						Required: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+							ValidateFunc: validation.StringInSlice([]string{
+								"blockBlob",
+								"appendBlob",
+							}, false),
 						},
-						"enabled": {
-							Type:     pluginsdk.TypeBool,
-							Required: true,
+						Set: pluginsdk.HashString,
+					},
+					"prefix_match": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
+						Set:      pluginsdk.HashString,
+					},
+					"match_blob_index_tag": {
+						Type:     pluginsdk.TypeSet,
+						Optional: true,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"name": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validate.StorageBlobIndexTagName,
+								},
+
+								"operation": {
+									Type:     pluginsdk.TypeString,
+									Optional: true,
+									ValidateFunc: validation.StringInSlice([]string{
+										"==",
+									}, false),
+									Default: "==",
+								},
+
+								"value": {
+									Type:         pluginsdk.TypeString,
+									Required:     true,
+									ValidateFunc: validate.StorageBlobIndexTagValue,
+								},
+							},
 						},
-						"filters": {
-							Type:     pluginsdk.TypeList,
-							Required: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									"blob_types": {
-										Type:     pluginsdk.TypeSet,
-										Required: true,
-										Elem: &pluginsdk.Schema{
-											Type: pluginsdk.TypeString,
-											ValidateFunc: validation.StringInSlice([]string{
-												"blockBlob",
-												"appendBlob",
-											}, false),
-										},
-										Set: pluginsdk.HashString,
-									},
-									"prefix_match": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem:     &pluginsdk.Schema{Type: pluginsdk.TypeString},
-										Set:      pluginsdk.HashString,
-									},
-									"match_blob_index_tag": {
-										Type:     pluginsdk.TypeSet,
-										Optional: true,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"name": {
-													Type:         pluginsdk.TypeString,
-													Required:     true,
-													ValidateFunc: validate.StorageBlobIndexTagName,
-												},
-
-												"operation": {
-													Type:     pluginsdk.TypeString,
-													Optional: true,
-													ValidateFunc: validation.StringInSlice([]string{
-														"==",
-													}, false),
-													Default: "==",
-												},
-
-												"value": {
-													Type:         pluginsdk.TypeString,
-													Required:     true,
-													ValidateFunc: validate.StorageBlobIndexTagValue,
-												},
-											},
-										},
-									},
+					},
+				},
+			},
+		},
+		// lintignore:XS003
+		"actions": {
+			Type:     pluginsdk.TypeList,
+			Required: true,
+			MaxItems: 1,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					// lintignore:XS003
+					"base_blob": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"tier_to_cool_after_days_since_modification_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"tier_to_cool_after_days_since_last_access_time_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"auto_tier_to_hot_from_cool_enabled": {
+									Type:     pluginsdk.TypeBool,
+									Optional: true,
+								},
+								"tier_to_cool_after_days_since_creation_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"tier_to_archive_after_days_since_modification_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"tier_to_archive_after_days_since_last_access_time_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"tier_to_archive_after_days_since_last_tier_change_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"tier_to_archive_after_days_since_creation_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"tier_to_cold_after_days_since_modification_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"tier_to_cold_after_days_since_last_access_time_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"tier_to_cold_after_days_since_creation_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"delete_after_days_since_modification_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"delete_after_days_since_last_access_time_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"delete_after_days_since_creation_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+							},
+						},
+					},
+					// lintignore:XS003
+					"snapshot": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"change_tier_to_archive_after_days_since_creation": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"tier_to_archive_after_days_since_last_tier_change_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"change_tier_to_cool_after_days_since_creation": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"tier_to_cold_after_days_since_creation_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"delete_after_days_since_creation_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
 								},
 							},
 						},
-						// lintignore:XS003
-						"actions": {
-							Type:     pluginsdk.TypeList,
-							Required: true,
-							MaxItems: 1,
-							Elem: &pluginsdk.Resource{
-								Schema: map[string]*pluginsdk.Schema{
-									// lintignore:XS003
-									"base_blob": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										MaxItems: 1,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"tier_to_cool_after_days_since_modification_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"tier_to_cool_after_days_since_last_access_time_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"auto_tier_to_hot_from_cool_enabled": {
-													Type:     pluginsdk.TypeBool,
-													Optional: true,
-												},
-												"tier_to_cool_after_days_since_creation_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"tier_to_archive_after_days_since_modification_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"tier_to_archive_after_days_since_last_access_time_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"tier_to_archive_after_days_since_last_tier_change_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"tier_to_archive_after_days_since_creation_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"tier_to_cold_after_days_since_modification_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"tier_to_cold_after_days_since_last_access_time_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"tier_to_cold_after_days_since_creation_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"delete_after_days_since_modification_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"delete_after_days_since_last_access_time_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"delete_after_days_since_creation_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-											},
-										},
-									},
-									// lintignore:XS003
-									"snapshot": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										MaxItems: 1,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"change_tier_to_archive_after_days_since_creation": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"tier_to_archive_after_days_since_last_tier_change_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"change_tier_to_cool_after_days_since_creation": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"tier_to_cold_after_days_since_creation_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"delete_after_days_since_creation_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-											},
-										},
-									},
-									"version": {
-										Type:     pluginsdk.TypeList,
-										Optional: true,
-										MaxItems: 1,
-										Elem: &pluginsdk.Resource{
-											Schema: map[string]*pluginsdk.Schema{
-												"change_tier_to_archive_after_days_since_creation": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"tier_to_archive_after_days_since_last_tier_change_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"change_tier_to_cool_after_days_since_creation": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"tier_to_cold_after_days_since_creation_greater_than": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-												"delete_after_days_since_creation": {
-													Type:         pluginsdk.TypeInt,
-													Optional:     true,
-													Default:      -1,
-													ValidateFunc: validation.IntBetween(0, 99999),
-												},
-											},
-										},
-									},
+					},
+					"version": {
+						Type:     pluginsdk.TypeList,
+						Optional: true,
+						MaxItems: 1,
+						Elem: &pluginsdk.Resource{
+							Schema: map[string]*pluginsdk.Schema{
+								"change_tier_to_archive_after_days_since_creation": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"tier_to_archive_after_days_since_last_tier_change_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"change_tier_to_cool_after_days_since_creation": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"tier_to_cold_after_days_since_creation_greater_than": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
+								},
+								"delete_after_days_since_creation": {
+									Type:         pluginsdk.TypeInt,
+									Optional:     true,
+									Default:      -1,
+									ValidateFunc: validation.IntBetween(0, 99999),
 								},
 							},
 						},
@@ -318,6 +323,9 @@ func resourceStorageManagementPolicyCreateOrUpdate(d *pluginsdk.ResourceData, me
 	// The name of the Storage Account Management Policy. It should always be 'default' (from https://docs.microsoft.com/en-us/rest/api/storagerp/managementpolicies/createorupdate)
 	mgmtPolicyId := parse.NewStorageAccountManagementPolicyID(rid.SubscriptionId, rid.ResourceGroupName, rid.StorageAccountName, "default")
 
+	locks.ByName(mgmtPolicyId.StorageAccountName, storageManagementPolicyResourceName)
+	defer locks.UnlockByName(mgmtPolicyId.StorageAccountName, storageManagementPolicyResourceName)
+
 	if d.IsNewResource() {
 		existing, err := client.Get(ctx, *rid)
 		if err != nil {
@@ -402,6 +410,9 @@ func resourceStorageManagementPolicyDelete(d *pluginsdk.ResourceData, meta inter
 
 	accountId := commonids.NewStorageAccountID(rid.SubscriptionId, rid.ResourceGroup, rid.StorageAccountName)
 
+	locks.ByName(rid.StorageAccountName, storageManagementPolicyResourceName)
+	defer locks.UnlockByName(rid.StorageAccountName, storageManagementPolicyResourceName)
+
 	if _, err := client.Delete(ctx, accountId); err != nil {
 		return fmt.Errorf("deleting %s: %+v", rid, err)
 	}
@@ -416,32 +427,35 @@ func expandStorageManagementPolicyRules(d *pluginsdk.ResourceData) ([]management
 
 	for k, v := range rules {
 		if v != nil {
-			rule, err := expandStorageManagementPolicyRule(d, k)
+			rule, err := expandStorageManagementPolicyRule(d, fmt.Sprintf("rule.%d.", k))
 			if err != nil {
 				return nil, fmt.Errorf("expanding the %dth rule: %+v", k, err)
 			}
-			_, blobIndexExist := d.GetOk(fmt.Sprintf("rule.%d.filters.0.match_blob_index_tag", k))
-			_, snapshotExist := d.GetOk(fmt.Sprintf("rule.%d.actions.0.snapshot", k))
-			_, versionExist := d.GetOk(fmt.Sprintf("rule.%d.actions.0.version", k))
-			if blobIndexExist && (snapshotExist || versionExist) {
-				return nil, fmt.Errorf("`match_blob_index_tag` is not supported as a filter for versions and snapshots")
-			}
 			result = append(result, *rule)
 		}
 	}
 	return result, nil
 }
 
-func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int) (*managementpolicies.ManagementPolicyRule, error) {
-	name := d.Get(fmt.Sprintf("rule.%d.name", ruleIndex)).(string)
-	enabled := d.Get(fmt.Sprintf("rule.%d.enabled", ruleIndex)).(bool)
+// expandStorageManagementPolicyRule expands the rule whose fields are found under `prefix` in the resource data, which
+// is either an element of the `rule` block or the top level of `azurerm_storage_management_policy_rule`
+func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, prefix string) (*managementpolicies.ManagementPolicyRule, error) {
+	name := d.Get(prefix + "name").(string)
+	enabled := d.Get(prefix + "enabled").(bool)
 	typeVal := "Lifecycle"
 
+	_, blobIndexExist := d.GetOk(prefix + "filters.0.match_blob_index_tag")
+	_, snapshotExist := d.GetOk(prefix + "actions.0.snapshot")
+	_, versionExist := d.GetOk(prefix + "actions.0.version")
+	if blobIndexExist && (snapshotExist || versionExist) {
+		return nil, fmt.Errorf("`match_blob_index_tag` is not supported as a filter for versions and snapshots")
+	}
+
 	definition := managementpolicies.ManagementPolicyDefinition{
 		Filters: &managementpolicies.ManagementPolicyFilter{},
 		Actions: managementpolicies.ManagementPolicyAction{},
 	}
-	filtersRef := d.Get(fmt.Sprintf("rule.%d.filters", ruleIndex)).([]interface{})
+	filtersRef := d.Get(prefix + "filters").([]interface{})
 	if len(filtersRef) == 1 {
 		if filtersRef[0] != nil {
 			filterRef := filtersRef[0].(map[string]interface{})
@@ -467,24 +481,24 @@ func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int)
 			definition.Filters.BlobIndexMatch = expandAzureRmStorageBlobIndexMatch(filterRef["match_blob_index_tag"].(*pluginsdk.Set).List())
 		}
 	}
-	if _, ok := d.GetOk(fmt.Sprintf("rule.%d.actions", ruleIndex)); ok {
-		if _, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.base_blob", ruleIndex)); ok {
+	if _, ok := d.GetOk(prefix + "actions"); ok {
+		if _, ok := d.GetOk(prefix + "actions.0.base_blob"); ok {
 			baseBlob := &managementpolicies.ManagementPolicyBaseBlob{}
 			var (
 				sinceMod, sinceAccess, sinceCreate       interface{}
 				sinceModOK, sinceAccessOK, sinceCreateOK bool
 			)
 
-			sinceMod = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_cool_after_days_since_modification_greater_than", ruleIndex))
+			sinceMod = d.Get(prefix + "actions.0.base_blob.0.tier_to_cool_after_days_since_modification_greater_than")
 			sinceModOK = sinceMod != -1
 
-			sinceAccess = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_cool_after_days_since_last_access_time_greater_than", ruleIndex))
+			sinceAccess = d.Get(prefix + "actions.0.base_blob.0.tier_to_cool_after_days_since_last_access_time_greater_than")
 			sinceAccessOK = sinceAccess != -1
 
-			sinceCreate = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_cool_after_days_since_creation_greater_than", ruleIndex))
+			sinceCreate = d.Get(prefix + "actions.0.base_blob.0.tier_to_cool_after_days_since_creation_greater_than")
 			sinceCreateOK = sinceCreate != -1
 
-			autoTierToHotOK := d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.auto_tier_to_hot_from_cool_enabled", ruleIndex)).(bool)
+			autoTierToHotOK := d.Get(prefix + "actions.0.base_blob.0.auto_tier_to_hot_from_cool_enabled").(bool)
 			if autoTierToHotOK && !sinceAccessOK {
 				return nil, fmt.Errorf("`auto_tier_to_hot_from_cool_enabled` must be used together with `tier_to_cool_after_days_since_last_access_time_greater_than`")
 			}
@@ -519,11 +533,11 @@ func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int)
 				}
 			}
 
-			sinceMod = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_archive_after_days_since_modification_greater_than", ruleIndex))
+			sinceMod = d.Get(prefix + "actions.0.base_blob.0.tier_to_archive_after_days_since_modification_greater_than")
 			sinceModOK = sinceMod != -1
-			sinceAccess = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_archive_after_days_since_last_access_time_greater_than", ruleIndex))
+			sinceAccess = d.Get(prefix + "actions.0.base_blob.0.tier_to_archive_after_days_since_last_access_time_greater_than")
 			sinceAccessOK = sinceAccess != -1
-			sinceCreate = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_archive_after_days_since_creation_greater_than", ruleIndex))
+			sinceCreate = d.Get(prefix + "actions.0.base_blob.0.tier_to_archive_after_days_since_creation_greater_than")
 			sinceCreateOK = sinceCreate != -1
 
 			cnt = 0
@@ -551,16 +565,16 @@ func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int)
 				if sinceCreateOK {
 					baseBlob.TierToArchive.DaysAfterCreationGreaterThan = utils.Float(float64(sinceCreate.(int)))
 				}
-				if v := d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_archive_after_days_since_last_tier_change_greater_than", ruleIndex)); v != -1 {
+				if v := d.Get(prefix + "actions.0.base_blob.0.tier_to_archive_after_days_since_last_tier_change_greater_than"); v != -1 {
 					baseBlob.TierToArchive.DaysAfterLastTierChangeGreaterThan = utils.Float(float64(v.(int)))
 				}
 			}
 
-			sinceMod = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.delete_after_days_since_modification_greater_than", ruleIndex))
+			sinceMod = d.Get(prefix + "actions.0.base_blob.0.delete_after_days_since_modification_greater_than")
 			sinceModOK = sinceMod != -1
-			sinceAccess = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.delete_after_days_since_last_access_time_greater_than", ruleIndex))
+			sinceAccess = d.Get(prefix + "actions.0.base_blob.0.delete_after_days_since_last_access_time_greater_than")
 			sinceAccessOK = sinceAccess != -1
-			sinceCreate = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.delete_after_days_since_creation_greater_than", ruleIndex))
+			sinceCreate = d.Get(prefix + "actions.0.base_blob.0.delete_after_days_since_creation_greater_than")
 			sinceCreateOK = sinceCreate != -1
 
 			cnt = 0
@@ -589,11 +603,11 @@ func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int)
 				}
 			}
 
-			sinceMod = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_cold_after_days_since_modification_greater_than", ruleIndex))
+			sinceMod = d.Get(prefix + "actions.0.base_blob.0.tier_to_cold_after_days_since_modification_greater_than")
 			sinceModOK = sinceMod != -1
-			sinceAccess = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_cold_after_days_since_last_access_time_greater_than", ruleIndex))
+			sinceAccess = d.Get(prefix + "actions.0.base_blob.0.tier_to_cold_after_days_since_last_access_time_greater_than")
 			sinceAccessOK = sinceAccess != -1
-			sinceCreate = d.Get(fmt.Sprintf("rule.%d.actions.0.base_blob.0.tier_to_cold_after_days_since_creation_greater_than", ruleIndex))
+			sinceCreate = d.Get(prefix + "actions.0.base_blob.0.tier_to_cold_after_days_since_creation_greater_than")
 			sinceCreateOK = sinceCreate != -1
 
 			cnt = 0
@@ -626,27 +640,27 @@ func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int)
 			definition.Actions.BaseBlob = baseBlob
 		}
 
-		if _, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.snapshot", ruleIndex)); ok {
+		if _, ok := d.GetOk(prefix + "actions.0.snapshot"); ok {
 			snapshot := &managementpolicies.ManagementPolicySnapShot{}
 
-			if v := d.Get(fmt.Sprintf("rule.%d.actions.0.snapshot.0.delete_after_days_since_creation_greater_than", ruleIndex)); v != -1 {
+			if v := d.Get(prefix + "actions.0.snapshot.0.delete_after_days_since_creation_greater_than"); v != -1 {
 				snapshot.Delete = &managementpolicies.DateAfterCreation{DaysAfterCreationGreaterThan: float64(v.(int))}
 			}
 
-			if v := d.Get(fmt.Sprintf("rule.%d.actions.0.snapshot.0.change_tier_to_archive_after_days_since_creation", ruleIndex)); v != -1 {
+			if v := d.Get(prefix + "actions.0.snapshot.0.change_tier_to_archive_after_days_since_creation"); v != -1 {
 				snapshot.TierToArchive = &managementpolicies.DateAfterCreation{
 					DaysAfterCreationGreaterThan: float64(v.(int)),
 				}
-				if vv := d.Get(fmt.Sprintf("rule.%d.actions.0.snapshot.0.tier_to_archive_after_days_since_last_tier_change_greater_than", ruleIndex)); vv != -1 {
+				if vv := d.Get(prefix + "actions.0.snapshot.0.tier_to_archive_after_days_since_last_tier_change_greater_than"); vv != -1 {
 					snapshot.TierToArchive.DaysAfterLastTierChangeGreaterThan = utils.Float(float64(vv.(int)))
 				}
 			}
-			if v := d.Get(fmt.Sprintf("rule.%d.actions.0.snapshot.0.change_tier_to_cool_after_days_since_creation", ruleIndex)); v != -1 {
+			if v := d.Get(prefix + "actions.0.snapshot.0.change_tier_to_cool_after_days_since_creation"); v != -1 {
 				snapshot.TierToCool = &managementpolicies.DateAfterCreation{
 					DaysAfterCreationGreaterThan: float64(v.(int)),
 				}
 			}
-			if v := d.Get(fmt.Sprintf("rule.%d.actions.0.snapshot.0.tier_to_cold_after_days_since_creation_greater_than", ruleIndex)); v != -1 {
+			if v := d.Get(prefix + "actions.0.snapshot.0.tier_to_cold_after_days_since_creation_greater_than"); v != -1 {
 				snapshot.TierToCold = &managementpolicies.DateAfterCreation{
 					DaysAfterCreationGreaterThan: float64(v.(int)),
 				}
@@ -654,27 +668,27 @@ func expandStorageManagementPolicyRule(d *pluginsdk.ResourceData, ruleIndex int)
 			definition.Actions.Snapshot = snapshot
 		}
 
-		if _, ok := d.GetOk(fmt.Sprintf("rule.%d.actions.0.version", ruleIndex)); ok {
+		if _, ok := d.GetOk(prefix + "actions.0.version"); ok {
 			version := &managementpolicies.ManagementPolicyVersion{}
-			if v := d.Get(fmt.Sprintf("rule.%d.actions.0.version.0.delete_after_days_since_creation", ruleIndex)); v != -1 {
+			if v := d.Get(prefix + "actions.0.version.0.delete_after_days_since_creation"); v != -1 {
 				version.Delete = &managementpolicies.DateAfterCreation{
 					DaysAfterCreationGreaterThan: float64(v.(int)),
 				}
 			}
-			if v := d.Get(fmt.Sprintf("rule.%d.actions.0.version.0.change_tier_to_archive_after_days_since_creation", ruleIndex)); v != -1 {
+			if v := d.Get(prefix + "actions.0.version.0.change_tier_to_archive_after_days_since_creation"); v != -1 {
 				version.TierToArchive = &managementpolicies.DateAfterCreation{
 					DaysAfterCreationGreaterThan: float64(v.(int)),
 				}
-				if vv := d.Get(fmt.Sprintf("rule.%d.actions.0.version.0.tier_to_archive_after_days_since_last_tier_change_greater_than", ruleIndex)); vv != -1 {
+				if vv := d.Get(prefix + "actions.0.version.0.tier_to_archive_after_days_since_last_tier_change_greater_than"); vv != -1 {
 					version.TierToArchive.DaysAfterLastTierChangeGreaterThan = utils.Float(float64(vv.(int)))
 				}
 			}
-			if v := d.Get(fmt.Sprintf("rule.%d.actions.0.version.0.change_tier_to_cool_after_days_since_creation", ruleIndex)); v != -1 {
+			if v := d.Get(prefix + "actions.0.version.0.change_tier_to_cool_after_days_since_creation"); v != -1 {
 				version.TierToCool = &managementpolicies.DateAfterCreation{
 					DaysAfterCreationGreaterThan: float64(v.(int)),
 				}
 			}
-			if v := d.Get(fmt.Sprintf("rule.%d.actions.0.version.0.tier_to_cold_after_days_since_creation_greater_than", ruleIndex)); v != -1 {
+			if v := d.Get(prefix + "actions.0.version.0.tier_to_cold_after_days_since_creation_greater_than"); v != -1 {
 				version.TierToCold = &managementpolicies.DateAfterCreation{
 					DaysAfterCreationGreaterThan: float64(v.(int)),
 				}
@@ -697,199 +711,203 @@ func flattenStorageManagementPolicyRules(armRules []managementpolicies.Managemen
 		return rules
 	}
 	for _, armRule := range armRules {
-		rule := make(map[string]interface{})
-
-		rule["name"] = armRule.Name
-		rule["enabled"] = armRule.Enabled
-
-		armDefinition := armRule.Definition
-		armFilter := armDefinition.Filters
-		if armFilter != nil {
-			filter := make(map[string]interface{})
-			if armFilter.PrefixMatch != nil {
-				prefixMatches := make([]interface{}, 0)
-				for _, armPrefixMatch := range *armFilter.PrefixMatch {
-					prefixMatches = append(prefixMatches, armPrefixMatch)
-				}
-				filter["prefix_match"] = prefixMatches
-			}
-			if armFilter.BlobTypes != nil {
-				blobTypes := make([]interface{}, 0)
-				for _, armBlobType := range armFilter.BlobTypes {
-					blobTypes = append(blobTypes, armBlobType)
-				}
-				filter["blob_types"] = blobTypes
-			}
+		rules = append(rules, flattenStorageManagementPolicyRule(armRule))
+	}
 
-			filter["match_blob_index_tag"] = flattenAzureRmStorageBlobIndexMatch(armFilter.BlobIndexMatch)
+	return rules
+}
+
+func flattenStorageManagementPolicyRule(armRule managementpolicies.ManagementPolicyRule) map[string]interface{} {
+	rule := make(map[string]interface{})
 
-			rule["filters"] = []interface{}{filter}
+	rule["name"] = armRule.Name
+	rule["enabled"] = armRule.Enabled
+
+	armDefinition := armRule.Definition
+	armFilter := armDefinition.Filters
+	if armFilter != nil {
+		filter := make(map[string]interface{})
+		if armFilter.PrefixMatch != nil {
+			prefixMatches := make([]interface{}, 0)
+			for _, armPrefixMatch := range *armFilter.PrefixMatch {
+				prefixMatches = append(prefixMatches, armPrefixMatch)
+			}
+			filter["prefix_match"] = prefixMatches
+		}
+		if armFilter.BlobTypes != nil {
+			blobTypes := make([]interface{}, 0)
+			for _, armBlobType := range armFilter.BlobTypes {
+				blobTypes = append(blobTypes, armBlobType)
+			}
+			filter["blob_types"] = blobTypes
 		}
 
-		armAction := armDefinition.Actions
-		action := make(map[string]interface{})
-		armActionBaseBlob := armAction.BaseBlob
-		if armActionBaseBlob != nil {
-			var (
-				tierToCoolSinceMod               = -1
-				tierToCoolSinceAccess            = -1
-				tierToCoolSinceCreate            = -1
-				autoTierToHotOK                  = false
-				tierToArchiveSinceMod            = -1
-				tierToArchiveSinceAccess         = -1
-				tierToArchiveSinceCreate         = -1
-				tierToArchiveSinceLastTierChange = -1
-				tierToColdSinceMod               = -1
-				tierToColdSinceAccess            = -1
-				tierToColdSinceCreate            = -1
-				deleteSinceMod                   = -1
-				deleteSinceAccess                = -1
-				deleteSinceCreate                = -1
-			)
+		filter["match_blob_index_tag"] = flattenAzureRmStorageBlobIndexMatch(armFilter.BlobIndexMatch)
+
+		rule["filters"] = []interface{}{filter}
+	}
 
-			if v := armActionBaseBlob.EnableAutoTierToHotFromCool; v != nil {
-				autoTierToHotOK = *v
+	armAction := armDefinition.Actions
+	action := make(map[string]interface{})
+	armActionBaseBlob := armAction.BaseBlob
+	if armActionBaseBlob != nil {
+		var (
+			tierToCoolSinceMod               = -1
+			tierToCoolSinceAccess            = -1
+			tierToCoolSinceCreate            = -1
+			autoTierToHotOK                  = false
+			tierToArchiveSinceMod            = -1
+			tierToArchiveSinceAccess         = -1
+			tierToArchiveSinceCreate         = -1
+			tierToArchiveSinceLastTierChange = -1
+			tierToColdSinceMod               = -1
+			tierToColdSinceAccess            = -1
+			tierToColdSinceCreate            = -1
+			deleteSinceMod                   = -1
+			deleteSinceAccess                = -1
+			deleteSinceCreate                = -1
+		)
+
+		if v := armActionBaseBlob.EnableAutoTierToHotFromCool; v != nil {
+			autoTierToHotOK = *v
+		}
+		if props := armActionBaseBlob.TierToCool; props != nil {
+			if props.DaysAfterModificationGreaterThan != nil {
+				tierToCoolSinceMod = int(*props.DaysAfterModificationGreaterThan)
 			}
-			if props := armActionBaseBlob.TierToCool; props != nil {
-				if props.DaysAfterModificationGreaterThan != nil {
-					tierToCoolSinceMod = int(*props.DaysAfterModificationGreaterThan)
-				}
-				if props.DaysAfterLastAccessTimeGreaterThan != nil {
-					tierToCoolSinceAccess = int(*props.DaysAfterLastAccessTimeGreaterThan)
-				}
-				if props.DaysAfterCreationGreaterThan != nil {
-					tierToCoolSinceCreate = int(*props.DaysAfterCreationGreaterThan)
-				}
+			if props.DaysAfterLastAccessTimeGreaterThan != nil {
+				tierToCoolSinceAccess = int(*props.DaysAfterLastAccessTimeGreaterThan)
 			}
-			if props := armActionBaseBlob.TierToArchive; props != nil {
-				if props.DaysAfterModificationGreaterThan != nil {
-					tierToArchiveSinceMod = int(*props.DaysAfterModificationGreaterThan)
-				}
-				if props.DaysAfterLastAccessTimeGreaterThan != nil {
-					tierToArchiveSinceAccess = int(*props.DaysAfterLastAccessTimeGreaterThan)
-				}
-				if props.DaysAfterLastTierChangeGreaterThan != nil {
-					tierToArchiveSinceLastTierChange = int(*props.DaysAfterLastTierChangeGreaterThan)
-				}
-				if props.DaysAfterCreationGreaterThan != nil {
-					tierToArchiveSinceCreate = int(*props.DaysAfterCreationGreaterThan)
-				}
+			if props.DaysAfterCreationGreaterThan != nil {
+				tierToCoolSinceCreate = int(*props.DaysAfterCreationGreaterThan)
 			}
-			if props := armActionBaseBlob.TierToCold; props != nil {
-				if props.DaysAfterModificationGreaterThan != nil {
-					tierToColdSinceMod = int(*props.DaysAfterModificationGreaterThan)
-				}
-				if props.DaysAfterLastAccessTimeGreaterThan != nil {
-					tierToColdSinceAccess = int(*props.DaysAfterLastAccessTimeGreaterThan)
-				}
-				if props.DaysAfterCreationGreaterThan != nil {
-					tierToColdSinceCreate = int(*props.DaysAfterCreationGreaterThan)
-				}
+		}
+		if props := armActionBaseBlob.TierToArchive; props != nil {
+			if props.DaysAfterModificationGreaterThan != nil {
+				tierToArchiveSinceMod = int(*props.DaysAfterModificationGreaterThan)
 			}
-			if props := armActionBaseBlob.Delete; props != nil {
-				if props.DaysAfterModificationGreaterThan != nil {
-					deleteSinceMod = int(*props.DaysAfterModificationGreaterThan)
-				}
-				if props.DaysAfterLastAccessTimeGreaterThan != nil {
-					deleteSinceAccess = int(*props.DaysAfterLastAccessTimeGreaterThan)
-				}
-				if props.DaysAfterCreationGreaterThan != nil {
-					deleteSinceCreate = int(*props.DaysAfterCreationGreaterThan)
-				}
+			if props.DaysAfterLastAccessTimeGreaterThan != nil {
+				tierToArchiveSinceAccess = int(*props.DaysAfterLastAccessTimeGreaterThan)
 			}
-			action["base_blob"] = []interface{}{
-				map[string]interface{}{
-					"auto_tier_to_hot_from_cool_enabled":                             autoTierToHotOK,
-					"tier_to_cool_after_days_since_modification_greater_than":        tierToCoolSinceMod,
-					"tier_to_cool_after_days_since_last_access_time_greater_than":    tierToCoolSinceAccess,
-					"tier_to_cool_after_days_since_creation_greater_than":            tierToCoolSinceCreate,
-					"tier_to_archive_after_days_since_modification_greater_than":     tierToArchiveSinceMod,
-					"tier_to_archive_after_days_since_last_access_time_greater_than": tierToArchiveSinceAccess,
-					"tier_to_archive_after_days_since_last_tier_change_greater_than": tierToArchiveSinceLastTierChange,
-					"tier_to_archive_after_days_since_creation_greater_than":         tierToArchiveSinceCreate,
-					"tier_to_cold_after_days_since_modification_greater_than":        tierToColdSinceMod,
-					"tier_to_cold_after_days_since_last_access_time_greater_than":    tierToColdSinceAccess,
-					"tier_to_cold_after_days_since_creation_greater_than":            tierToColdSinceCreate,
-					"delete_after_days_since_modification_greater_than":              deleteSinceMod,
-					"delete_after_days_since_last_access_time_greater_than":          deleteSinceAccess,
-					"delete_after_days_since_creation_greater_than":                  deleteSinceCreate,
-				},
+			if props.DaysAfterLastTierChangeGreaterThan != nil {
+				tierToArchiveSinceLastTierChange = int(*props.DaysAfterLastTierChangeGreaterThan)
+			}
+			if props.DaysAfterCreationGreaterThan != nil {
+				tierToArchiveSinceCreate = int(*props.DaysAfterCreationGreaterThan)
 			}
 		}
-
-		armActionSnaphost := armAction.Snapshot
-		if armActionSnaphost != nil {
-			var (
-				deleteAfterCreation        = -1
-				archiveAfterCreation       = -1
-				archiveAfterLastTierChange = -1
-				coolAfterCreation          = -1
-				tierToColdSinceCreate      = -1
-			)
-			if armActionSnaphost.Delete != nil {
-				deleteAfterCreation = int(armActionSnaphost.Delete.DaysAfterCreationGreaterThan)
+		if props := armActionBaseBlob.TierToCold; props != nil {
+			if props.DaysAfterModificationGreaterThan != nil {
+				tierToColdSinceMod = int(*props.DaysAfterModificationGreaterThan)
 			}
-			if armActionSnaphost.TierToArchive != nil {
-				archiveAfterCreation = int(armActionSnaphost.TierToArchive.DaysAfterCreationGreaterThan)
-
-				if v := armActionSnaphost.TierToArchive.DaysAfterLastTierChangeGreaterThan; v != nil {
-					archiveAfterLastTierChange = int(*v)
-				}
+			if props.DaysAfterLastAccessTimeGreaterThan != nil {
+				tierToColdSinceAccess = int(*props.DaysAfterLastAccessTimeGreaterThan)
+			}
+			if props.DaysAfterCreationGreaterThan != nil {
+				tierToColdSinceCreate = int(*props.DaysAfterCreationGreaterThan)
+			}
+		}
+		if props := armActionBaseBlob.Delete; props != nil {
+			if props.DaysAfterModificationGreaterThan != nil {
+				deleteSinceMod = int(*props.DaysAfterModificationGreaterThan)
 			}
-			if armActionSnaphost.TierToCold != nil {
-				tierToColdSinceCreate = int(armActionSnaphost.TierToCold.DaysAfterCreationGreaterThan)
+			if props.DaysAfterLastAccessTimeGreaterThan != nil {
+				deleteSinceAccess = int(*props.DaysAfterLastAccessTimeGreaterThan)
 			}
-			if armActionSnaphost.TierToCool != nil {
-				coolAfterCreation = int(armActionSnaphost.TierToCool.DaysAfterCreationGreaterThan)
+			if props.DaysAfterCreationGreaterThan != nil {
+				deleteSinceCreate = int(*props.DaysAfterCreationGreaterThan)
 			}
-			action["snapshot"] = []interface{}{map[string]interface{}{
-				"delete_after_days_since_creation_greater_than":                  deleteAfterCreation,
-				"change_tier_to_archive_after_days_since_creation":               archiveAfterCreation,
-				"tier_to_archive_after_days_since_last_tier_change_greater_than": archiveAfterLastTierChange,
+		}
+		action["base_blob"] = []interface{}{
+			map[string]interface{}{
+				"auto_tier_to_hot_from_cool_enabled":                             autoTierToHotOK,
+				"tier_to_cool_after_days_since_modification_greater_than":        tierToCoolSinceMod,
+				"tier_to_cool_after_days_since_last_access_time_greater_than":    tierToCoolSinceAccess,
+				"tier_to_cool_after_days_since_creation_greater_than":            tierToCoolSinceCreate,
+				"tier_to_archive_after_days_since_modification_greater_than":     tierToArchiveSinceMod,
+				"tier_to_archive_after_days_since_last_access_time_greater_than": tierToArchiveSinceAccess,
+				"tier_to_archive_after_days_since_last_tier_change_greater_than": tierToArchiveSinceLastTierChange,
+				"tier_to_archive_after_days_since_creation_greater_than":         tierToArchiveSinceCreate,
+				"tier_to_cold_after_days_since_modification_greater_than":        tierToColdSinceMod,
+				"tier_to_cold_after_days_since_last_access_time_greater_than":    tierToColdSinceAccess,
 				"tier_to_cold_after_days_since_creation_greater_than":            tierToColdSinceCreate,
-				"change_tier_to_cool_after_days_since_creation":                  coolAfterCreation,
-			}}
+				"delete_after_days_since_modification_greater_than":              deleteSinceMod,
+				"delete_after_days_since_last_access_time_greater_than":          deleteSinceAccess,
+				"delete_after_days_since_creation_greater_than":                  deleteSinceCreate,
+			},
 		}
+	}
 
-		if armActionVersion := armAction.Version; armActionVersion != nil {
-			var (
-				deleteAfterCreation        = -1
-				archiveAfterCreation       = -1
-				archiveAfterLastTierChange = -1
-				coolAfterCreation          = -1
-				tierToColdSinceCreate      = -1
-			)
-			if armActionVersion.Delete != nil {
-				deleteAfterCreation = int(armActionVersion.Delete.DaysAfterCreationGreaterThan)
-			}
-			if armActionVersion.TierToArchive != nil {
-				archiveAfterCreation = int(armActionVersion.TierToArchive.DaysAfterCreationGreaterThan)
+	armActionSnaphost := armAction.Snapshot
+	if armActionSnaphost != nil {
+		var (
+			deleteAfterCreation        = -1
+			archiveAfterCreation       = -1
+			archiveAfterLastTierChange = -1
+			coolAfterCreation          = -1
+			tierToColdSinceCreate      = -1
+		)
+		if armActionSnaphost.Delete != nil {
+			deleteAfterCreation = int(armActionSnaphost.Delete.DaysAfterCreationGreaterThan)
+		}
+		if armActionSnaphost.TierToArchive != nil {
+			archiveAfterCreation = int(armActionSnaphost.TierToArchive.DaysAfterCreationGreaterThan)
 
-				if v := armActionVersion.TierToArchive.DaysAfterLastTierChangeGreaterThan; v != nil {
-					archiveAfterLastTierChange = int(*v)
-				}
-			}
-			if armActionVersion.TierToCold != nil {
-				tierToColdSinceCreate = int(armActionVersion.TierToCold.DaysAfterCreationGreaterThan)
+			if v := armActionSnaphost.TierToArchive.DaysAfterLastTierChangeGreaterThan; v != nil {
+				archiveAfterLastTierChange = int(*v)
 			}
-			if armActionVersion.TierToCool != nil {
-				coolAfterCreation = int(armActionVersion.TierToCool.DaysAfterCreationGreaterThan)
-			}
-			action["version"] = []interface{}{map[string]interface{}{
-				"delete_after_days_since_creation":                               deleteAfterCreation,
-				"change_tier_to_archive_after_days_since_creation":               archiveAfterCreation,
-				"tier_to_archive_after_days_since_last_tier_change_greater_than": archiveAfterLastTierChange,
-				"tier_to_cold_after_days_since_creation_greater_than":            tierToColdSinceCreate,
-				"change_tier_to_cool_after_days_since_creation":                  coolAfterCreation,
-			}}
 		}
+		if armActionSnaphost.TierToCold != nil {
+			tierToColdSinceCreate = int(armActionSnaphost.TierToCold.DaysAfterCreationGreaterThan)
+		}
+		if armActionSnaphost.TierToCool != nil {
+			coolAfterCreation = int(armActionSnaphost.TierToCool.DaysAfterCreationGreaterThan)
+		}
+		action["snapshot"] = []interface{}{map[string]interface{}{
+			"delete_after_days_since_creation_greater_than":                  deleteAfterCreation,
+			"change_tier_to_archive_after_days_since_creation":               archiveAfterCreation,
+			"tier_to_archive_after_days_since_last_tier_change_greater_than": archiveAfterLastTierChange,
+			"tier_to_cold_after_days_since_creation_greater_than":            tierToColdSinceCreate,
+			"change_tier_to_cool_after_days_since_creation":                  coolAfterCreation,
+		}}
+	}
 
-		rule["actions"] = []interface{}{action}
+	if armActionVersion := armAction.Version; armActionVersion != nil {
+		var (
+			deleteAfterCreation        = -1
+			archiveAfterCreation       = -1
+			archiveAfterLastTierChange = -1
+			coolAfterCreation          = -1
+			tierToColdSinceCreate      = -1
+		)
+		if armActionVersion.Delete != nil {
+			deleteAfterCreation = int(armActionVersion.Delete.DaysAfterCreationGreaterThan)
+		}
+		if armActionVersion.TierToArchive != nil {
+			archiveAfterCreation = int(armActionVersion.TierToArchive.DaysAfterCreationGreaterThan)
 
-		rules = append(rules, rule)
+			if v := armActionVersion.TierToArchive.DaysAfterLastTierChangeGreaterThan; v != nil {
+				archiveAfterLastTierChange = int(*v)
+			}
+		}
+		if armActionVersion.TierToCold != nil {
+			tierToColdSinceCreate = int(armActionVersion.TierToCold.DaysAfterCreationGreaterThan)
+		}
+		if armActionVersion.TierToCool != nil {
+			coolAfterCreation = int(armActionVersion.TierToCool.DaysAfterCreationGreaterThan)
+		}
+		action["version"] = []interface{}{map[string]interface{}{
+			"delete_after_days_since_creation":                               deleteAfterCreation,
+			"change_tier_to_archive_after_days_since_creation":               archiveAfterCreation,
+			"tier_to_archive_after_days_since_last_tier_change_greater_than": archiveAfterLastTierChange,
+			"tier_to_cold_after_days_since_creation_greater_than":            tierToColdSinceCreate,
+			"change_tier_to_cool_after_days_since_creation":                  coolAfterCreation,
+		}}
 	}
 
-	return rules
+	rule["actions"] = []interface{}{action}
+
+	return rule
 }
 
 func expandAzureRmStorageBlobIndexMatch(blobIndexMatches []interface{}) *[]managementpolicies.TagFilter {
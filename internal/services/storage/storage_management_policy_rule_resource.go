@@ -0,0 +1,264 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/managementpolicies"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
+)
+
+// the rules of a Storage Account are stored within a single Management Policy, so changes to individual rules
+// need to be serialized to avoid concurrent read-modify-write operations overwriting one another
+const storageManagementPolicyResourceName = "azurerm_storage_management_policy"
+
+func resourceStorageManagementPolicyRule() *pluginsdk.Resource {
+	schema := storageManagementPolicyRuleSchema()
+	schema["name"].ForceNew = true
+	schema["storage_account_id"] = &pluginsdk.Schema{
+		Type:         pluginsdk.TypeString,
+		Required:     true,
+		ForceNew:     true,
+		ValidateFunc: commonids.ValidateStorageAccountID,
+	}
+
+	return &pluginsdk.Resource{
+		Create: resourceStorageManagementPolicyRuleCreate,
+		Read:   resourceStorageManagementPolicyRuleRead,
+		Update: resourceStorageManagementPolicyRuleUpdate,
+		Delete: resourceStorageManagementPolicyRuleDelete,
+		Importer: pluginsdk.ImporterValidatingResourceId(func(id string) error {
+			_, err := parse.StorageAccountManagementPolicyRuleID(id)
+			return err
+		}),
+
+		Timeouts: &pluginsdk.ResourceTimeout{
+			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
+			Update: pluginsdk.DefaultTimeout(30 * time.Minute),
+			Delete: pluginsdk.DefaultTimeout(30 * time.Minute),
+		},
+
+		Schema: schema,
+	}
+}
+
+func resourceStorageManagementPolicyRuleCreate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	accountId, err := commonids.ParseStorageAccountID(d.Get("storage_account_id").(string))
+	if err != nil {
+		return err
+	}
+
+	// The name of the Storage Account Management Policy. It should always be 'default' (from https://docs.microsoft.com/en-us/rest/api/storagerp/managementpolicies/createorupdate)
+	id := parse.NewStorageAccountManagementPolicyRuleID(accountId.SubscriptionId, accountId.ResourceGroupName, accountId.StorageAccountName, "default", d.Get("name").(string))
+
+	locks.ByName(id.StorageAccountName, storageManagementPolicyResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageManagementPolicyResourceName)
+
+	rules, err := retrieveStorageManagementPolicyRules(ctx, meta, *accountId)
+	if err != nil {
+		return fmt.Errorf("retrieving Management Policy for %s: %+v", id, err)
+	}
+
+	for _, rule := range rules {
+		if rule.Name == id.RuleName {
+			return tf.ImportAsExistsError("azurerm_storage_management_policy_rule", id.ID())
+		}
+	}
+
+	rule, err := expandStorageManagementPolicyRule(d, "")
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+	rules = append(rules, *rule)
+
+	if err := updateStorageManagementPolicyRules(ctx, meta, *accountId, rules); err != nil {
+		return fmt.Errorf("creating %s: %+v", id, err)
+	}
+
+	d.SetId(id.ID())
+
+	return resourceStorageManagementPolicyRuleRead(d, meta)
+}
+
+func resourceStorageManagementPolicyRuleRead(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountManagementPolicyRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	accountId := commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName)
+
+	rules, err := retrieveStorageManagementPolicyRules(ctx, meta, accountId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	var existing *managementpolicies.ManagementPolicyRule
+	for _, rule := range rules {
+		if rule.Name == id.RuleName {
+			existing = &rule
+			break
+		}
+	}
+	if existing == nil {
+		log.Printf("[DEBUG] %s was not found - removing from state!", id)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("storage_account_id", accountId.ID())
+	for k, v := range flattenStorageManagementPolicyRule(*existing) {
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("setting `%s`: %+v", k, err)
+		}
+	}
+
+	return nil
+}
+
+func resourceStorageManagementPolicyRuleUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
+	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountManagementPolicyRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	accountId := commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName)
+
+	locks.ByName(id.StorageAccountName, storageManagementPolicyResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageManagementPolicyResourceName)
+
+	rules, err := retrieveStorageManagementPolicyRules(ctx, meta, accountId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	rule, err := expandStorageManagementPolicyRule(d, "")
+	if err != nil {
+		return fmt.Errorf("expanding %s: %+v", id, err)
+	}
+
+	found := false
+	for i := range rules {
+		if rules[i].Name == id.RuleName {
+			rules[i] = *rule
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("%s was not found", id)
+	}
+
+	if err := updateStorageManagementPolicyRules(ctx, meta, accountId, rules); err != nil {
+		return fmt.Errorf("updating %s: %+v", id, err)
+	}
+
+	return resourceStorageManagementPolicyRuleRead(d, meta)
+}
+
+func resourceStorageManagementPolicyRuleDelete(d *pluginsdk.ResourceData, meta interface{}) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.ManagementPolicies
+	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
+	defer cancel()
+
+	id, err := parse.StorageAccountManagementPolicyRuleID(d.Id())
+	if err != nil {
+		return err
+	}
+
+	accountId := commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName)
+
+	locks.ByName(id.StorageAccountName, storageManagementPolicyResourceName)
+	defer locks.UnlockByName(id.StorageAccountName, storageManagementPolicyResourceName)
+
+	rules, err := retrieveStorageManagementPolicyRules(ctx, meta, accountId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	remaining := make([]managementpolicies.ManagementPolicyRule, 0)
+	for _, rule := range rules {
+		if rule.Name != id.RuleName {
+			remaining = append(remaining, rule)
+		}
+	}
+	if len(remaining) == len(rules) {
+		return nil
+	}
+
+	// a Management Policy must contain at least one rule, so the Policy itself is removed along with the last rule
+	if len(remaining) == 0 {
+		if _, err := client.Delete(ctx, accountId); err != nil {
+			return fmt.Errorf("deleting %s: %+v", id, err)
+		}
+		return nil
+	}
+
+	if err := updateStorageManagementPolicyRules(ctx, meta, accountId, remaining); err != nil {
+		return fmt.Errorf("deleting %s: %+v", id, err)
+	}
+
+	return nil
+}
+
+// retrieveStorageManagementPolicyRules returns the rules currently defined in the Management Policy of the specified
+// Storage Account, which is empty when no Management Policy exists
+func retrieveStorageManagementPolicyRules(ctx context.Context, meta interface{}, accountId commonids.StorageAccountId) ([]managementpolicies.ManagementPolicyRule, error) {
+	client := meta.(*clients.Client).Storage.ResourceManager.ManagementPolicies
+
+	rules := make([]managementpolicies.ManagementPolicyRule, 0)
+
+	resp, err := client.Get(ctx, accountId)
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return rules, nil
+		}
+		return nil, err
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		rules = append(rules, model.Properties.Policy.Rules...)
+	}
+
+	return rules, nil
+}
+
+func updateStorageManagementPolicyRules(ctx context.Context, meta interface{}, accountId commonids.StorageAccountId, rules []managementpolicies.ManagementPolicyRule) error {
+	client := meta.(*clients.Client).Storage.ResourceManager.ManagementPolicies
+
+	parameters := managementpolicies.ManagementPolicy{
+		Properties: &managementpolicies.ManagementPolicyProperties{
+			Policy: managementpolicies.ManagementPolicySchema{
+				Rules: rules,
+			},
+		},
+	}
+
+	if _, err := client.CreateOrUpdate(ctx, accountId, parameters); err != nil {
+		return err
+	}
+
+	return nil
+}
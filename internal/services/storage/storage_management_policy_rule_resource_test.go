@@ -0,0 +1,293 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/utils"
+)
+
+type StorageManagementPolicyRuleResource struct{}
+
+func TestAccStorageManagementPolicyRule_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_management_policy_rule", "test")
+	r := StorageManagementPolicyRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageManagementPolicyRule_requiresImport(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_management_policy_rule", "test")
+	r := StorageManagementPolicyRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.RequiresImportErrorStep(r.requiresImport),
+	})
+}
+
+func TestAccStorageManagementPolicyRule_update(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_management_policy_rule", "test")
+	r := StorageManagementPolicyRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.complete(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageManagementPolicyRule_multiple(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_management_policy_rule", "test")
+	r := StorageManagementPolicyRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.multiple(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That("azurerm_storage_management_policy_rule.second").ExistsInAzure(r),
+			),
+		},
+		data.ImportStep(),
+		data.ImportStepFor("azurerm_storage_management_policy_rule.second"),
+	})
+}
+
+func TestAccStorageManagementPolicyRule_lastAccessTime(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_management_policy_rule", "test")
+	r := StorageManagementPolicyRuleResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.lastAccessTime(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("actions.0.base_blob.0.tier_to_cool_after_days_since_last_access_time_greater_than").HasValue("10"),
+				check.That(data.ResourceName).Key("actions.0.base_blob.0.auto_tier_to_hot_from_cool_enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageManagementPolicyRuleResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageAccountManagementPolicyRuleID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Storage.ResourceManager.ManagementPolicies.Get(ctx, commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName))
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return utils.Bool(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	if model := resp.Model; model != nil && model.Properties != nil {
+		for _, rule := range model.Properties.Policy.Rules {
+			if rule.Name == id.RuleName {
+				return utils.Bool(true), nil
+			}
+		}
+	}
+
+	return utils.Bool(false), nil
+}
+
+func (r StorageManagementPolicyRuleResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy_rule" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  name               = "rule-1"
+  enabled            = true
+
+  filters {
+    prefix_match = ["container1/prefix1"]
+    blob_types   = ["blockBlob"]
+  }
+
+  actions {
+    base_blob {
+      delete_after_days_since_modification_greater_than = 100
+    }
+  }
+}
+`, r.template(data, false))
+}
+
+func (r StorageManagementPolicyRuleResource) requiresImport(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy_rule" "import" {
+  storage_account_id = azurerm_storage_management_policy_rule.test.storage_account_id
+  name               = azurerm_storage_management_policy_rule.test.name
+  enabled            = azurerm_storage_management_policy_rule.test.enabled
+
+  filters {
+    prefix_match = ["container1/prefix1"]
+    blob_types   = ["blockBlob"]
+  }
+
+  actions {
+    base_blob {
+      delete_after_days_since_modification_greater_than = 100
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r StorageManagementPolicyRuleResource) complete(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy_rule" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  name               = "rule-1"
+  enabled            = false
+
+  filters {
+    prefix_match = ["container1/prefix1", "container1/prefix2"]
+    blob_types   = ["blockBlob"]
+  }
+
+  actions {
+    base_blob {
+      tier_to_cool_after_days_since_modification_greater_than    = 10
+      tier_to_archive_after_days_since_modification_greater_than = 50
+      delete_after_days_since_modification_greater_than          = 100
+    }
+    snapshot {
+      change_tier_to_archive_after_days_since_creation = 90
+      change_tier_to_cool_after_days_since_creation    = 23
+      delete_after_days_since_creation_greater_than    = 31
+    }
+    version {
+      change_tier_to_archive_after_days_since_creation = 9
+      change_tier_to_cool_after_days_since_creation    = 90
+      delete_after_days_since_creation                 = 3
+    }
+  }
+}
+`, r.template(data, false))
+}
+
+func (r StorageManagementPolicyRuleResource) multiple(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy_rule" "second" {
+  storage_account_id = azurerm_storage_account.test.id
+  name               = "rule-2"
+  enabled            = true
+
+  filters {
+    prefix_match = ["container2/prefix1"]
+    blob_types   = ["blockBlob"]
+  }
+
+  actions {
+    base_blob {
+      tier_to_archive_after_days_since_modification_greater_than = 50
+    }
+  }
+}
+`, r.basic(data))
+}
+
+func (r StorageManagementPolicyRuleResource) lastAccessTime(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_management_policy_rule" "test" {
+  storage_account_id = azurerm_storage_account.test.id
+  name               = "rule-1"
+  enabled            = true
+
+  filters {
+    blob_types = ["blockBlob"]
+  }
+
+  actions {
+    base_blob {
+      tier_to_cool_after_days_since_last_access_time_greater_than = 10
+      auto_tier_to_hot_from_cool_enabled                          = true
+    }
+  }
+}
+`, r.template(data, true))
+}
+
+func (r StorageManagementPolicyRuleResource) template(data acceptance.TestData, lastAccessTimeEnabled bool) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-storage-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                = "unlikely23exst2acct%s"
+  resource_group_name = azurerm_resource_group.test.name
+
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+  account_kind             = "BlobStorage"
+
+  blob_properties {
+    last_access_time_enabled = %t
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString, lastAccessTimeEnabled)
+}
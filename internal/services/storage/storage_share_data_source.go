@@ -9,7 +9,7 @@ import (
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/fileshares"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2024-01-01/fileshares"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/features"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/client"
@@ -113,7 +113,7 @@ func dataSourceStorageShare() *pluginsdk.Resource {
 }
 
 func dataSourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	sharesClient := meta.(*clients.Client).Storage.ResourceManager.FileShares
+	sharesClient := meta.(*clients.Client).Storage.FileSharesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -13,8 +13,8 @@ import (
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/fileshares"
 	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/storageaccounts"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2024-01-01/fileshares"
 	"github.com/hashicorp/go-azure-sdk/sdk/client/pollers"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -154,6 +154,26 @@ func resourceStorageShare() *pluginsdk.Resource {
 						string(shares.TransactionOptimizedAccessTier),
 					}, false),
 			},
+
+			"restore_from_deleted_share": {
+				Type:     pluginsdk.TypeBool,
+				Optional: true,
+				Default:  false,
+			},
+
+			"provisioned_iops": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"provisioned_bandwidth_mibps": {
+				Type:         pluginsdk.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
 		},
 	}
 
@@ -168,6 +188,10 @@ func resourceStorageShare() *pluginsdk.Resource {
 			Deprecated: "This property has been deprecated and will be replaced by `storage_account_id` in version 5.0 of the provider.",
 		}
 
+		// the provisioned IOPS and bandwidth can only be managed via Resource Manager
+		r.Schema["provisioned_iops"].ConflictsWith = []string{"storage_account_name"}
+		r.Schema["provisioned_bandwidth_mibps"].ConflictsWith = []string{"storage_account_name"}
+
 		r.Schema["storage_account_id"] = &pluginsdk.Schema{
 			Type:     pluginsdk.TypeString,
 			Optional: true,
@@ -213,7 +237,7 @@ func resourceStorageShare() *pluginsdk.Resource {
 
 func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	sharesClient := meta.(*clients.Client).Storage.ResourceManager.FileShares
+	sharesClient := meta.(*clients.Client).Storage.FileSharesClient
 	ctx, cancel := timeouts.ForCreate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -328,6 +352,45 @@ func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		payload.Properties.AccessTier = pointer.To(fileshares.ShareAccessTier(sharedAccessTier.(string)))
 	}
 
+	if v, ok := d.GetOk("provisioned_iops"); ok {
+		payload.Properties.ProvisionedIops = pointer.To(int64(v.(int)))
+	}
+
+	if v, ok := d.GetOk("provisioned_bandwidth_mibps"); ok {
+		payload.Properties.ProvisionedBandwidthMibps = pointer.To(int64(v.(int)))
+	}
+
+	if d.Get("restore_from_deleted_share").(bool) {
+		deletedShare, err := findDeletedStorageShare(ctx, sharesClient, id)
+		if err != nil {
+			return err
+		}
+
+		if deletedShare != nil {
+			if _, err := sharesClient.Restore(ctx, id, *deletedShare); err != nil {
+				return fmt.Errorf("restoring %s: %v", id, err)
+			}
+
+			restorePoller := pollers.NewPoller(custompollers.NewStorageShareRestorePoller(sharesClient, id), 5*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
+			if err := restorePoller.PollUntilDone(ctx); err != nil {
+				return fmt.Errorf("waiting for %s to be restored: %v", id, err)
+			}
+
+			// the Share is restored with the properties it had when it was deleted, so these need to be reconciled
+			// with the configuration - `enabled_protocol` can't be changed, so is omitted from the update
+			payload.Properties.EnabledProtocols = nil
+			if _, err = sharesClient.Update(ctx, id, payload); err != nil {
+				return fmt.Errorf("updating restored %s: %v", id, err)
+			}
+
+			d.SetId(id.ID())
+
+			return resourceStorageShareRead(d, meta)
+		}
+
+		log.Printf("[DEBUG] no soft-deleted version of %s was found - creating a new Share", id)
+	}
+
 	pollerType := custompollers.NewStorageShareCreatePoller(sharesClient, id, payload)
 	poller := pollers.NewPoller(pollerType, 5*time.Second, pollers.DefaultNumberOfDroppedConnectionsToAllow)
 
@@ -341,7 +404,7 @@ func resourceStorageShareCreate(d *pluginsdk.ResourceData, meta interface{}) err
 }
 
 func resourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error {
-	sharesClient := meta.(*clients.Client).Storage.ResourceManager.FileShares
+	sharesClient := meta.(*clients.Client).Storage.FileSharesClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
 	ctx, cancel := timeouts.ForRead(meta.(*clients.Client).StopContext, d)
 	defer cancel()
@@ -448,6 +511,8 @@ func resourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error
 			d.Set("access_tier", string(pointer.From(props.AccessTier)))
 			d.Set("acl", flattenStorageShareACLs(pointer.From(props.SignedIdentifiers)))
 			d.Set("metadata", FlattenMetaData(pointer.From(props.Metadata)))
+			d.Set("provisioned_iops", pointer.From(props.ProvisionedIops))
+			d.Set("provisioned_bandwidth_mibps", pointer.From(props.ProvisionedBandwidthMibps))
 		}
 	}
 
@@ -485,7 +550,7 @@ func resourceStorageShareRead(d *pluginsdk.ResourceData, meta interface{}) error
 func resourceStorageShareUpdate(d *pluginsdk.ResourceData, meta interface{}) error {
 	storageClient := meta.(*clients.Client).Storage
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	sharesClient := meta.(*clients.Client).Storage.ResourceManager.FileShares
+	sharesClient := meta.(*clients.Client).Storage.FileSharesClient
 	ctx, cancel := timeouts.ForUpdate(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -599,6 +664,14 @@ func resourceStorageShareUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		update.Properties.AccessTier = pointer.To(fileshares.ShareAccessTier(tier))
 	}
 
+	if d.HasChange("provisioned_iops") {
+		update.Properties.ProvisionedIops = pointer.To(int64(d.Get("provisioned_iops").(int)))
+	}
+
+	if d.HasChange("provisioned_bandwidth_mibps") {
+		update.Properties.ProvisionedBandwidthMibps = pointer.To(int64(d.Get("provisioned_bandwidth_mibps").(int)))
+	}
+
 	if _, err = sharesClient.Update(ctx, *id, update); err != nil {
 		return fmt.Errorf("updating %s: %v", id, err)
 	}
@@ -608,7 +681,7 @@ func resourceStorageShareUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 
 func resourceStorageShareDelete(d *pluginsdk.ResourceData, meta interface{}) error {
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
-	fileSharesClient := meta.(*clients.Client).Storage.ResourceManager.FileShares
+	fileSharesClient := meta.(*clients.Client).Storage.FileSharesClient
 	ctx, cancel := timeouts.ForDelete(meta.(*clients.Client).StopContext, d)
 	defer cancel()
 
@@ -657,6 +730,42 @@ func resourceStorageShareDelete(d *pluginsdk.ResourceData, meta interface{}) err
 	return nil
 }
 
+// findDeletedStorageShare returns the most recently deleted version of the specified Share which is still within its
+// soft-delete retention period, or `nil` if there isn't one.
+func findDeletedStorageShare(ctx context.Context, client *fileshares.FileSharesClient, id fileshares.ShareId) (*fileshares.DeletedShare, error) {
+	accountId := commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+
+	options := fileshares.ListOperationOptions{
+		Expand: pointer.To("deleted"),
+	}
+	resp, err := client.ListCompleteMatchingPredicate(ctx, accountId, options, fileshares.FileShareItemOperationPredicate{
+		Name: pointer.To(id.ShareName),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing deleted Shares for %s: %v", accountId, err)
+	}
+
+	var result *fileshares.DeletedShare
+	var latestDeletedTime string
+	for _, item := range resp.Items {
+		props := item.Properties
+		if props == nil || !pointer.From(props.Deleted) || pointer.From(props.Version) == "" {
+			continue
+		}
+
+		// RFC3339 timestamps in the same format sort lexically
+		if deletedTime := pointer.From(props.DeletedTime); result == nil || deletedTime > latestDeletedTime {
+			latestDeletedTime = deletedTime
+			result = &fileshares.DeletedShare{
+				DeletedShareName:    id.ShareName,
+				DeletedShareVersion: *props.Version,
+			}
+		}
+	}
+
+	return result, nil
+}
+
 func expandStorageShareACLsDeprecated(input []interface{}) []shares.SignedIdentifier {
 	results := make([]shares.SignedIdentifier, 0)
 
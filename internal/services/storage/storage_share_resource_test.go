@@ -6,12 +6,13 @@ package storage_test
 import (
 	"context"
 	"fmt"
+	"os"
 	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/hashicorp/go-azure-helpers/lang/pointer"
-	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2023-05-01/fileshares"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2024-01-01/fileshares"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
@@ -552,6 +553,64 @@ func TestAccStorageShare_protocolUpdate(t *testing.T) {
 	})
 }
 
+func TestAccStorageShare_restoreFromDeletedShare(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
+	r := StorageShareResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.softDeleteEnabled(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		{
+			Config: r.softDeleteEnabledTemplate(data),
+		},
+		{
+			Config: r.restoreFromDeletedShare(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("quota").HasValue("10"),
+			),
+		},
+		data.ImportStep("restore_from_deleted_share"),
+	})
+}
+
+func TestAccStorageShare_provisioned(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share", "test")
+	r := StorageShareResource{}
+
+	// provisioned IOPS and bandwidth require a Provisioned v2 Storage Account, which can't be created using `azurerm_storage_account`
+	accountId := os.Getenv("ARM_TEST_STORAGE_PROVISIONED_V2_ACCOUNT_ID")
+	if accountId == "" {
+		t.Skip("Skipping as ARM_TEST_STORAGE_PROVISIONED_V2_ACCOUNT_ID not set")
+		return
+	}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.provisioned(data, accountId, 3000, 125),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("provisioned_iops").HasValue("3000"),
+				check.That(data.ResourceName).Key("provisioned_bandwidth_mibps").HasValue("125"),
+			),
+		},
+		data.ImportStep(),
+		{
+			Config: r.provisioned(data, accountId, 4000, 150),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("provisioned_iops").HasValue("4000"),
+				check.That(data.ResourceName).Key("provisioned_bandwidth_mibps").HasValue("150"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
 func TestAccStorageShare_migrateToStorageID(t *testing.T) {
 	if features.FivePointOh() {
 		t.Skip("skipping as test is not valid in 5.0")
@@ -639,7 +698,7 @@ func (r StorageShareResource) Exists(ctx context.Context, client *clients.Client
 	if err != nil {
 		return nil, err
 	}
-	existing, err := client.Storage.ResourceManager.FileShares.Get(ctx, *id, fileshares.DefaultGetOperationOptions())
+	existing, err := client.Storage.FileSharesClient.Get(ctx, *id, fileshares.DefaultGetOperationOptions())
 	if err != nil {
 		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
 	}
@@ -1304,6 +1363,74 @@ resource "azurerm_storage_share" "test" {
 `, r.template(data), data.RandomString)
 }
 
+func (r StorageShareResource) softDeleteEnabled(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share" "test" {
+  name               = "testshare%s"
+  storage_account_id = azurerm_storage_account.test.id
+  quota              = 5
+}
+`, r.softDeleteEnabledTemplate(data), data.RandomString)
+}
+
+func (r StorageShareResource) restoreFromDeletedShare(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share" "test" {
+  name                       = "testshare%s"
+  storage_account_id         = azurerm_storage_account.test.id
+  quota                      = 10
+  restore_from_deleted_share = true
+}
+`, r.softDeleteEnabledTemplate(data), data.RandomString)
+}
+
+func (r StorageShareResource) provisioned(data acceptance.TestData, accountId string, iops, bandwidth int) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_storage_share" "test" {
+  name                        = "testshare%s"
+  storage_account_id          = "%s"
+  quota                       = 100
+  provisioned_iops            = %d
+  provisioned_bandwidth_mibps = %d
+}
+`, data.RandomString, accountId, iops, bandwidth)
+}
+
+func (r StorageShareResource) softDeleteEnabledTemplate(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+resource "azurerm_resource_group" "test" {
+  name     = "acctestRG-%d"
+  location = "%s"
+}
+
+resource "azurerm_storage_account" "test" {
+  name                     = "acctestacc%s"
+  resource_group_name      = azurerm_resource_group.test.name
+  location                 = azurerm_resource_group.test.location
+  account_tier             = "Standard"
+  account_replication_type = "LRS"
+
+  share_properties {
+    retention_policy {
+      days = 7
+    }
+  }
+}
+`, data.RandomInteger, data.Locations.Primary, data.RandomString)
+}
+
 func (r StorageShareResource) template(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 provider "azurerm" {
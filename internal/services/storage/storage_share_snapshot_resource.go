@@ -0,0 +1,182 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2024-01-01/fileshares"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/validate"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/validation"
+)
+
+type StorageShareSnapshotResource struct{}
+
+var _ sdk.Resource = StorageShareSnapshotResource{}
+
+type StorageShareSnapshotModel struct {
+	StorageShareId string            `tfschema:"storage_share_id"`
+	Metadata       map[string]string `tfschema:"metadata"`
+	SnapshotTime   string            `tfschema:"snapshot_time"`
+}
+
+func (r StorageShareSnapshotResource) ResourceType() string {
+	return "azurerm_storage_share_snapshot"
+}
+
+func (r StorageShareSnapshotResource) IDValidationFunc() pluginsdk.SchemaValidateFunc {
+	return validate.StorageShareSnapshotID
+}
+
+func (r StorageShareSnapshotResource) ModelObject() interface{} {
+	return &StorageShareSnapshotModel{}
+}
+
+func (r StorageShareSnapshotResource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_share_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: fileshares.ValidateShareID,
+		},
+
+		// the metadata of a Share Snapshot can't be changed once it's been taken
+		"metadata": {
+			Type:     pluginsdk.TypeMap,
+			Optional: true,
+			ForceNew: true,
+			Elem: &pluginsdk.Schema{
+				Type:         pluginsdk.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+	}
+}
+
+func (r StorageShareSnapshotResource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"snapshot_time": {
+			Type:     pluginsdk.TypeString,
+			Computed: true,
+		},
+	}
+}
+
+func (r StorageShareSnapshotResource) Create() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.FileSharesClient
+
+			var config StorageShareSnapshotModel
+			if err := metadata.Decode(&config); err != nil {
+				return fmt.Errorf("decoding: %+v", err)
+			}
+
+			shareId, err := fileshares.ParseShareID(config.StorageShareId)
+			if err != nil {
+				return err
+			}
+
+			payload := fileshares.FileShare{
+				Properties: &fileshares.FileShareProperties{},
+			}
+			if len(config.Metadata) > 0 {
+				payload.Properties.Metadata = pointer.To(config.Metadata)
+			}
+
+			// a Snapshot is taken by "creating" the Share with the `snapshots` expansion, the name of the new Snapshot
+			// being the time at which it was taken
+			options := fileshares.CreateOperationOptions{
+				Expand: pointer.To("snapshots"),
+			}
+			resp, err := client.Create(ctx, *shareId, payload, options)
+			if err != nil {
+				return fmt.Errorf("creating Snapshot of %s: %+v", shareId, err)
+			}
+
+			if resp.Model == nil || resp.Model.Properties == nil || pointer.From(resp.Model.Properties.SnapshotTime) == "" {
+				return fmt.Errorf("creating Snapshot of %s: `snapshotTime` was nil", shareId)
+			}
+
+			id := parse.NewStorageShareSnapshotID(shareId.SubscriptionId, shareId.ResourceGroupName, shareId.StorageAccountName, "default", shareId.ShareName, *resp.Model.Properties.SnapshotTime)
+
+			metadata.SetID(id)
+			return nil
+		},
+	}
+}
+
+func (r StorageShareSnapshotResource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.FileSharesClient
+
+			id, err := parse.StorageShareSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			shareId := fileshares.NewShareID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.ShareName)
+
+			options := fileshares.GetOperationOptions{
+				XMsSnapshot: pointer.To(id.SnapshotName),
+			}
+			resp, err := client.Get(ctx, shareId, options)
+			if err != nil {
+				if response.WasNotFound(resp.HttpResponse) {
+					return metadata.MarkAsGone(id)
+				}
+				return fmt.Errorf("retrieving %s: %+v", id, err)
+			}
+
+			state := StorageShareSnapshotModel{
+				StorageShareId: shareId.ID(),
+				SnapshotTime:   id.SnapshotName,
+			}
+
+			if model := resp.Model; model != nil && model.Properties != nil {
+				state.Metadata = pointer.From(model.Properties.Metadata)
+			}
+
+			return metadata.Encode(&state)
+		},
+	}
+}
+
+func (r StorageShareSnapshotResource) Delete() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 30 * time.Minute,
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.FileSharesClient
+
+			id, err := parse.StorageShareSnapshotID(metadata.ResourceData.Id())
+			if err != nil {
+				return err
+			}
+
+			shareId := fileshares.NewShareID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.ShareName)
+
+			options := fileshares.DeleteOperationOptions{
+				XMsSnapshot: pointer.To(id.SnapshotName),
+			}
+			if resp, err := client.Delete(ctx, shareId, options); err != nil {
+				if !response.WasNotFound(resp.HttpResponse) {
+					return fmt.Errorf("deleting %s: %+v", id, err)
+				}
+			}
+
+			return nil
+		},
+	}
+}
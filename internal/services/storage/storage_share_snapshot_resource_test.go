@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/lang/response"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2024-01-01/fileshares"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type StorageShareSnapshotResource struct{}
+
+func TestAccStorageShareSnapshot_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_snapshot", "test")
+	r := StorageShareSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basic(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("snapshot_time").Exists(),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func TestAccStorageShareSnapshot_metadata(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_storage_share_snapshot", "test")
+	r := StorageShareSnapshotResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.metadata(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("metadata.%").HasValue("1"),
+			),
+		},
+		data.ImportStep(),
+	})
+}
+
+func (r StorageShareSnapshotResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
+	id, err := parse.StorageShareSnapshotID(state.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	shareId := fileshares.NewShareID(id.SubscriptionId, id.ResourceGroup, id.StorageAccountName, id.ShareName)
+	resp, err := client.Storage.FileSharesClient.Get(ctx, shareId, fileshares.GetOperationOptions{
+		XMsSnapshot: pointer.To(id.SnapshotName),
+	})
+	if err != nil {
+		if response.WasNotFound(resp.HttpResponse) {
+			return pointer.To(false), nil
+		}
+		return nil, fmt.Errorf("retrieving %s: %+v", id, err)
+	}
+
+	return pointer.To(true), nil
+}
+
+func (r StorageShareSnapshotResource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_snapshot" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+}
+`, StorageShareResource{}.basic(data))
+}
+
+func (r StorageShareSnapshotResource) metadata(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_snapshot" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+
+  metadata = {
+    purpose = "backup"
+  }
+}
+`, StorageShareResource{}.basic(data))
+}
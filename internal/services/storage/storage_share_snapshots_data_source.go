@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
+	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonids"
+	"github.com/hashicorp/go-azure-sdk/resource-manager/storage/2024-01-01/fileshares"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/sdk"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/services/storage/parse"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/tf/pluginsdk"
+)
+
+type storageShareSnapshotsDataSource struct{}
+
+var _ sdk.DataSource = storageShareSnapshotsDataSource{}
+
+type storageShareSnapshotsDataSourceModel struct {
+	StorageShareId string               `tfschema:"storage_share_id"`
+	Snapshots      []shareSnapshotModel `tfschema:"snapshots"`
+}
+
+type shareSnapshotModel struct {
+	Id           string            `tfschema:"id"`
+	SnapshotTime string            `tfschema:"snapshot_time"`
+	Metadata     map[string]string `tfschema:"metadata"`
+}
+
+func (r storageShareSnapshotsDataSource) Arguments() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"storage_share_id": {
+			Type:         pluginsdk.TypeString,
+			Required:     true,
+			ValidateFunc: fileshares.ValidateShareID,
+		},
+	}
+}
+
+func (r storageShareSnapshotsDataSource) Attributes() map[string]*pluginsdk.Schema {
+	return map[string]*pluginsdk.Schema{
+		"snapshots": {
+			Type:     pluginsdk.TypeList,
+			Computed: true,
+			Elem: &pluginsdk.Resource{
+				Schema: map[string]*pluginsdk.Schema{
+					"id": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"snapshot_time": {
+						Type:     pluginsdk.TypeString,
+						Computed: true,
+					},
+					"metadata": {
+						Type:     pluginsdk.TypeMap,
+						Computed: true,
+						Elem: &pluginsdk.Schema{
+							Type: pluginsdk.TypeString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r storageShareSnapshotsDataSource) ResourceType() string {
+	return "azurerm_storage_share_snapshots"
+}
+
+func (r storageShareSnapshotsDataSource) ModelObject() interface{} {
+	return &storageShareSnapshotsDataSourceModel{}
+}
+
+func (r storageShareSnapshotsDataSource) Read() sdk.ResourceFunc {
+	return sdk.ResourceFunc{
+		Timeout: 5 * time.Minute,
+
+		Func: func(ctx context.Context, metadata sdk.ResourceMetaData) error {
+			client := metadata.Client.Storage.FileSharesClient
+
+			var plan storageShareSnapshotsDataSourceModel
+			if err := metadata.Decode(&plan); err != nil {
+				return fmt.Errorf("decoding %+v", err)
+			}
+
+			id, err := fileshares.ParseShareID(plan.StorageShareId)
+			if err != nil {
+				return err
+			}
+
+			accountId := commonids.NewStorageAccountID(id.SubscriptionId, id.ResourceGroupName, id.StorageAccountName)
+
+			options := fileshares.ListOperationOptions{
+				Expand: pointer.To("snapshots"),
+			}
+			resp, err := client.ListCompleteMatchingPredicate(ctx, accountId, options, fileshares.FileShareItemOperationPredicate{
+				Name: pointer.To(id.ShareName),
+			})
+			if err != nil {
+				return fmt.Errorf("listing Snapshots for %s: %+v", id, err)
+			}
+
+			plan.Snapshots = flattenStorageShareSnapshots(resp.Items, *id)
+
+			if err := metadata.Encode(&plan); err != nil {
+				return fmt.Errorf("encoding %s: %+v", id, err)
+			}
+
+			metadata.SetID(id)
+
+			return nil
+		},
+	}
+}
+
+func flattenStorageShareSnapshots(input []fileshares.FileShareItem, shareId fileshares.ShareId) []shareSnapshotModel {
+	output := make([]shareSnapshotModel, 0)
+	for _, item := range input {
+		// the listing contains the Share itself alongside its Snapshots, which is the only item without a snapshot time
+		if item.Properties == nil || pointer.From(item.Properties.SnapshotTime) == "" {
+			continue
+		}
+
+		snapshotTime := *item.Properties.SnapshotTime
+		output = append(output, shareSnapshotModel{
+			Id:           parse.NewStorageShareSnapshotID(shareId.SubscriptionId, shareId.ResourceGroupName, shareId.StorageAccountName, "default", shareId.ShareName, snapshotTime).ID(),
+			SnapshotTime: snapshotTime,
+			Metadata:     pointer.From(item.Properties.Metadata),
+		})
+	}
+
+	return output
+}
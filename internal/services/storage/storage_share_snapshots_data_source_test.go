@@ -0,0 +1,53 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package storage_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
+	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance/check"
+)
+
+type storageShareSnapshotsDataSource struct{}
+
+func TestAccDataSourceStorageShareSnapshots_basic(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_storage_share_snapshots", "test")
+	d := storageShareSnapshotsDataSource{}
+
+	data.DataSourceTest(t, []resource.TestStep{
+		{
+			Config: d.basic(data),
+			Check: resource.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("snapshots.#").HasValue("2"),
+				check.That(data.ResourceName).Key("snapshots.0.id").Exists(),
+				check.That(data.ResourceName).Key("snapshots.0.snapshot_time").Exists(),
+			),
+		},
+	})
+}
+
+func (d storageShareSnapshotsDataSource) basic(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+resource "azurerm_storage_share_snapshot" "first" {
+  storage_share_id = azurerm_storage_share.test.id
+}
+
+resource "azurerm_storage_share_snapshot" "second" {
+  storage_share_id = azurerm_storage_share.test.id
+
+  depends_on = [azurerm_storage_share_snapshot.first]
+}
+
+data "azurerm_storage_share_snapshots" "test" {
+  storage_share_id = azurerm_storage_share.test.id
+
+  depends_on = [azurerm_storage_share_snapshot.second]
+}
+`, StorageShareResource{}.basic(data))
+}
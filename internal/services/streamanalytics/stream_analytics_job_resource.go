@@ -4,6 +4,7 @@
 package streamanalytics
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"time"
@@ -19,6 +20,7 @@ import (
 	"github.com/hashicorp/go-azure-sdk/resource-manager/streamanalytics/2021-10-01-preview/streamingjobs"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-azurerm/helpers/tf"
+	"github.com/hashicorp/terraform-provider-azurerm/helpers/validate"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/clients"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/locks"
 	"github.com/hashicorp/terraform-provider-azurerm/internal/services/streamanalytics/migration"
@@ -27,6 +29,11 @@ import (
 	"github.com/hashicorp/terraform-provider-azurerm/internal/timeouts"
 )
 
+const (
+	streamAnalyticsJobStateRunning = "Running"
+	streamAnalyticsJobStateStopped = "Stopped"
+)
+
 func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 	return &pluginsdk.Resource{
 		Create: resourceStreamAnalyticsJobCreate,
@@ -44,6 +51,10 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 			0: migration.StreamAnalyticsJobV0ToV1{},
 		}),
 
+		CustomizeDiff: pluginsdk.CustomDiffWithAll(
+			resourceStreamAnalyticsJobValidateStartParameters,
+		),
+
 		Timeouts: &pluginsdk.ResourceTimeout{
 			Create: pluginsdk.DefaultTimeout(30 * time.Minute),
 			Read:   pluginsdk.DefaultTimeout(5 * time.Minute),
@@ -190,6 +201,33 @@ func resourceStreamAnalyticsJob() *pluginsdk.Resource {
 
 			"identity": commonschema.SystemOrUserAssignedIdentityOptional(),
 
+			"desired_state": {
+				Type:     pluginsdk.TypeString,
+				Optional: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					streamAnalyticsJobStateRunning,
+					streamAnalyticsJobStateStopped,
+				}, false),
+			},
+
+			"output_start_mode": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"desired_state"},
+				ValidateFunc: validation.StringInSlice([]string{
+					string(streamingjobs.OutputStartModeCustomTime),
+					string(streamingjobs.OutputStartModeJobStartTime),
+					string(streamingjobs.OutputStartModeLastOutputEventTime),
+				}, false),
+			},
+
+			"output_start_time": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				RequiredWith: []string{"output_start_mode"},
+				ValidateFunc: validate.ISO8601DateTime,
+			},
+
 			"job_id": {
 				Type:     pluginsdk.TypeString,
 				Computed: true,
@@ -331,6 +369,12 @@ func resourceStreamAnalyticsJobCreate(d *pluginsdk.ResourceData, meta interface{
 
 	d.SetId(id.ID())
 
+	if d.Get("desired_state").(string) == streamAnalyticsJobStateRunning {
+		if err := client.StartThenPoll(ctx, id, expandStreamAnalyticsJobStartParameters(d)); err != nil {
+			return fmt.Errorf("starting %s: %+v", id, err)
+		}
+	}
+
 	return resourceStreamAnalyticsJobRead(d, meta)
 }
 
@@ -398,6 +442,16 @@ func resourceStreamAnalyticsJobRead(d *pluginsdk.ResourceData, meta interface{})
 			d.Set("sku_name", sku)
 			d.Set("content_storage_policy", pointer.From(props.ContentStoragePolicy))
 			d.Set("job_id", pointer.From(props.JobId))
+
+			// the job state is only tracked when it's managed through this resource, since it can also be
+			// controlled using the `azurerm_stream_analytics_job_schedule` resource
+			if d.Get("desired_state").(string) != "" {
+				desiredState := streamAnalyticsJobStateStopped
+				if streamAnalyticsJobIsRunning(props.JobState) {
+					desiredState = streamAnalyticsJobStateRunning
+				}
+				d.Set("desired_state", desiredState)
+			}
 			d.Set("job_storage_account", flattenJobStorageAccount(d, props.JobStorageAccount))
 
 			if transformation := props.Transformation; transformation != nil {
@@ -443,6 +497,21 @@ func resourceStreamAnalyticsJobUpdate(d *pluginsdk.ResourceData, meta interface{
 
 	payload := existing.Model
 
+	startParameters := expandStreamAnalyticsJobStartParameters(d)
+	desiredState := d.Get("desired_state").(string)
+	wasRunning := streamAnalyticsJobIsRunning(payload.Properties.JobState)
+
+	// most properties of a Stream Analytics Job (including the transformation) can't be changed whilst the job is
+	// running, so when the job state is managed by this resource the job is stopped before applying these changes
+	// and (if desired) started again afterwards
+	requiresStop := d.HasChangesExcept("tags", "desired_state", "output_start_mode", "output_start_time")
+	if desiredState != "" && wasRunning && (requiresStop || desiredState == streamAnalyticsJobStateStopped) {
+		log.Printf("[DEBUG] Stopping %s..", *id)
+		if err := client.StopThenPoll(ctx, *id); err != nil {
+			return fmt.Errorf("stopping %s: %+v", *id, err)
+		}
+	}
+
 	if d.HasChange("stream_analytics_cluster_id") {
 		clusterId := d.Get("stream_analytics_cluster_id").(string)
 		if d.Get("type").(string) == string(streamingjobs.JobTypeEdge) {
@@ -542,6 +611,21 @@ func resourceStreamAnalyticsJobUpdate(d *pluginsdk.ResourceData, meta interface{
 		}
 	}
 
+	if desiredState == streamAnalyticsJobStateRunning {
+		if wasRunning && requiresStop && payload.Properties.LastOutputEventTime != nil {
+			// resume from where the job stopped to avoid losing or duplicating any output
+			startParameters.OutputStartMode = pointer.To(streamingjobs.OutputStartModeLastOutputEventTime)
+			startParameters.OutputStartTime = nil
+		}
+
+		if !wasRunning || requiresStop {
+			log.Printf("[DEBUG] Starting %s..", *id)
+			if err := client.StartThenPoll(ctx, *id, startParameters); err != nil {
+				return fmt.Errorf("starting %s: %+v", *id, err)
+			}
+		}
+	}
+
 	return resourceStreamAnalyticsJobRead(d, meta)
 }
 
@@ -562,6 +646,55 @@ func resourceStreamAnalyticsJobDelete(d *pluginsdk.ResourceData, meta interface{
 	return nil
 }
 
+// resourceStreamAnalyticsJobValidateStartParameters ensures that the parameters used to start the Stream Analytics Job
+// are consistent with one another
+func resourceStreamAnalyticsJobValidateStartParameters(_ context.Context, diff *pluginsdk.ResourceDiff, _ interface{}) error {
+	if !diff.NewValueKnown("desired_state") || !diff.NewValueKnown("output_start_mode") || !diff.NewValueKnown("output_start_time") {
+		return nil
+	}
+
+	outputStartMode := diff.Get("output_start_mode").(string)
+	if diff.Id() == "" && diff.Get("desired_state").(string) == streamAnalyticsJobStateRunning && outputStartMode == string(streamingjobs.OutputStartModeLastOutputEventTime) {
+		return fmt.Errorf("`output_start_mode` cannot be `LastOutputEventTime` when creating a Stream Analytics Job, since it has not produced any output yet")
+	}
+
+	outputStartTime := diff.Get("output_start_time").(string)
+	if outputStartMode == string(streamingjobs.OutputStartModeCustomTime) && outputStartTime == "" {
+		return fmt.Errorf("`output_start_time` must be specified when `output_start_mode` is set to `CustomTime`")
+	}
+	if outputStartMode != string(streamingjobs.OutputStartModeCustomTime) && outputStartTime != "" {
+		return fmt.Errorf("`output_start_time` can only be specified when `output_start_mode` is set to `CustomTime`")
+	}
+
+	return nil
+}
+
+func expandStreamAnalyticsJobStartParameters(d *pluginsdk.ResourceData) streamingjobs.StartStreamingJobParameters {
+	outputStartMode := streamingjobs.OutputStartModeJobStartTime
+	if v := d.Get("output_start_mode").(string); v != "" {
+		outputStartMode = streamingjobs.OutputStartMode(v)
+	}
+
+	params := streamingjobs.StartStreamingJobParameters{
+		OutputStartMode: pointer.To(outputStartMode),
+	}
+
+	if outputStartMode == streamingjobs.OutputStartModeCustomTime {
+		params.OutputStartTime = pointer.To(d.Get("output_start_time").(string))
+	}
+
+	return params
+}
+
+func streamAnalyticsJobIsRunning(input *string) bool {
+	switch pointer.From(input) {
+	case "Running", "Starting", "Degraded", "Restarting", "Scaling":
+		return true
+	}
+
+	return false
+}
+
 func expandJobStorageAccount(input []interface{}) *streamingjobs.JobStorageAccount {
 	if len(input) == 0 {
 		return nil
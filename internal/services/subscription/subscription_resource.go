@@ -111,6 +111,25 @@ func resourceSubscription() *pluginsdk.Resource {
 				ValidateFunc: validation.IsUUID,
 			},
 
+			"management_group_id": {
+				Type:         pluginsdk.TypeString,
+				Optional:     true,
+				Description:  "The ID of the Management Group in which the Subscription should be placed when it is created.",
+				ValidateFunc: commonids.ValidateManagementGroupID,
+				// The Management Group is only used when creating the Subscription, after which the placement is managed
+				// using the `azurerm_management_group_subscription_association` resource, so this isn't read back
+				DiffSuppressFunc: func(k, old, new string, d *pluginsdk.ResourceData) bool {
+					return d.Id() != ""
+				},
+			},
+
+			"enabled": {
+				Type:        pluginsdk.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Should the Subscription be enabled? Setting this to `false` cancels the Subscription without removing it from the State.",
+			},
+
 			"tenant_id": {
 				Type:        pluginsdk.TypeString,
 				Description: "The Tenant ID to which the subscription belongs",
@@ -196,7 +215,7 @@ func resourceSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 
 		// Disabled and Warned are both "effectively" cancelled states,
-		if *existingSub.Model.State == subscriptions.SubscriptionStateDisabled || *existingSub.Model.State == subscriptions.SubscriptionStateWarned {
+		if d.Get("enabled").(bool) && subscriptionIsCancelled(existingSub.Model.State) {
 			log.Printf("[DEBUG] Existing subscription in Disabled/Cancelled state Terraform will attempt to re-activate it")
 			if _, err := aliasClient.SubscriptionEnable(ctx, subscriptionResourceId); err != nil {
 				return fmt.Errorf("enabling Subscription %q: %+v", subscriptionId, err)
@@ -211,6 +230,14 @@ func resourceSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		// If we're not assuming control of an existing Subscription, we need to know where to create it.
 		req.Properties.DisplayName = utils.String(d.Get("subscription_name").(string))
 		req.Properties.BillingScope = utils.String(d.Get("billing_scope_id").(string))
+
+		// tags and the Management Group can only be specified when creating a new Subscription
+		req.Properties.AdditionalProperties = &subscriptionAlias.PutAliasRequestAdditionalProperties{
+			Tags: tags.Expand(d.Get("tags").(map[string]interface{})),
+		}
+		if v := d.Get("management_group_id").(string); v != "" {
+			req.Properties.AdditionalProperties.ManagementGroupId = pointer.To(v)
+		}
 	}
 
 	if err := aliasClient.AliasCreateThenPoll(ctx, id, req); err != nil {
@@ -229,11 +256,15 @@ func resourceSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) err
 	createDeadline := time.Until(deadline)
 
 	subscriptionResourceId := commonids.NewSubscriptionID(*alias.Model.Properties.SubscriptionId)
-	if err := waitForSubscriptionStateToSettle(ctx, client, subscriptionResourceId, "Active", createDeadline); err != nil {
-		return fmt.Errorf("failed waiting for Subscription %q (Alias %q) to enter %q state: %+v", *alias.Model.Properties.SubscriptionId, id.AliasName, "Active", err)
+	// an existing Subscription which is cancelled isn't re-enabled when `enabled` is `false`
+	if _, existingSubscription := d.GetOk("subscription_id"); !existingSubscription || d.Get("enabled").(bool) {
+		if err := waitForSubscriptionStateToSettle(ctx, client, subscriptionResourceId, "Active", createDeadline); err != nil {
+			return fmt.Errorf("failed waiting for Subscription %q (Alias %q) to enter %q state: %+v", *alias.Model.Properties.SubscriptionId, id.AliasName, "Active", err)
+		}
 	}
 
-	if d.HasChange("tags") {
+	// tags are set on the Alias when creating a new Subscription, so only need to be set for an existing Subscription
+	if _, ok := d.GetOk("subscription_id"); ok && d.HasChange("tags") {
 		tagsClient := meta.(*clients.Client).Resource.TagsClient
 		t := tags.Expand(d.Get("tags").(map[string]interface{}))
 		scope := commonids.NewScopeID(commonids.NewSubscriptionID(*alias.Model.Properties.SubscriptionId).ID())
@@ -247,6 +278,12 @@ func resourceSubscriptionCreate(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
+	if !d.Get("enabled").(bool) {
+		if err := cancelSubscription(ctx, meta, subscriptionResourceId); err != nil {
+			return fmt.Errorf("cancelling Subscription %q (Alias %q): %+v", subscriptionResourceId.SubscriptionId, id.AliasName, err)
+		}
+	}
+
 	d.SetId(id.ID())
 
 	return resourceSubscriptionRead(d, meta)
@@ -271,10 +308,17 @@ func resourceSubscriptionUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 
 	subscriptionId := commonids.NewSubscriptionID(*resp.Model.Properties.SubscriptionId)
 
-	if d.HasChange("subscription_name") {
-		locks.ByID(subscriptionId.ID())
-		defer locks.UnlockByID(subscriptionId.ID())
+	locks.ByID(subscriptionId.ID())
+	defer locks.UnlockByID(subscriptionId.ID())
+
+	// a Subscription must be enabled before it can be renamed, so this needs to happen first
+	if d.HasChange("enabled") && d.Get("enabled").(bool) {
+		if err := enableSubscription(ctx, meta, subscriptionId); err != nil {
+			return fmt.Errorf("enabling Subscription %q (Alias %q): %+v", subscriptionId.SubscriptionId, id.AliasName, err)
+		}
+	}
 
+	if d.HasChange("subscription_name") {
 		displayName := subscriptionAlias.SubscriptionName{
 			SubscriptionName: utils.String(d.Get("subscription_name").(string)),
 		}
@@ -297,6 +341,12 @@ func resourceSubscriptionUpdate(d *pluginsdk.ResourceData, meta interface{}) err
 		}
 	}
 
+	if d.HasChange("enabled") && !d.Get("enabled").(bool) {
+		if err := cancelSubscription(ctx, meta, subscriptionId); err != nil {
+			return fmt.Errorf("cancelling Subscription %q (Alias %q): %+v", subscriptionId.SubscriptionId, id.AliasName, err)
+		}
+	}
+
 	return nil
 }
 
@@ -326,6 +376,7 @@ func resourceSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) error
 	subscriptionId := ""
 	subscriptionName := ""
 	tenantId := ""
+	enabled := true
 	var t *map[string]string
 	if props := alias.Model.Properties; props != nil && props.SubscriptionId != nil {
 		subscriptionId = *props.SubscriptionId
@@ -341,6 +392,7 @@ func resourceSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) error
 		if model := resp.Model; model != nil {
 			subscriptionName = pointer.From(model.DisplayName)
 			tenantId = pointer.From(model.TenantId)
+			enabled = !subscriptionIsCancelled(model.State)
 			t = model.Tags
 		}
 	}
@@ -350,6 +402,7 @@ func resourceSubscriptionRead(d *pluginsdk.ResourceData, meta interface{}) error
 	d.Set("subscription_id", subscriptionId)
 	d.Set("subscription_name", subscriptionName)
 	d.Set("tenant_id", tenantId)
+	d.Set("enabled", enabled)
 	if err := tags.FlattenAndSet(d, t); err != nil {
 		return err
 	}
@@ -421,7 +474,9 @@ func resourceSubscriptionDelete(d *pluginsdk.ResourceData, meta interface{}) err
 	}
 
 	// Cancel the Subscription
-	if !meta.(*clients.Client).Features.Subscription.PreventCancellationOnDestroy {
+	if subscriptionIsCancelled(sub.Model.State) {
+		log.Printf("[DEBUG] Skipping cancellation of subscription %s since it's already cancelled", subscriptionId)
+	} else if !meta.(*clients.Client).Features.Subscription.PreventCancellationOnDestroy {
 		log.Printf("[DEBUG] Cancelling subscription %s", subscriptionId)
 
 		opts := subscriptionAlias.DefaultSubscriptionCancelOperationOptions()
@@ -498,6 +553,58 @@ func waitForSubscriptionStateToSettle(ctx context.Context, client *subscriptions
 	return nil
 }
 
+// subscriptionIsCancelled returns whether the Subscription is in one of the "effectively" cancelled states
+func subscriptionIsCancelled(input *subscriptions.SubscriptionState) bool {
+	if input == nil {
+		return false
+	}
+
+	return *input == subscriptions.SubscriptionStateDisabled || *input == subscriptions.SubscriptionStateWarned
+}
+
+func cancelSubscription(ctx context.Context, meta interface{}, subscriptionId commonids.SubscriptionId) error {
+	aliasClient := meta.(*clients.Client).Subscription.AliasClient
+	client := meta.(*clients.Client).Subscription.SubscriptionsClient
+
+	existing, err := client.Get(ctx, subscriptionId)
+	if err != nil {
+		return fmt.Errorf("retrieving %s: %+v", subscriptionId, err)
+	}
+	if model := existing.Model; model != nil && subscriptionIsCancelled(model.State) {
+		log.Printf("[DEBUG] %s is already cancelled", subscriptionId)
+		return nil
+	}
+
+	log.Printf("[DEBUG] Cancelling %s", subscriptionId)
+	if _, err := aliasClient.SubscriptionCancel(ctx, subscriptionId, subscriptionAlias.DefaultSubscriptionCancelOperationOptions()); err != nil {
+		return err
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context with no deadline")
+	}
+
+	return waitForSubscriptionStateToSettle(ctx, client, subscriptionId, "Cancelled", time.Until(deadline))
+}
+
+func enableSubscription(ctx context.Context, meta interface{}, subscriptionId commonids.SubscriptionId) error {
+	aliasClient := meta.(*clients.Client).Subscription.AliasClient
+	client := meta.(*clients.Client).Subscription.SubscriptionsClient
+
+	log.Printf("[DEBUG] Enabling %s", subscriptionId)
+	if _, err := aliasClient.SubscriptionEnable(ctx, subscriptionId); err != nil {
+		return err
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return fmt.Errorf("internal-error: context with no deadline")
+	}
+
+	return waitForSubscriptionStateToSettle(ctx, client, subscriptionId, "Active", time.Until(deadline))
+}
+
 func checkExistingAliases(ctx context.Context, client subscriptionAlias.SubscriptionsClient, subscriptionId string) (*string, int, error) {
 	aliasList, err := client.AliasListComplete(ctx)
 	if err != nil {
@@ -97,6 +97,62 @@ func TestAccSubscriptionResource_devTest(t *testing.T) {
 	})
 }
 
+func TestAccSubscriptionResource_managementGroup(t *testing.T) {
+	if os.Getenv("ARM_BILLING_ACCOUNT") == "" {
+		t.Skip("skipping tests - no billing account data provided")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_subscription", "test")
+	r := SubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managementGroup(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("tags.%").HasValue("1"),
+			),
+		},
+		data.ImportStep("billing_scope_id", "management_group_id"),
+	})
+}
+
+func TestAccSubscriptionResource_enabled(t *testing.T) {
+	if os.Getenv("ARM_BILLING_ACCOUNT") == "" {
+		t.Skip("skipping tests - no billing account data provided")
+	}
+
+	data := acceptance.BuildTestData(t, "azurerm_subscription", "test")
+	r := SubscriptionResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.basicEnrollmentAccount(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("billing_scope_id"),
+		{
+			Config: r.enabled(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("false"),
+			),
+		},
+		data.ImportStep("billing_scope_id"),
+		{
+			Config: r.enabled(data, true),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("enabled").HasValue("true"),
+			),
+		},
+		data.ImportStep("billing_scope_id"),
+	})
+}
+
 func (SubscriptionResource) Exists(ctx context.Context, client *clients.Client, state *pluginsdk.InstanceState) (*bool, error) {
 	id, err := subscriptions.ParseAliasID(state.ID)
 	if err != nil {
@@ -184,6 +240,62 @@ resource "azurerm_subscription" "test" {
 `, billingAccount, enrollmentAccount, data.RandomInteger)
 }
 
+func (SubscriptionResource) managementGroup(data acceptance.TestData) string {
+	billingAccount := os.Getenv("ARM_BILLING_ACCOUNT")
+	billingProfile := os.Getenv("ARM_BILLING_PROFILE")
+	invoiceSection := os.Getenv("ARM_INVOICE_SECTION")
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_billing_mca_account_scope" "test" {
+  billing_account_name = "%[1]s"
+  billing_profile_name = "%[2]s"
+  invoice_section_name = "%[3]s"
+}
+
+resource "azurerm_management_group" "test" {
+  display_name = "acctestmg-%[4]d"
+}
+
+resource "azurerm_subscription" "test" {
+  alias               = "testAcc-%[4]d"
+  subscription_name   = "testAccSubscription %[4]d"
+  billing_scope_id    = data.azurerm_billing_mca_account_scope.test.id
+  management_group_id = azurerm_management_group.test.id
+
+  tags = {
+    environment = "Test"
+  }
+}
+`, billingAccount, billingProfile, invoiceSection, data.RandomInteger)
+}
+
+func (SubscriptionResource) enabled(data acceptance.TestData, enabled bool) string {
+	billingAccount := os.Getenv("ARM_BILLING_ACCOUNT")
+	billingProfile := os.Getenv("ARM_BILLING_PROFILE")
+	invoiceSection := os.Getenv("ARM_INVOICE_SECTION")
+	return fmt.Sprintf(`
+provider "azurerm" {
+  features {}
+}
+
+data "azurerm_billing_mca_account_scope" "test" {
+  billing_account_name = "%[1]s"
+  billing_profile_name = "%[2]s"
+  invoice_section_name = "%[3]s"
+}
+
+resource "azurerm_subscription" "test" {
+  alias             = "testAcc-%[4]d"
+  subscription_name = "testAccSubscription %[4]d"
+  billing_scope_id  = data.azurerm_billing_mca_account_scope.test.id
+  enabled           = %[5]t
+}
+`, billingAccount, billingProfile, invoiceSection, data.RandomInteger, enabled)
+}
+
 func (r SubscriptionResource) requiresImport(data acceptance.TestData) string {
 	return fmt.Sprintf(`
 %s
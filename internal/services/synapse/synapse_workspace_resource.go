@@ -106,13 +106,11 @@ func resourceSynapseWorkspace() *pluginsdk.Resource {
 			"data_exfiltration_protection_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
-				ForceNew: true,
 			},
 
 			"managed_virtual_network_enabled": {
 				Type:     pluginsdk.TypeBool,
 				Optional: true,
-				ForceNew: true,
 			},
 
 			"connectivity_endpoints": {
@@ -270,9 +268,35 @@ func resourceSynapseWorkspace() *pluginsdk.Resource {
 
 			"tags": tags.Schema(),
 		},
+
+		CustomizeDiff: pluginsdk.CustomizeDiffShim(synapseWorkspaceCustomizeDiff),
 	}
 }
 
+func synapseWorkspaceCustomizeDiff(ctx context.Context, d *pluginsdk.ResourceDiff, v interface{}) error {
+	managedVirtualNetworkEnabled := d.Get("managed_virtual_network_enabled").(bool)
+
+	if d.Get("data_exfiltration_protection_enabled").(bool) && !managedVirtualNetworkEnabled {
+		return fmt.Errorf("`data_exfiltration_protection_enabled` can only be `true` when `managed_virtual_network_enabled` is `true`")
+	}
+
+	if len(d.Get("linking_allowed_for_aad_tenant_ids").([]interface{})) > 0 && !managedVirtualNetworkEnabled {
+		return fmt.Errorf("`linking_allowed_for_aad_tenant_ids` can only be specified when `managed_virtual_network_enabled` is `true`")
+	}
+
+	// these can only be set when the workspace is created, recreating the workspace would delete all of the pipelines,
+	// linked services and other artifacts within it - so rather than silently replacing the workspace we raise an error
+	if d.Id() != "" {
+		for _, key := range []string{"managed_virtual_network_enabled", "data_exfiltration_protection_enabled"} {
+			if d.HasChange(key) {
+				return fmt.Errorf("`%s` can only be set when the Synapse Workspace is created. Recreating the Synapse Workspace deletes all of the artifacts within it, to proceed mark the resource as tainted (e.g. using `terraform taint`) so that it's recreated", key)
+			}
+		}
+	}
+
+	return nil
+}
+
 func resourceSynapseWorkspaceCreate(d *pluginsdk.ResourceData, meta interface{}) error {
 	client := meta.(*clients.Client).Synapse.WorkspaceClient
 	subscriptionId := meta.(*clients.Client).Account.SubscriptionId
@@ -442,15 +466,21 @@ func resourceSynapseWorkspaceRead(d *pluginsdk.ResourceData, meta interface{}) e
 			return fmt.Errorf("setting `customer_managed_key`: %+v", err)
 		}
 
+		// both blocks are always set so that a repository configuration which has been removed (or switched to the
+		// other provider) outside of Terraform is detected
+		azureDevOpsRepo := make([]interface{}, 0)
+		gitHubRepo := make([]interface{}, 0)
 		repoType, repo := flattenWorkspaceRepositoryConfiguration(props.WorkspaceRepositoryConfiguration)
 		if repoType == workspaceVSTSConfiguration {
-			if err := d.Set("azure_devops_repo", repo); err != nil {
-				return fmt.Errorf("setting `azure_devops_repo`: %+v", err)
-			}
+			azureDevOpsRepo = repo
 		} else if repoType == workspaceGitHubConfiguration {
-			if err := d.Set("github_repo", repo); err != nil {
-				return fmt.Errorf("setting `github_repo`: %+v", err)
-			}
+			gitHubRepo = repo
+		}
+		if err := d.Set("azure_devops_repo", azureDevOpsRepo); err != nil {
+			return fmt.Errorf("setting `azure_devops_repo`: %+v", err)
+		}
+		if err := d.Set("github_repo", gitHubRepo); err != nil {
+			return fmt.Errorf("setting `github_repo`: %+v", err)
 		}
 
 		if props.VirtualNetworkProfile != nil {
@@ -480,7 +510,7 @@ func resourceSynapseWorkspaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 		return err
 	}
 
-	if d.HasChanges("tags", "sql_administrator_login_password", "github_repo", "azure_devops_repo", "customer_managed_key", "public_network_access_enabled") {
+	if d.HasChanges("tags", "sql_administrator_login_password", "github_repo", "azure_devops_repo", "customer_managed_key", "public_network_access_enabled", "linking_allowed_for_aad_tenant_ids", "purview_id") {
 		publicNetworkAccess := synapse.WorkspacePublicNetworkAccessEnabled
 		if !d.Get("public_network_access_enabled").(bool) {
 			publicNetworkAccess = synapse.WorkspacePublicNetworkAccessDisabled
@@ -495,11 +525,11 @@ func resourceSynapseWorkspaceUpdate(d *pluginsdk.ResourceData, meta interface{})
 			},
 		}
 
-		if allowedLinkingTenantIds, ok := d.GetOk("linking_allowed_for_aad_tenant_ids"); ok {
-			if workspacePatchInfo.ManagedVirtualNetworkSettings == nil {
-				workspacePatchInfo.ManagedVirtualNetworkSettings = &synapse.ManagedVirtualNetworkSettings{}
+		// the list is always sent for a Managed Virtual Network so that removing the Tenant IDs clears them
+		if d.Get("managed_virtual_network_enabled").(bool) {
+			workspacePatchInfo.ManagedVirtualNetworkSettings = &synapse.ManagedVirtualNetworkSettings{
+				AllowedAadTenantIdsForLinking: utils.ExpandStringSlice(d.Get("linking_allowed_for_aad_tenant_ids").([]interface{})),
 			}
-			workspacePatchInfo.ManagedVirtualNetworkSettings.AllowedAadTenantIdsForLinking = utils.ExpandStringSlice(allowedLinkingTenantIds.([]interface{}))
 		}
 
 		if purviewId, ok := d.GetOk("purview_id"); ok {
@@ -6,6 +6,7 @@ package synapse_test
 import (
 	"context"
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-provider-azurerm/internal/acceptance"
@@ -146,6 +147,55 @@ func TestAccSynapseWorkspace_azdoTenant(t *testing.T) {
 	})
 }
 
+func TestAccSynapseWorkspace_repoUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace", "test")
+	r := SynapseWorkspaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.azureDevOps(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("sql_administrator_login_password"),
+		{
+			Config: r.azureDevOpsTenant(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("sql_administrator_login_password"),
+		{
+			Config: r.github(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+				check.That(data.ResourceName).Key("azure_devops_repo.#").HasValue("0"),
+			),
+		},
+		data.ImportStep("sql_administrator_login_password"),
+	})
+}
+
+func TestAccSynapseWorkspace_managedVirtualNetworkUpdate(t *testing.T) {
+	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace", "test")
+	r := SynapseWorkspaceResource{}
+
+	data.ResourceTest(t, r, []acceptance.TestStep{
+		{
+			Config: r.managedVirtualNetwork(data, false),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).ExistsInAzure(r),
+			),
+		},
+		data.ImportStep("sql_administrator_login_password"),
+		{
+			Config:      r.managedVirtualNetwork(data, true),
+			ExpectError: regexp.MustCompile("`data_exfiltration_protection_enabled` can only be set when the Synapse Workspace is created"),
+		},
+	})
+}
+
 func TestAccSynapseWorkspace_github(t *testing.T) {
 	data := acceptance.BuildTestData(t, "azurerm_synapse_workspace", "test")
 	r := SynapseWorkspaceResource{}
@@ -569,6 +619,31 @@ resource "azurerm_synapse_workspace" "test" {
 `, template, data.RandomInteger)
 }
 
+func (r SynapseWorkspaceResource) managedVirtualNetwork(data acceptance.TestData, dataExfiltrationProtectionEnabled bool) string {
+	template := r.template(data)
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_client_config" "current" {}
+
+resource "azurerm_synapse_workspace" "test" {
+  name                                 = "acctestsw%d"
+  resource_group_name                  = azurerm_resource_group.test.name
+  location                             = azurerm_resource_group.test.location
+  storage_data_lake_gen2_filesystem_id = azurerm_storage_data_lake_gen2_filesystem.test.id
+  sql_administrator_login              = "sqladminuser"
+  sql_administrator_login_password     = "H@Sh1CoR3!"
+  managed_virtual_network_enabled      = true
+  data_exfiltration_protection_enabled = %t
+  linking_allowed_for_aad_tenant_ids   = [data.azurerm_client_config.current.tenant_id]
+
+  identity {
+    type = "SystemAssigned"
+  }
+}
+`, template, data.RandomInteger, dataExfiltrationProtectionEnabled)
+}
+
 func (r SynapseWorkspaceResource) github(data acceptance.TestData) string {
 	template := r.template(data)
 	return fmt.Sprintf(`
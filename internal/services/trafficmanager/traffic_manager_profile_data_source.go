@@ -5,8 +5,10 @@ package trafficmanager
 
 import (
 	"fmt"
+	"strings"
 	"time"
 
+	"github.com/hashicorp/go-azure-helpers/lang/pointer"
 	"github.com/hashicorp/go-azure-helpers/lang/response"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/commonschema"
 	"github.com/hashicorp/go-azure-helpers/resourcemanager/tags"
@@ -134,6 +136,54 @@ func dataSourceArmTrafficManagerProfile() *pluginsdk.Resource {
 				Optional: true,
 			},
 
+			"endpoint": {
+				Type:     pluginsdk.TypeList,
+				Computed: true,
+				Elem: &pluginsdk.Resource{
+					Schema: map[string]*pluginsdk.Schema{
+						"name": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"type": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"target": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"target_resource_id": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+
+						"enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"always_serve_enabled": {
+							Type:     pluginsdk.TypeBool,
+							Computed: true,
+						},
+
+						"monitor_status": {
+							Type:     pluginsdk.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+
 			"tags": commonschema.Tags(),
 		},
 	}
@@ -183,8 +233,48 @@ func dataSourceArmTrafficManagerProfileRead(d *pluginsdk.ResourceData, meta inte
 			if dns := profile.DnsConfig; dns != nil {
 				d.Set("fqdn", dns.Fqdn)
 			}
+
+			if err := d.Set("endpoint", flattenTrafficManagerProfileEndpoints(profile.Endpoints)); err != nil {
+				return fmt.Errorf("setting `endpoint`: %+v", err)
+			}
 		}
 		return tags.FlattenAndSet(d, model.Tags)
 	}
 	return nil
 }
+
+func flattenTrafficManagerProfileEndpoints(input *[]profiles.Endpoint) []interface{} {
+	results := make([]interface{}, 0)
+	if input == nil {
+		return results
+	}
+
+	for _, endpoint := range *input {
+		enabled := false
+		alwaysServeEnabled := false
+		monitorStatus := ""
+		target := ""
+		targetResourceId := ""
+		if props := endpoint.Properties; props != nil {
+			enabled = pointer.From(props.EndpointStatus) == profiles.EndpointStatusEnabled
+			alwaysServeEnabled = pointer.From(props.AlwaysServe) == profiles.AlwaysServeEnabled
+			monitorStatus = string(pointer.From(props.EndpointMonitorStatus))
+			target = pointer.From(props.Target)
+			targetResourceId = pointer.From(props.TargetResourceId)
+		}
+
+		results = append(results, map[string]interface{}{
+			"name": pointer.From(endpoint.Name),
+			"id":   pointer.From(endpoint.Id),
+			// the API returns the type as `Microsoft.Network/trafficManagerProfiles/{endpointType}`
+			"type":                 strings.TrimPrefix(pointer.From(endpoint.Type), "Microsoft.Network/trafficManagerProfiles/"),
+			"target":               target,
+			"target_resource_id":   targetResourceId,
+			"enabled":              enabled,
+			"always_serve_enabled": alwaysServeEnabled,
+			"monitor_status":       monitorStatus,
+		})
+	}
+
+	return results
+}
@@ -26,6 +26,23 @@ func TestAccAzureRMDataSourceTrafficManagerProfile(t *testing.T) {
 	})
 }
 
+func TestAccAzureRMDataSourceTrafficManagerProfile_endpoints(t *testing.T) {
+	data := acceptance.BuildTestData(t, "data.azurerm_traffic_manager_profile", "test")
+
+	data.DataSourceTest(t, []acceptance.TestStep{
+		{
+			Config: TrafficManagerProfileDataSource{}.endpoints(data),
+			Check: acceptance.ComposeTestCheckFunc(
+				check.That(data.ResourceName).Key("endpoint.#").HasValue("1"),
+				check.That(data.ResourceName).Key("endpoint.0.type").HasValue("externalEndpoints"),
+				check.That(data.ResourceName).Key("endpoint.0.target").HasValue("www.example.com"),
+				check.That(data.ResourceName).Key("endpoint.0.enabled").HasValue("true"),
+				check.That(data.ResourceName).Key("endpoint.0.monitor_status").Exists(),
+			),
+		},
+	})
+}
+
 func (d TrafficManagerProfileDataSource) template(data acceptance.TestData) string {
 	template := TrafficManagerProfileResource{}.basic(data, "Performance")
 	return fmt.Sprintf(`
@@ -37,3 +54,16 @@ data "azurerm_traffic_manager_profile" "test" {
 }
 `, template)
 }
+
+func (d TrafficManagerProfileDataSource) endpoints(data acceptance.TestData) string {
+	return fmt.Sprintf(`
+%s
+
+data "azurerm_traffic_manager_profile" "test" {
+  name                = azurerm_traffic_manager_profile.test.name
+  resource_group_name = azurerm_resource_group.test.name
+
+  depends_on = [azurerm_traffic_manager_external_endpoint.test]
+}
+`, ExternalEndpointResource{}.basic(data))
+}